@@ -17,7 +17,6 @@ package checker
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"github.com/ossf/scorecard/v5/clients"
 	azdorepo "github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
@@ -58,23 +57,20 @@ func GetClients(ctx context.Context, repoURI, localURI string, logger *log.Logge
 			retErr
 	}
 
-	_, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL")
 	var repoClient clients.RepoClient
 
-	if experimental {
-		repo, makeRepoError = azdorepo.MakeAzureDevOpsRepo(repoURI)
-		if repo != nil && makeRepoError == nil {
-			repoClient, makeRepoError = azdorepo.CreateAzureDevOpsClient(ctx, repo)
-		}
-		if azdorepo.HasAzureDevOpsHost(repoURI) && (makeRepoError != nil || repo == nil) {
-			return repo,
-				nil,
-				nil,
-				nil,
-				nil,
-				packageclient.CreateDepsDevClient(),
-				fmt.Errorf("error making azure devops repo: %w", makeRepoError)
-		}
+	repo, makeRepoError = azdorepo.MakeAzureDevOpsRepo(repoURI)
+	if repo != nil && makeRepoError == nil {
+		repoClient, makeRepoError = azdorepo.CreateAzureDevOpsClient(ctx, repo)
+	}
+	if azdorepo.HasAzureDevOpsHost(repoURI) && (makeRepoError != nil || repo == nil) {
+		return repo,
+			nil,
+			nil,
+			nil,
+			nil,
+			packageclient.CreateDepsDevClient(),
+			fmt.Errorf("error making azure devops repo: %w", makeRepoError)
 	}
 
 	if makeRepoError != nil || repo == nil {
@@ -38,7 +38,6 @@ func TestGetClients(t *testing.T) {
 		shouldCIIBeNil           bool
 		shouldProjectClientBeNil bool
 		wantErr                  bool
-		experimental             bool
 		isGhHost                 bool
 	}{
 		{
@@ -105,15 +104,11 @@ func TestGetClients(t *testing.T) {
 			shouldRepoClientBeNil: true,
 			shouldRepoBeNil:       true,
 			wantErr:               true,
-			experimental:          true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if tt.experimental {
-				t.Setenv("SCORECARD_EXPERIMENTAL", "true")
-			}
 			if tt.isGhHost {
 				t.Setenv("GH_HOST", "github.corp.com")
 				t.Setenv("GH_TOKEN", "PAT")
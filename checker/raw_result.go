@@ -54,6 +54,18 @@ type MetadataData struct {
 	Metadata map[string]string
 }
 
+// ProjectFacts describes properties of the project which decide
+// whether a probe applies to it. A nil field means the fact is unknown.
+type ProjectFacts struct {
+	HasReleases  *bool
+	HasWorkflows *bool
+	HasPackages  *bool
+	IsLibrary    *bool
+	// Languages are the languages used by the project, largest first,
+	// nil if they could not be determined.
+	Languages []clients.LanguageName
+}
+
 type RevisionCIInfo struct {
 	HeadSHA           string
 	CheckRuns         []clients.CheckRun
@@ -63,11 +75,16 @@ type RevisionCIInfo struct {
 
 type CITestData struct {
 	CIInfo []RevisionCIInfo
+	// TestJobs are the jobs of the repository's GitLab CI configuration
+	// which run tests.
+	TestJobs []string
 }
 
 // FuzzingData represents different fuzzing done.
 type FuzzingData struct {
 	Fuzzers []Tool
+	// Languages are the programming languages of the repository.
+	Languages []clients.Language
 }
 
 // TODO: Add Msg to all results.
@@ -120,6 +137,9 @@ const (
 	DependencyUseTypePipCommand DependencyUseType = "pipCommand"
 	// DependencyUseTypeNugetCommand is a nuget command.
 	DependencyUseTypeNugetCommand DependencyUseType = "nugetCommand"
+	// DependencyUseTypePipelinePlugin is an orb, plugin, shared library or
+	// remote task of a CI pipeline.
+	DependencyUseTypePipelinePlugin DependencyUseType = "pipelinePlugin"
 )
 
 // PinningDependenciesData represents pinned dependency data.
@@ -288,6 +308,9 @@ const (
 	QodanaWorkflow SASTWorkflowType = "Qodana"
 	// HadolintWorkflow represents a workflow that runs Hadolint.
 	HadolintWorkflow SASTWorkflowType = "Hadolint"
+	// GitLabSASTWorkflow represents a GitLab CI configuration that includes the
+	// SAST template or component of GitLab.
+	GitLabSASTWorkflow SASTWorkflowType = "GitLabSAST"
 )
 
 // SASTWorkflow represents a SAST workflow.
@@ -387,13 +410,42 @@ const (
 	DangerousWorkflowScriptInjection DangerousWorkflowType = "scriptInjection"
 	// DangerousWorkflowUntrustedCheckout represents an untrusted checkout.
 	DangerousWorkflowUntrustedCheckout DangerousWorkflowType = "untrustedCheckout"
+	// DangerousWorkflowArtifactPoisoning represents artifacts of an untrusted
+	// workflow run extracted or executed with privileges.
+	DangerousWorkflowArtifactPoisoning DangerousWorkflowType = "artifactPoisoning"
+	// DangerousWorkflowCachePoisoning represents a cache saved by a
+	// pull_request_target workflow.
+	DangerousWorkflowCachePoisoning DangerousWorkflowType = "cachePoisoning"
+	// DangerousWorkflowEnvironmentInjection represents untrusted data written
+	// to GITHUB_ENV or GITHUB_OUTPUT.
+	DangerousWorkflowEnvironmentInjection DangerousWorkflowType = "environmentInjection"
 )
 
 // DangerousWorkflowData contains raw results
 // for dangerous workflow check.
 type DangerousWorkflowData struct {
-	Workflows    []DangerousWorkflow
-	NumWorkflows int
+	// Private is whether the repository is private, or nil if unknown. It is
+	// only looked up for repositories with self-hosted runner jobs.
+	Private   *bool
+	Workflows []DangerousWorkflow
+	// SelfHostedRunnerJobs are the jobs of GitHub workflows which run on
+	// self-hosted runners.
+	SelfHostedRunnerJobs []SelfHostedRunnerJob
+	NumWorkflows         int
+}
+
+// SelfHostedRunnerJob is a job of a GitHub workflow which runs on a
+// self-hosted runner.
+type SelfHostedRunnerJob struct {
+	Job *WorkflowJob
+	// Triggers are the events which trigger the workflow of the job.
+	Triggers []string
+	// Secrets are the names of the secrets the job uses, other than the
+	// GITHUB_TOKEN.
+	Secrets []string
+	// File is the location of the self-hosted label of the job, and its
+	// snippet the labels of the job.
+	File File
 }
 
 // DangerousWorkflow represents a dangerous workflow.
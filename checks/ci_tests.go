@@ -18,6 +18,8 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/evaluation"
 	"github.com/ossf/scorecard/v5/checks/raw"
+	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/probes"
 	"github.com/ossf/scorecard/v5/probes/zrunner"
@@ -42,6 +44,14 @@ func CITests(c *checker.CheckRequest) checker.CheckResult {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckCITests, e)
 	}
+	// GitLab pipelines are reported without the names of their jobs.
+	if _, ok := c.RepoClient.(*gitlabrepo.Client); ok {
+		rawData.TestJobs, err = gitlab.CITestJobs(c.RepoClient)
+		if err != nil {
+			e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+			return checker.CreateRuntimeErrorResult(CheckCITests, e)
+		}
+	}
 
 	pRawResults := getRawResults(c)
 	pRawResults.CITestResults = rawData
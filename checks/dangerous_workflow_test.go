@@ -23,6 +23,7 @@ import (
 
 	"github.com/ossf/scorecard/v5/checker"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
 	scut "github.com/ossf/scorecard/v5/utests"
 )
 
@@ -78,7 +79,7 @@ func TestDangerousWorkflow(t *testing.T) {
 			dl := scut.TestDetailLogger{}
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
-			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.workflowPaths, nil)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.workflowPaths, nil).AnyTimes()
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("./testdata/" + file)
 			}).AnyTimes()
@@ -94,3 +95,61 @@ func TestDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestDangerousWorkflow_preconditions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		wantMessage    string
+		workflowPaths  []string
+		expected       scut.TestReturn
+		wantApplicable bool
+	}{
+		{
+			name:        "unmet precondition keeps the inconclusive score",
+			wantMessage: "project has no CI workflows",
+			expected: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+			},
+		},
+		{
+			name:           "met precondition keeps the probe findings",
+			workflowPaths:  []string{".github/workflows/github-workflow-dangerous-pattern-untrusted-checkout.yml"},
+			wantApplicable: true,
+			expected: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.workflowPaths, nil).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return os.Open("./testdata/" + file)
+			}).AnyTimes()
+
+			req := &checker.CheckRequest{
+				Ctx:        t.Context(),
+				RepoClient: mockRepoClient,
+				Dlogger:    &dl,
+				RawResults: &checker.RawResults{},
+			}
+
+			result := DangerousWorkflow(req)
+			scut.ValidateTestReturn(t, tt.name, &tt.expected, &result, &dl)
+			for i := range result.Findings {
+				if applicable := result.Findings[i].Outcome != finding.OutcomeNotApplicable; applicable != tt.wantApplicable {
+					t.Errorf("finding %v: applicable = %v, want %v", result.Findings[i], applicable, tt.wantApplicable)
+				}
+				if tt.wantMessage != "" && result.Findings[i].Message != tt.wantMessage {
+					t.Errorf("finding message = %q, want %q", result.Findings[i].Message, tt.wantMessage)
+				}
+			}
+		})
+	}
+}
@@ -48,6 +48,15 @@ func TestDangerousWorkflow(t *testing.T) {
 						LineStart: &testLineStart,
 						Snippet:   &testSnippet,
 					},
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeFalse,
 				},
 			},
 			result: scut.TestReturn{
@@ -64,6 +73,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeNotApplicable,
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeNotApplicable,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeNotApplicable,
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeNotApplicable,
 				},
 			},
 			result: scut.TestReturn{
@@ -79,6 +97,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeFalse,
 				},
 			},
 			result: scut.TestReturn{
@@ -100,6 +127,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeFalse,
 				},
 			},
 			result: scut.TestReturn{
@@ -131,6 +167,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeFalse,
 				},
 			},
 			result: scut.TestReturn{
@@ -216,6 +261,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeFalse,
 				},
 			},
 			result: scut.TestReturn{
@@ -223,6 +277,37 @@ func TestDangerousWorkflow(t *testing.T) {
 				NumberOfWarn: 8,
 			},
 		},
+		{
+			name: "DangerousWorkflow - cache poisoning detected",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowScriptInjection",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowUntrustedCheckout",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowArtifactPoisoning",
+					Outcome: finding.OutcomeFalse,
+				}, {
+					Probe:   "hasDangerousWorkflowCachePoisoning",
+					Outcome: finding.OutcomeTrue,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/dangerous-workflow.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				}, {
+					Probe:   "hasDangerousWorkflowEnvironmentInjection",
+					Outcome: finding.OutcomeFalse,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        0,
+				NumberOfWarn: 1,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
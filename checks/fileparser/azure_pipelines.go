@@ -0,0 +1,271 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// azurePipelinesDirs are the directories which conventionally hold Azure
+// Pipelines files besides azure-pipelines.yml.
+var azurePipelinesDirs = map[string]bool{
+	".azure-pipelines": true,
+	"azure-pipelines":  true,
+	".pipelines":       true,
+}
+
+// azurePipelineScriptKeys are the step keys which run an inline script, keyed
+// to the shell running it. script runs in cmd.exe on Windows agents.
+var azurePipelineScriptKeys = map[string]string{
+	"script":     "sh",
+	"bash":       "bash",
+	"pwsh":       "pwsh",
+	"powershell": "powershell",
+}
+
+// AzurePipeline is the part of an Azure Pipelines file used by checks.
+// Templates and extended pipelines aren't resolved.
+type AzurePipeline struct {
+	// Containers are the container resources of the pipeline.
+	Containers []AzurePipelineContainer
+	Jobs       []AzurePipelineJob
+}
+
+// AzurePipelineContainer is a container image used by a pipeline.
+type AzurePipelineContainer struct {
+	// Alias is the name jobs refer to a container resource with.
+	Alias string
+	Image string
+	Line  uint
+}
+
+// AzurePipelineJob is a job, or deployment job, of a pipeline.
+type AzurePipelineJob struct {
+	// Container is the container the job runs in, if it isn't a container resource.
+	Container *AzurePipelineContainer
+	Name      string
+	// VMImage is the image of the Microsoft-hosted agent the job runs on.
+	VMImage string
+	Steps   []AzurePipelineStep
+}
+
+// AzurePipelineStep is a task or script step of a job.
+type AzurePipelineStep struct {
+	Inputs map[string]string
+	// Task is the name and major version of a task, e.g. Docker@2.
+	Task string
+	// Shell runs Script: sh, bash, pwsh or powershell.
+	Shell  string
+	Script string
+	Line   uint
+	// ScriptLine is the line of the first line of Script.
+	ScriptLine uint
+}
+
+// TaskName returns the name of a task step without its version.
+func (s *AzurePipelineStep) TaskName() string {
+	name, _, _ := strings.Cut(s.Task, "@")
+	return name
+}
+
+// IsAzurePipelinesFile determines if a file is an Azure Pipelines file, as a
+// callback to use for repo client's ListFiles() API. Pipelines may use any YAML
+// file of the repository, so only the conventional locations are matched.
+func IsAzurePipelinesFile(pathfn string) (bool, error) {
+	switch path.Ext(pathfn) {
+	case ".yml", ".yaml":
+	default:
+		return false, nil
+	}
+	if strings.HasPrefix(strings.ToLower(path.Base(pathfn)), "azure-pipelines") {
+		return true, nil
+	}
+	for _, dir := range strings.Split(path.Dir(pathfn), "/") {
+		if azurePipelinesDirs[strings.ToLower(dir)] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ParseAzurePipeline parses the stages, jobs and steps of an Azure Pipelines file.
+func ParseAzurePipeline(content []byte) (*AzurePipeline, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidAzurePipeline, err)
+	}
+	pipeline := &AzurePipeline{}
+	if len(doc.Content) == 0 {
+		return pipeline, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: expected a mapping", errInvalidAzurePipeline)
+	}
+
+	if resources := yamlValue(root, "resources"); resources != nil {
+		for _, c := range yamlItems(yamlValue(resources, "containers")) {
+			image := yamlValue(c, "image")
+			if image == nil {
+				continue
+			}
+			pipeline.Containers = append(pipeline.Containers, AzurePipelineContainer{
+				Alias: yamlString(c, "container"),
+				Image: image.Value,
+				Line:  uint(image.Line),
+			})
+		}
+	}
+
+	// a pipeline has either stages, jobs or the steps of a single job.
+	var jobs []*yaml.Node
+	for _, stage := range yamlItems(yamlValue(root, "stages")) {
+		jobs = append(jobs, yamlItems(yamlValue(stage, "jobs"))...)
+	}
+	jobs = append(jobs, yamlItems(yamlValue(root, "jobs"))...)
+	if yamlValue(root, "steps") != nil {
+		jobs = append(jobs, root)
+	}
+	for _, j := range jobs {
+		pipeline.Jobs = append(pipeline.Jobs, parseAzurePipelineJob(j, pipeline.Containers))
+	}
+	return pipeline, nil
+}
+
+func parseAzurePipelineJob(node *yaml.Node, resources []AzurePipelineContainer) AzurePipelineJob {
+	job := AzurePipelineJob{
+		Name:    yamlString(node, "job"),
+		VMImage: yamlString(yamlValue(node, "pool"), "vmImage"),
+	}
+	if job.Name == "" {
+		job.Name = yamlString(node, "deployment")
+	}
+
+	if c := yamlValue(node, "container"); c != nil {
+		image := c
+		if c.Kind == yaml.MappingNode {
+			image = yamlValue(c, "image")
+		}
+		if image != nil && image.Kind == yaml.ScalarNode && !isContainerAlias(image.Value, resources) {
+			job.Container = &AzurePipelineContainer{Image: image.Value, Line: uint(image.Line)}
+		}
+	}
+
+	for _, steps := range collectAzurePipelineSteps(node) {
+		for _, s := range yamlItems(steps) {
+			if step, ok := parseAzurePipelineStep(s); ok {
+				job.Steps = append(job.Steps, step)
+			}
+		}
+	}
+	return job
+}
+
+// collectAzurePipelineSteps returns the steps of a job, including the steps
+// of each lifecycle hook of a deployment strategy.
+func collectAzurePipelineSteps(node *yaml.Node) []*yaml.Node {
+	var all []*yaml.Node
+	if steps := yamlValue(node, "steps"); steps != nil {
+		all = append(all, steps)
+	}
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		if n == nil || n.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == "steps" {
+				all = append(all, n.Content[i+1])
+				continue
+			}
+			walk(n.Content[i+1])
+		}
+	}
+	walk(yamlValue(node, "strategy"))
+	return all
+}
+
+func parseAzurePipelineStep(node *yaml.Node) (AzurePipelineStep, bool) {
+	if node.Kind != yaml.MappingNode {
+		return AzurePipelineStep{}, false
+	}
+	step := AzurePipelineStep{Line: uint(node.Line)}
+	if task := yamlValue(node, "task"); task != nil {
+		step.Task = task.Value
+		step.Inputs = map[string]string{}
+		inputs := yamlValue(node, "inputs")
+		if inputs != nil && inputs.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(inputs.Content); i += 2 {
+				step.Inputs[inputs.Content[i].Value] = inputs.Content[i+1].Value
+			}
+		}
+		return step, true
+	}
+	for key, shell := range azurePipelineScriptKeys {
+		if script := yamlValue(node, key); script != nil {
+			step.Shell = shell
+			step.Script = script.Value
+			step.ScriptLine = uint(script.Line)
+			// block scalars start on the line after their indicator.
+			if script.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+				step.ScriptLine++
+			}
+			return step, true
+		}
+	}
+	return AzurePipelineStep{}, false
+}
+
+func isContainerAlias(name string, resources []AzurePipelineContainer) bool {
+	for i := range resources {
+		if resources[i].Alias == name {
+			return true
+		}
+	}
+	return false
+}
+
+// yamlValue returns the value of key in a mapping node, or nil.
+func yamlValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlString returns the scalar value of key in a mapping node.
+func yamlString(node *yaml.Node, key string) string {
+	if v := yamlValue(node, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+// yamlItems returns the items of a sequence node.
+func yamlItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsAzurePipelinesFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "azure-pipelines.yml", want: true},
+		{path: "build/azure-pipelines-release.yaml", want: true},
+		{path: ".azure-pipelines/ci.yml", want: true},
+		{path: "eng/.pipelines/templates/build.yaml", want: true},
+		{path: "azure-pipelines.json", want: false},
+		{path: ".github/workflows/ci.yml", want: false},
+		{path: "pipelines/ci.yml", want: false},
+	}
+	for _, tt := range tests {
+		got, err := IsAzurePipelinesFile(tt.path)
+		if err != nil {
+			t.Fatalf("IsAzurePipelinesFile(%q): %v", tt.path, err)
+		}
+		if got != tt.want {
+			t.Errorf("IsAzurePipelinesFile(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParseAzurePipeline(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want    *AzurePipeline
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "empty file",
+			content: "",
+			want:    &AzurePipeline{},
+		},
+		{
+			name:    "not a mapping",
+			content: "- job: build",
+			wantErr: true,
+		},
+		{
+			name: "steps of a single job",
+			content: `pool:
+  vmImage: windows-latest
+steps:
+  - task: Docker@2
+    inputs:
+      command: push
+  - script: |
+      make
+  - checkout: self
+`,
+			want: &AzurePipeline{
+				Jobs: []AzurePipelineJob{{
+					VMImage: "windows-latest",
+					Steps: []AzurePipelineStep{
+						{Task: "Docker@2", Inputs: map[string]string{"command": "push"}, Line: 4},
+						{Shell: "sh", Script: "make\n", Line: 7, ScriptLine: 8},
+					},
+				}},
+			},
+		},
+		{
+			name: "stages, deployment jobs and containers",
+			content: `resources:
+  containers:
+    - container: builder
+      image: golang:1.25
+stages:
+  - stage: build
+    jobs:
+      - job: build
+        container: builder
+        steps:
+          - bash: go build ./...
+  - stage: deploy
+    jobs:
+      - deployment: deploy
+        container:
+          image: alpine:3
+        strategy:
+          runOnce:
+            deploy:
+              steps:
+                - pwsh: ./deploy.ps1
+            on:
+              failure:
+                steps:
+                  - template: rollback.yml
+`,
+			want: &AzurePipeline{
+				Containers: []AzurePipelineContainer{{Alias: "builder", Image: "golang:1.25", Line: 4}},
+				Jobs: []AzurePipelineJob{
+					{
+						Name: "build",
+						Steps: []AzurePipelineStep{
+							{Shell: "bash", Script: "go build ./...", Line: 11, ScriptLine: 11},
+						},
+					},
+					{
+						Name:      "deploy",
+						Container: &AzurePipelineContainer{Image: "alpine:3", Line: 16},
+						Steps: []AzurePipelineStep{
+							{Shell: "pwsh", Script: "./deploy.ps1", Line: 21, ScriptLine: 21},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseAzurePipeline([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAzurePipeline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+// IsBitbucketPipelinesFile determines if a file is the configuration of
+// Bitbucket Pipelines, as a callback to use for repo client's ListFiles() API.
+func IsBitbucketPipelinesFile(pathfn string) (bool, error) {
+	return pathfn == "bitbucket-pipelines.yml", nil
+}
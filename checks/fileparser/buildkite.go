@@ -0,0 +1,174 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// buildkiteDefaultShell runs the commands of steps, unless the agent is
+// configured with another shell.
+const buildkiteDefaultShell = "bash"
+
+// buildkiteUntrustedPattern matches the environment variables of Buildkite
+// which the authors of commits and pull requests control. The agent
+// interpolates them in a pipeline when uploading it, unless they are escaped.
+var buildkiteUntrustedPattern = regexp.MustCompile(`\$(\{` + buildkiteUntrustedVariables + `\b[^}]*\}|` +
+	buildkiteUntrustedVariables + `\b)`)
+
+const buildkiteUntrustedVariables = `(BUILDKITE_BRANCH|BUILDKITE_MESSAGE|BUILDKITE_BUILD_AUTHOR|BUILDKITE_BUILD_AUTHOR_EMAIL)`
+
+// gitCommitHash matches the full hash of a commit.
+var gitCommitHash = regexp.MustCompile(`^[a-fA-F0-9]{40}$`)
+
+// parseBuildkite parses the command steps of a Buildkite pipeline, which is a
+// mapping with steps or, in the older format, the list of steps.
+func parseBuildkite(content []byte) (*Pipeline, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err //nolint:wrapcheck // wrapped by ParsePipeline.
+	}
+	pipeline := &Pipeline{}
+	if len(doc.Content) == 0 {
+		return pipeline, nil
+	}
+	steps := doc.Content[0]
+	switch steps.Kind {
+	case yaml.MappingNode:
+		steps = yamlValue(steps, "steps")
+	case yaml.SequenceNode:
+	default:
+		return nil, errExpectedMapping
+	}
+	addBuildkiteSteps(pipeline, steps)
+	return pipeline, nil
+}
+
+// addBuildkiteSteps adds the command steps, including those of groups, as
+// jobs. Wait, block, input and trigger steps run no commands.
+func addBuildkiteSteps(pipeline *Pipeline, steps *yaml.Node) {
+	for _, step := range yamlItems(steps) {
+		if step.Kind != yaml.MappingNode {
+			continue
+		}
+		if group := yamlValue(step, "steps"); group != nil {
+			addBuildkiteSteps(pipeline, group)
+			continue
+		}
+		command := yamlValue(step, "command")
+		if command == nil {
+			command = yamlValue(step, "commands")
+		}
+		plugins := yamlValue(step, "plugins")
+		if command == nil && plugins == nil {
+			continue
+		}
+
+		job := PipelineJob{Name: buildkiteStepName(step), Line: uint(step.Line)}
+		if image := yamlValue(step, "image"); image != nil && image.Kind == yaml.ScalarNode {
+			job.Images = append(job.Images, PipelineImage{Name: image.Value, Line: uint(image.Line)})
+		}
+		commands := []*yaml.Node{command}
+		if command != nil && command.Kind == yaml.SequenceNode {
+			commands = command.Content
+		}
+		for _, c := range commands {
+			if c == nil || c.Kind != yaml.ScalarNode || c.Value == "" {
+				continue
+			}
+			line := scriptLine(c)
+			job.Commands = append(job.Commands, PipelineCommand{
+				Command:   c.Value,
+				Shell:     buildkiteDefaultShell,
+				Untrusted: findExpressions(buildkiteUntrustedPattern, c.Value, line, isBuildkiteEscaped),
+				Line:      line,
+			})
+		}
+		for _, plugin := range buildkitePlugins(plugins) {
+			name, ref, _ := strings.Cut(plugin.key.Value, "#")
+			pipeline.Plugins = append(pipeline.Plugins, PipelinePlugin{
+				Name:    name,
+				Version: ref,
+				Line:    uint(plugin.key.Line),
+				Pinned:  gitCommitHash.MatchString(ref),
+			})
+			if isBuildkiteDockerPlugin(name) {
+				if image := yamlValue(plugin.config, "image"); image != nil && image.Kind == yaml.ScalarNode {
+					job.Images = append(job.Images, PipelineImage{Name: image.Value, Line: uint(image.Line)})
+				}
+			}
+		}
+		pipeline.Jobs = append(pipeline.Jobs, job)
+	}
+}
+
+func buildkiteStepName(step *yaml.Node) string {
+	for _, key := range []string{"label", "name", "key"} {
+		if name := yamlString(step, key); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+type buildkitePlugin struct {
+	key    *yaml.Node
+	config *yaml.Node
+}
+
+// buildkitePlugins returns the plugins of a step, which are a list of plugins
+// with or without configuration or, in the older format, a mapping.
+func buildkitePlugins(node *yaml.Node) []buildkitePlugin {
+	var plugins []buildkitePlugin
+	items := yamlItems(node)
+	if node != nil && node.Kind == yaml.MappingNode {
+		items = []*yaml.Node{node}
+	}
+	for _, item := range items {
+		switch item.Kind {
+		case yaml.ScalarNode:
+			plugins = append(plugins, buildkitePlugin{key: item})
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(item.Content); i += 2 {
+				plugins = append(plugins, buildkitePlugin{key: item.Content[i], config: item.Content[i+1]})
+			}
+		default:
+		}
+	}
+	return plugins
+}
+
+// isBuildkiteDockerPlugin reports whether a plugin is the docker plugin,
+// given by its short name or its repository.
+func isBuildkiteDockerPlugin(name string) bool {
+	name = path.Base(strings.TrimSuffix(name, ".git"))
+	return name == "docker" || name == "docker-buildkite-plugin"
+}
+
+// isBuildkiteEscaped reports whether the $ at start is escaped, by $ or \.
+func isBuildkiteEscaped(command string, start int) bool {
+	if start > 0 && command[start-1] == '\\' {
+		return true
+	}
+	dollars := 0
+	for i := start - 1; i >= 0 && command[i] == '$'; i-- {
+		dollars++
+	}
+	return dollars%2 == 1
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBuildkite(t *testing.T) {
+	t.Parallel()
+	content := `env:
+  FOO: bar
+steps:
+  - label: ":go: test"
+    commands:
+      - go test ./...
+      - echo "$BUILDKITE_MESSAGE $$BUILDKITE_BRANCH ${BUILDKITE_BRANCH:-main}"
+    plugins:
+      - docker#v5.9.0:
+          image: golang:1.22
+      - org/cache#0123456789abcdef0123456789abcdef01234567: ~
+  - wait
+  - group: release
+    steps:
+      - key: publish
+        command: make publish
+        image: alpine:3
+  - trigger: deploy
+`
+	want := &Pipeline{
+		System: Buildkite,
+		Path:   ".buildkite/pipeline.yml",
+		Plugins: []PipelinePlugin{
+			{Name: "docker", Version: "v5.9.0", Line: 9},
+			{Name: "org/cache", Version: "0123456789abcdef0123456789abcdef01234567", Line: 11, Pinned: true},
+		},
+		Jobs: []PipelineJob{
+			{
+				Name:   ":go: test",
+				Line:   4,
+				Images: []PipelineImage{{Name: "golang:1.22", Line: 10}},
+				Commands: []PipelineCommand{
+					{Command: "go test ./...", Shell: "bash", Line: 6},
+					{
+						Command: "echo \"$BUILDKITE_MESSAGE $$BUILDKITE_BRANCH ${BUILDKITE_BRANCH:-main}\"",
+						Shell:   "bash",
+						Untrusted: []PipelineExpression{
+							{Expression: "$BUILDKITE_MESSAGE", Line: 7},
+							{Expression: "${BUILDKITE_BRANCH:-main}", Line: 7},
+						},
+						Line: 7,
+					},
+				},
+			},
+			{
+				Name:     "publish",
+				Line:     15,
+				Images:   []PipelineImage{{Name: "alpine:3", Line: 17}},
+				Commands: []PipelineCommand{{Command: "make publish", Shell: "bash", Line: 16}},
+			},
+		},
+	}
+	got, err := ParsePipeline(".buildkite/pipeline.yml", []byte(content))
+	if err != nil {
+		t.Fatalf("ParsePipeline() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// circleCIDefaultShell runs the run steps of jobs on Linux and macOS.
+const circleCIDefaultShell = "bash"
+
+// circleCIUntrustedPattern matches the pipeline values of CircleCI which the
+// authors of commits and pull requests control. CircleCI substitutes them in
+// the configuration before running it.
+var circleCIUntrustedPattern = regexp.MustCompile(
+	`<<\s*pipeline\.(git\.branch|trigger_parameters\.\w+\.(branch|commit_message|commit_title))\s*>>`)
+
+// circleCIOrbVersion matches the full version of an orb. Versions of orbs
+// can't be published again, unlike dev: versions.
+var circleCIOrbVersion = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+// parseCircleCI parses the orbs and jobs of a CircleCI configuration. The
+// steps of reusable commands are inlined, but those of orbs aren't.
+func parseCircleCI(content []byte) (*Pipeline, error) {
+	root, err := parseYAMLMapping(content)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := &Pipeline{}
+
+	orbs := yamlValue(root, "orbs")
+	for i := 0; orbs != nil && orbs.Kind == yaml.MappingNode && i+1 < len(orbs.Content); i += 2 {
+		// inline orbs are mappings.
+		ref := orbs.Content[i+1]
+		if ref.Kind != yaml.ScalarNode {
+			continue
+		}
+		name, version, _ := strings.Cut(ref.Value, "@")
+		pipeline.Plugins = append(pipeline.Plugins, PipelinePlugin{
+			Name:    name,
+			Version: version,
+			Line:    uint(ref.Line),
+			Pinned:  circleCIOrbVersion.MatchString(version),
+		})
+	}
+
+	p := circleCIParser{
+		executors: yamlValue(root, "executors"),
+		commands:  yamlValue(root, "commands"),
+	}
+	jobs := yamlValue(root, "jobs")
+	for i := 0; jobs != nil && jobs.Kind == yaml.MappingNode && i+1 < len(jobs.Content); i += 2 {
+		key, value := jobs.Content[i], jobs.Content[i+1]
+		if value.Kind != yaml.MappingNode {
+			continue
+		}
+		job := PipelineJob{Name: key.Value, Line: uint(key.Line)}
+		job.Images = circleCIImages(value)
+		if executor := yamlValue(value, "executor"); executor != nil {
+			name := executor.Value
+			if executor.Kind == yaml.MappingNode {
+				name = yamlString(executor, "name")
+			}
+			// executors of orbs, e.g. node/default, aren't resolved.
+			job.Images = append(job.Images, circleCIImages(yamlValue(p.executors, name))...)
+		}
+		shell := yamlString(value, "shell")
+		job.Commands = p.appendSteps(nil, yamlValue(value, "steps"), shell, map[string]bool{})
+		pipeline.Jobs = append(pipeline.Jobs, job)
+	}
+	return pipeline, nil
+}
+
+type circleCIParser struct {
+	executors *yaml.Node
+	commands  *yaml.Node
+}
+
+// circleCIImages returns the images of the docker executor of a job or of a
+// reusable executor.
+func circleCIImages(node *yaml.Node) []PipelineImage {
+	var images []PipelineImage
+	for _, d := range yamlItems(yamlValue(node, "docker")) {
+		if image := yamlValue(d, "image"); image != nil && image.Kind == yaml.ScalarNode {
+			images = append(images, PipelineImage{Name: image.Value, Line: uint(image.Line)})
+		}
+	}
+	return images
+}
+
+// appendSteps appends the commands of the run steps of a job, and of the
+// reusable commands and conditional steps it uses.
+func (p *circleCIParser) appendSteps(commands []PipelineCommand, steps *yaml.Node, shell string,
+	seen map[string]bool,
+) []PipelineCommand {
+	for _, step := range yamlItems(steps) {
+		name, params := step, (*yaml.Node)(nil)
+		if step.Kind == yaml.MappingNode && len(step.Content) == 2 {
+			name, params = step.Content[0], step.Content[1]
+		}
+		if name.Kind != yaml.ScalarNode {
+			continue
+		}
+		switch name.Value {
+		case "run":
+			if command, ok := parseCircleCIRun(params, shell); ok {
+				commands = append(commands, command)
+			}
+		case "when", "unless":
+			commands = p.appendSteps(commands, yamlValue(params, "steps"), shell, seen)
+		default:
+			command := yamlValue(p.commands, name.Value)
+			if command == nil || seen[name.Value] {
+				continue
+			}
+			seen[name.Value] = true
+			commands = p.appendSteps(commands, yamlValue(command, "steps"), shell, seen)
+			delete(seen, name.Value)
+		}
+	}
+	return commands
+}
+
+// parseCircleCIRun parses a run step, given as a command or as a mapping.
+func parseCircleCIRun(node *yaml.Node, shell string) (PipelineCommand, bool) {
+	if node != nil && node.Kind == yaml.MappingNode {
+		if s := yamlString(node, "shell"); s != "" {
+			shell = s
+		}
+		node = yamlValue(node, "command")
+	}
+	if node == nil || node.Kind != yaml.ScalarNode || node.Value == "" {
+		return PipelineCommand{}, false
+	}
+	if shell == "" {
+		shell = circleCIDefaultShell
+	}
+	line := scriptLine(node)
+	return PipelineCommand{
+		Command:   node.Value,
+		Shell:     programName(shell),
+		Untrusted: findExpressions(circleCIUntrustedPattern, node.Value, line, nil),
+		Line:      line,
+	}, true
+}
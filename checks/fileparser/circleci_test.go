@@ -0,0 +1,102 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCircleCI(t *testing.T) {
+	t.Parallel()
+	content := `version: 2.1
+orbs:
+  node: circleci/node@5.1.0
+  sonar: sonarsource/sonarcloud@2
+  local:
+    jobs: {}
+executors:
+  go:
+    docker:
+      - image: cimg/go:1.22
+commands:
+  setup:
+    steps:
+      - run: go mod download
+jobs:
+  build:
+    executor: go
+    docker:
+      - image: postgres:16
+    steps:
+      - checkout
+      - setup
+      - node/install-packages
+      - run:
+          name: build
+          shell: /bin/sh -e
+          command: |
+            echo "<< pipeline.git.branch >> << pipeline.number >>"
+            make
+      - when:
+          condition: true
+          steps:
+            - run: make test
+  lint:
+    executor:
+      name: go
+    steps:
+      - run: golangci-lint run
+`
+	want := &Pipeline{
+		System: CircleCI,
+		Path:   ".circleci/config.yml",
+		Plugins: []PipelinePlugin{
+			{Name: "circleci/node", Version: "5.1.0", Line: 3, Pinned: true},
+			{Name: "sonarsource/sonarcloud", Version: "2", Line: 4},
+		},
+		Jobs: []PipelineJob{
+			{
+				Name:   "build",
+				Line:   16,
+				Images: []PipelineImage{{Name: "postgres:16", Line: 19}, {Name: "cimg/go:1.22", Line: 10}},
+				Commands: []PipelineCommand{
+					{Command: "go mod download", Shell: "bash", Line: 14},
+					{
+						Command:   "echo \"<< pipeline.git.branch >> << pipeline.number >>\"\nmake\n",
+						Shell:     "sh",
+						Untrusted: []PipelineExpression{{Expression: "<< pipeline.git.branch >>", Line: 28}},
+						Line:      28,
+					},
+					{Command: "make test", Shell: "bash", Line: 33},
+				},
+			},
+			{
+				Name:     "lint",
+				Line:     34,
+				Images:   []PipelineImage{{Name: "cimg/go:1.22", Line: 10}},
+				Commands: []PipelineCommand{{Command: "golangci-lint run", Shell: "bash", Line: 38}},
+			},
+		},
+	}
+	got, err := ParsePipeline(".circleci/config.yml", []byte(content))
+	if err != nil {
+		t.Fatalf("ParsePipeline() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
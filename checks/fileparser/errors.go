@@ -20,5 +20,9 @@ import (
 
 var (
 	errInvalidGitHubWorkflow = errors.New("invalid GitHub workflow")
+	errInvalidAzurePipeline  = errors.New("invalid Azure Pipelines file")
+	errInvalidGitLabCI       = errors.New("invalid GitLab CI configuration")
+	errInvalidPipeline       = errors.New("invalid pipeline")
 	errInternalFilenameMatch = errors.New("filename match error")
+	errExpectedMapping       = errors.New("expected a mapping")
 )
@@ -0,0 +1,571 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// GitLabCIFile is the path of the CI/CD configuration of a GitLab project.
+const GitLabCIFile = ".gitlab-ci.yml"
+
+const (
+	// maxGitLabCIIncludes is the limit of GitLab on the number of includes.
+	maxGitLabCIIncludes = 150
+	// maxGitLabCIExtends is the limit of GitLab on the levels of extends.
+	maxGitLabCIExtends = 11
+	// maxGitLabCIReferences is the limit of GitLab on nested !reference tags.
+	maxGitLabCIReferences = 10
+	// maxGitLabCIAliases bounds the nesting of anchors expanded by aliases.
+	maxGitLabCIAliases = 32
+	// maxGitLabCINodes bounds the nodes of a file with its aliases expanded,
+	// which grow exponentially with nested aliases.
+	maxGitLabCINodes = 100_000
+)
+
+// gitLabCIKeywords are the top-level keys which aren't jobs.
+var gitLabCIKeywords = map[string]bool{
+	"after_script":  true,
+	"before_script": true,
+	"cache":         true,
+	"default":       true,
+	"image":         true,
+	"include":       true,
+	"services":      true,
+	"spec":          true,
+	"stages":        true,
+	"variables":     true,
+	"workflow":      true,
+}
+
+// gitLabCIDefaultKeys are the keys jobs inherit from default, or from the top
+// level where they are deprecated.
+var gitLabCIDefaultKeys = []string{"image", "services", "before_script", "after_script"}
+
+// gitLabCIScriptKeys are the keys holding the commands of a job, in the order
+// they run.
+var gitLabCIScriptKeys = []string{"before_script", "script", "after_script"}
+
+// GitLabCI is the part of a GitLab CI configuration used by checks. Local
+// includes, extends, anchors and !reference tags are resolved. Includes of
+// other projects, remote files, templates and components aren't, nor are local
+// includes with wildcards or variables.
+type GitLabCI struct {
+	Jobs []GitLabCIJob
+	// Includes are the templates and components the configuration includes.
+	Includes []GitLabCIInclude
+}
+
+// GitLabCIInclude is an include of a template or a component.
+type GitLabCIInclude struct {
+	// Template is the name of a template of GitLab, e.g. Security/SAST.gitlab-ci.yml.
+	Template string
+	// Component is the address of a component, e.g. gitlab.com/components/sast/sast@3.
+	Component string
+	Path      string
+	Line      uint
+}
+
+// GitLabCIJob is a job of a GitLab CI configuration, with the keys it
+// inherits from default and the jobs it extends.
+type GitLabCIJob struct {
+	// Image is the container image the job runs in, if any.
+	Image *GitLabCIImage
+	Name  string
+	Stage string
+	// Path is the file the job is defined in.
+	Path     string
+	Services []GitLabCIImage
+	// Commands are the commands of before_script, script and after_script.
+	Commands []GitLabCICommand
+	Line     uint
+	// MergeRequests is whether the job runs in merge request pipelines.
+	MergeRequests bool
+}
+
+// GitLabCIImage is the image of a job or of one of its services.
+type GitLabCIImage struct {
+	Name string
+	Path string
+	Line uint
+}
+
+// GitLabCICommand is a command of a job script, which may span several lines.
+type GitLabCICommand struct {
+	Command string
+	Path    string
+	Line    uint
+}
+
+// GitLabCIFileReader reads a file of a repository, by its path from the root.
+type GitLabCIFileReader func(path string) ([]byte, error)
+
+// IsGitLabCIFile determines if a file is the CI/CD configuration of a GitLab
+// project, as a callback to use for repo client's ListFiles() API.
+func IsGitLabCIFile(pathfn string) (bool, error) {
+	return pathfn == GitLabCIFile, nil
+}
+
+// ReadGitLabCI parses the GitLab CI configuration of a repository and the
+// local files it includes. It returns nil if the repository has none, or if it
+// isn't valid, in which case GitLab runs no pipelines either.
+func ReadGitLabCI(c clients.RepoClient) (*GitLabCI, error) {
+	files, err := c.ListFiles(IsGitLabCIFile)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	if len(files) == 0 || files[0] != GitLabCIFile {
+		return nil, nil
+	}
+	readFile := func(pathfn string) ([]byte, error) {
+		r, err := c.GetFileReader(pathfn)
+		if err != nil {
+			return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading from file: %w", err)
+		}
+		return content, nil
+	}
+	content, err := readFile(GitLabCIFile)
+	if err != nil {
+		return nil, err
+	}
+	ci, err := ParseGitLabCI(GitLabCIFile, content, readFile)
+	if err != nil {
+		//nolint:nilerr // an invalid configuration runs no jobs.
+		return nil, nil
+	}
+	return ci, nil
+}
+
+type gitLabCIParser struct {
+	readFile GitLabCIFileReader
+	// paths are the files nodes were read from.
+	paths    map[*yaml.Node]string
+	included map[string]bool
+	root     *yaml.Node
+	includes []GitLabCIInclude
+}
+
+// ParseGitLabCI parses the GitLab CI configuration at pathfn, and the local
+// files it includes, read with readFile. Includes which can't be read or
+// parsed are skipped.
+func ParseGitLabCI(pathfn string, content []byte, readFile GitLabCIFileReader) (*GitLabCI, error) {
+	p := &gitLabCIParser{
+		readFile: readFile,
+		paths:    map[*yaml.Node]string{},
+		included: map[string]bool{pathfn: true},
+	}
+	root, err := p.parseFile(pathfn, content)
+	if err != nil {
+		return nil, err
+	}
+	p.root = root
+
+	ci := &GitLabCI{Includes: p.includes}
+	defaults := yamlValue(root, "default")
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		name := key.Value
+		if gitLabCIKeywords[name] || strings.HasPrefix(name, ".") || value.Kind != yaml.MappingNode {
+			continue
+		}
+		job := p.resolveReferences(p.resolveExtends(name, map[string]bool{name: true}, 0), 0)
+		ci.Jobs = append(ci.Jobs, p.parseJob(key, job, defaults))
+	}
+	return ci, nil
+}
+
+// parseFile parses a configuration file merged with the files it includes.
+func (p *gitLabCIParser) parseFile(pathfn string, content []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidGitLabCI, err)
+	}
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if len(doc.Content) > 0 {
+		root = doc.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: expected a mapping", errInvalidGitLabCI)
+	}
+	nodes := maxGitLabCINodes
+	root, err := expandAliases(root, 0, &nodes)
+	if err != nil {
+		return nil, err
+	}
+	p.setPaths(root, pathfn)
+
+	p.addIncludes(yamlValue(root, "include"), pathfn)
+	merged := &yaml.Node{Kind: yaml.MappingNode}
+	for _, include := range localIncludes(yamlValue(root, "include")) {
+		if p.included[include] || len(p.included) > maxGitLabCIIncludes {
+			continue
+		}
+		p.included[include] = true
+		data, err := p.readFile(include)
+		if err != nil {
+			continue
+		}
+		included, err := p.parseFile(include, data)
+		if err != nil {
+			continue
+		}
+		merged = p.merge(merged, included)
+	}
+	return p.merge(merged, root), nil
+}
+
+func (p *gitLabCIParser) setPaths(node *yaml.Node, pathfn string) {
+	if _, ok := p.paths[node]; ok {
+		return
+	}
+	p.paths[node] = pathfn
+	for _, c := range node.Content {
+		p.setPaths(c, pathfn)
+	}
+}
+
+// localIncludes returns the paths of the local files of an include, which are
+// given as strings or with the local key. Other strings are remote URLs.
+func localIncludes(node *yaml.Node) []string {
+	var items []*yaml.Node
+	switch {
+	case node == nil:
+		return nil
+	case node.Kind == yaml.SequenceNode:
+		items = node.Content
+	default:
+		items = []*yaml.Node{node}
+	}
+	var paths []string
+	for _, item := range items {
+		local := yamlString(item, "local")
+		if item.Kind == yaml.ScalarNode && !strings.Contains(item.Value, "://") {
+			local = item.Value
+		}
+		if local == "" || strings.ContainsAny(local, "*$") {
+			continue
+		}
+		paths = append(paths, strings.TrimPrefix(path.Clean("/"+local), "/"))
+	}
+	return paths
+}
+
+// addIncludes records the templates and components of an include.
+func (p *gitLabCIParser) addIncludes(node *yaml.Node, pathfn string) {
+	items := yamlItems(node)
+	if node != nil && node.Kind == yaml.MappingNode {
+		items = []*yaml.Node{node}
+	}
+	for _, item := range items {
+		include := GitLabCIInclude{
+			Template:  yamlString(item, "template"),
+			Component: yamlString(item, "component"),
+			Path:      pathfn,
+			Line:      uint(item.Line),
+		}
+		if include.Template != "" || include.Component != "" {
+			p.includes = append(p.includes, include)
+		}
+	}
+}
+
+// expandAliases replaces the aliases of node by their anchors, and merges the
+// mappings of merge keys into the mappings holding them. It fails once more
+// than *nodes nodes are expanded.
+func expandAliases(node *yaml.Node, depth int, nodes *int) (*yaml.Node, error) {
+	if *nodes--; *nodes < 0 {
+		return nil, fmt.Errorf("%w: more than %d nodes with aliases expanded", errInvalidGitLabCI, maxGitLabCINodes)
+	}
+	if depth > maxGitLabCIAliases {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null"}, nil
+	}
+	switch node.Kind {
+	case yaml.AliasNode:
+		return expandAliases(node.Alias, depth+1, nodes)
+	case yaml.SequenceNode:
+		out := *node
+		out.Content = make([]*yaml.Node, 0, len(node.Content))
+		for _, c := range node.Content {
+			expanded, err := expandAliases(c, depth, nodes)
+			if err != nil {
+				return nil, err
+			}
+			out.Content = append(out.Content, expanded)
+		}
+		return &out, nil
+	case yaml.MappingNode:
+		out := *node
+		out.Content = nil
+		var merged []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			value, err := expandAliases(node.Content[i+1], depth, nodes)
+			if err != nil {
+				return nil, err
+			}
+			if key.Tag != "!!merge" {
+				out.Content = append(out.Content, key, value)
+				continue
+			}
+			if value.Kind == yaml.SequenceNode {
+				merged = append(merged, value.Content...)
+			} else {
+				merged = append(merged, value)
+			}
+		}
+		// keys of the mapping take precedence, then those of earlier merges.
+		for _, m := range merged {
+			if m.Kind != yaml.MappingNode {
+				continue
+			}
+			for i := 0; i+1 < len(m.Content); i += 2 {
+				if yamlValue(&out, m.Content[i].Value) == nil {
+					out.Content = append(out.Content, m.Content[i], m.Content[i+1])
+				}
+			}
+		}
+		return &out, nil
+	default:
+		return node, nil
+	}
+}
+
+// merge deep merges two mappings, with the keys of override taking
+// precedence. Other values of override replace those of base.
+func (p *gitLabCIParser) merge(base, override *yaml.Node) *yaml.Node {
+	if base == nil || base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		return override
+	}
+	out := &yaml.Node{Kind: yaml.MappingNode, Tag: override.Tag, Line: override.Line, Column: override.Column}
+	p.paths[out] = p.paths[override]
+	for i := 0; i+1 < len(base.Content); i += 2 {
+		key, value := base.Content[i], base.Content[i+1]
+		if yamlValue(override, key.Value) == nil {
+			out.Content = append(out.Content, key, value)
+		}
+	}
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+		out.Content = append(out.Content, key, p.merge(yamlValue(base, key.Value), value))
+	}
+	return out
+}
+
+// resolveExtends returns a job merged with the jobs it extends, in order.
+func (p *gitLabCIParser) resolveExtends(name string, seen map[string]bool, depth int) *yaml.Node {
+	job := yamlValue(p.root, name)
+	if job == nil || job.Kind != yaml.MappingNode {
+		return nil
+	}
+	extends := yamlValue(job, "extends")
+	if extends == nil || depth >= maxGitLabCIExtends {
+		return job
+	}
+	parents := []*yaml.Node{extends}
+	if extends.Kind == yaml.SequenceNode {
+		parents = extends.Content
+	}
+	var base *yaml.Node
+	for _, parent := range parents {
+		if parent.Kind != yaml.ScalarNode || seen[parent.Value] {
+			continue
+		}
+		seen[parent.Value] = true
+		if resolved := p.resolveExtends(parent.Value, seen, depth+1); resolved != nil {
+			base = p.merge(base, resolved)
+		}
+		delete(seen, parent.Value)
+	}
+	return p.merge(base, job)
+}
+
+// resolveReferences replaces the !reference tags of node by the values they
+// refer to. References which can't be resolved are dropped.
+func (p *gitLabCIParser) resolveReferences(node *yaml.Node, depth int) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	if node.Tag == "!reference" && node.Kind == yaml.SequenceNode {
+		if depth >= maxGitLabCIReferences || len(node.Content) == 0 {
+			return nil
+		}
+		target := p.resolveExtends(node.Content[0].Value, map[string]bool{node.Content[0].Value: true}, 0)
+		for _, key := range node.Content[1:] {
+			target = yamlValue(target, key.Value)
+		}
+		return p.resolveReferences(target, depth+1)
+	}
+	if node.Kind != yaml.MappingNode && node.Kind != yaml.SequenceNode {
+		return node
+	}
+	out := *node
+	out.Content = make([]*yaml.Node, 0, len(node.Content))
+	for i, c := range node.Content {
+		// keys of mappings aren't references.
+		isKey := node.Kind == yaml.MappingNode && i%2 == 0
+		if isKey {
+			out.Content = append(out.Content, c)
+			continue
+		}
+		resolved := p.resolveReferences(c, depth)
+		if resolved == nil {
+			if node.Kind == yaml.MappingNode {
+				out.Content = out.Content[:len(out.Content)-1]
+			}
+			continue
+		}
+		out.Content = append(out.Content, resolved)
+	}
+	p.paths[&out] = p.paths[node]
+	return &out
+}
+
+func (p *gitLabCIParser) parseJob(key, node, defaults *yaml.Node) GitLabCIJob {
+	job := GitLabCIJob{
+		Name:  key.Value,
+		Stage: yamlString(node, "stage"),
+		Path:  p.paths[key],
+		Line:  uint(key.Line),
+	}
+	if job.Stage == "" {
+		job.Stage = "test"
+	}
+	job.MergeRequests = p.runsForMergeRequests(node)
+
+	// keys missing from a job are inherited from default, or else the top level.
+	values := map[string]*yaml.Node{}
+	for _, k := range gitLabCIDefaultKeys {
+		if v := yamlValue(node, k); v != nil {
+			values[k] = v
+		} else if p.inheritsDefault(node, k) {
+			if v := yamlValue(defaults, k); v != nil {
+				values[k] = v
+			} else if v := yamlValue(p.root, k); v != nil {
+				values[k] = v
+			}
+		}
+		values[k] = p.resolveReferences(values[k], 0)
+	}
+	values["script"] = yamlValue(node, "script")
+
+	if image := p.parseImage(values["image"]); image != nil {
+		job.Image = image
+	}
+	for _, s := range yamlItems(values["services"]) {
+		if image := p.parseImage(s); image != nil {
+			job.Services = append(job.Services, *image)
+		}
+	}
+	for _, k := range gitLabCIScriptKeys {
+		job.Commands = p.appendCommands(job.Commands, values[k])
+	}
+	return job
+}
+
+// runsForMergeRequests reports whether a job runs in merge request pipelines,
+// which its only keyword or rules select, or else the rules of workflow.
+func (p *gitLabCIParser) runsForMergeRequests(job *yaml.Node) bool {
+	if only := yamlValue(job, "only"); only != nil {
+		if only.Kind == yaml.MappingNode {
+			only = yamlValue(only, "refs")
+		}
+		for _, ref := range yamlItems(only) {
+			if ref.Value == "merge_requests" {
+				return true
+			}
+		}
+		return false
+	}
+	rules := yamlValue(job, "rules")
+	if rules == nil {
+		rules = p.resolveReferences(yamlValue(yamlValue(p.root, "workflow"), "rules"), 0)
+	}
+	for _, rule := range yamlItems(rules) {
+		condition := yamlString(rule, "if")
+		if yamlString(rule, "when") == "never" {
+			continue
+		}
+		if strings.Contains(condition, "merge_request_event") ||
+			strings.TrimSpace(condition) == "$CI_MERGE_REQUEST_IID" {
+			return true
+		}
+	}
+	return false
+}
+
+// inheritsDefault reports whether a job inherits a key from default, which
+// inherit:default disables for all keys or only those it doesn't list.
+func (p *gitLabCIParser) inheritsDefault(job *yaml.Node, key string) bool {
+	inherit := yamlValue(yamlValue(job, "inherit"), "default")
+	switch {
+	case inherit == nil:
+		return true
+	case inherit.Kind == yaml.ScalarNode:
+		return inherit.Value != "false"
+	default:
+		for _, k := range yamlItems(inherit) {
+			if k.Value == key {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// parseImage parses an image given by name, or as a mapping with a name.
+func (p *gitLabCIParser) parseImage(node *yaml.Node) *GitLabCIImage {
+	if node != nil && node.Kind == yaml.MappingNode {
+		node = yamlValue(node, "name")
+	}
+	if node == nil || node.Kind != yaml.ScalarNode || node.Value == "" {
+		return nil
+	}
+	return &GitLabCIImage{Name: node.Value, Path: p.paths[node], Line: uint(node.Line)}
+}
+
+// appendCommands appends the commands of a script, which is a string or a
+// sequence of them, nested by !reference tags.
+func (p *gitLabCIParser) appendCommands(commands []GitLabCICommand, node *yaml.Node) []GitLabCICommand {
+	switch {
+	case node == nil:
+		return commands
+	case node.Kind == yaml.SequenceNode:
+		for _, c := range node.Content {
+			commands = p.appendCommands(commands, c)
+		}
+		return commands
+	case node.Kind == yaml.ScalarNode && node.Value != "":
+		line := uint(node.Line)
+		// block scalars start on the line after their indicator.
+		if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+			line++
+		}
+		return append(commands, GitLabCICommand{Command: node.Value, Path: p.paths[node], Line: line})
+	default:
+		return commands
+	}
+}
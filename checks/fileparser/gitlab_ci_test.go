@@ -0,0 +1,252 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseGitLabCI(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"ci/templates.yml": `include: /ci/base.yml
+.setup:
+  before_script:
+    - apt-get update
+build:
+  stage: build
+  script: make
+`,
+		"ci/base.yml": `.base:
+  image: golang:1.22
+  script:
+    - go build ./...
+include: ci/templates.yml
+`,
+	}
+	readFile := func(path string) ([]byte, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, fs.ErrNotExist
+		}
+		return []byte(content), nil
+	}
+
+	tests := []struct {
+		want    *GitLabCI
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "empty file",
+			content: "",
+			want:    &GitLabCI{},
+		},
+		{
+			name:    "not a mapping",
+			content: "- build",
+			wantErr: true,
+		},
+		{
+			name: "nested aliases",
+			content: `a: &a [x, x, x, x, x, x, x, x, x, x]
+b: &b [*a, *a, *a, *a, *a, *a, *a, *a, *a, *a]
+c: &c [*b, *b, *b, *b, *b, *b, *b, *b, *b, *b]
+d: &d [*c, *c, *c, *c, *c, *c, *c, *c, *c, *c]
+e: &e [*d, *d, *d, *d, *d, *d, *d, *d, *d, *d]
+f: &f [*e, *e, *e, *e, *e, *e, *e, *e, *e, *e]
+g: &g [*f, *f, *f, *f, *f, *f, *f, *f, *f, *f]
+h: &h [*g, *g, *g, *g, *g, *g, *g, *g, *g, *g]
+i: &i [*h, *h, *h, *h, *h, *h, *h, *h, *h, *h]
+build:
+  script: *i
+`,
+			wantErr: true,
+		},
+		{
+			name: "defaults and hidden jobs",
+			content: `image: alpine:3
+default:
+  image:
+    name: node:20
+  services:
+    - postgres:16
+    - name: redis:7
+.hidden:
+  script: echo hidden
+stages: [build, test]
+test:
+  script:
+    - npm ci
+    - |
+      npm test
+lint:
+  image: golangci/golangci-lint
+  inherit:
+    default: false
+  script: golangci-lint run
+`,
+			want: &GitLabCI{
+				Jobs: []GitLabCIJob{
+					{
+						Name:     "test",
+						Stage:    "test",
+						Path:     ".gitlab-ci.yml",
+						Line:     11,
+						Image:    &GitLabCIImage{Name: "node:20", Path: ".gitlab-ci.yml", Line: 4},
+						Services: []GitLabCIImage{{Name: "postgres:16", Path: ".gitlab-ci.yml", Line: 6}, {Name: "redis:7", Path: ".gitlab-ci.yml", Line: 7}},
+						Commands: []GitLabCICommand{
+							{Command: "npm ci", Path: ".gitlab-ci.yml", Line: 13},
+							{Command: "npm test\n", Path: ".gitlab-ci.yml", Line: 15},
+						},
+					},
+					{
+						Name:     "lint",
+						Stage:    "test",
+						Path:     ".gitlab-ci.yml",
+						Line:     16,
+						Image:    &GitLabCIImage{Name: "golangci/golangci-lint", Path: ".gitlab-ci.yml", Line: 17},
+						Commands: []GitLabCICommand{{Command: "golangci-lint run", Path: ".gitlab-ci.yml", Line: 20}},
+					},
+				},
+			},
+		},
+		{
+			name: "includes, extends, anchors and references",
+			content: `include:
+  - local: ci/base.yml
+  - project: group/project
+    file: ci.yml
+  - https://example.com/ci.yml
+.docker: &docker
+  image: docker:24
+  services: [docker:24-dind]
+release:
+  <<: *docker
+  stage: deploy
+  extends: [.base, .setup]
+  script:
+    - !reference [.base, script]
+    - docker push example/image
+build:
+  script: make all
+`,
+			want: &GitLabCI{
+				Jobs: []GitLabCIJob{
+					{
+						Name:     "release",
+						Stage:    "deploy",
+						Path:     ".gitlab-ci.yml",
+						Line:     9,
+						Image:    &GitLabCIImage{Name: "docker:24", Path: ".gitlab-ci.yml", Line: 7},
+						Services: []GitLabCIImage{{Name: "docker:24-dind", Path: ".gitlab-ci.yml", Line: 8}},
+						Commands: []GitLabCICommand{
+							{Command: "apt-get update", Path: "ci/templates.yml", Line: 4},
+							{Command: "go build ./...", Path: "ci/base.yml", Line: 4},
+							{Command: "docker push example/image", Path: ".gitlab-ci.yml", Line: 15},
+						},
+					},
+					{
+						Name:     "build",
+						Stage:    "build",
+						Path:     ".gitlab-ci.yml",
+						Line:     16,
+						Commands: []GitLabCICommand{{Command: "make all", Path: ".gitlab-ci.yml", Line: 17}},
+					},
+				},
+			},
+		},
+		{
+			name: "templates, components and merge request pipelines",
+			content: `include:
+  - template: Security/SAST.gitlab-ci.yml
+  - component: gitlab.com/components/secret-detection/secret-detection@1
+workflow:
+  rules:
+    - if: $CI_PIPELINE_SOURCE == "merge_request_event"
+    - if: $CI_COMMIT_BRANCH == $CI_DEFAULT_BRANCH
+test:
+  script: make test
+deploy:
+  rules:
+    - if: $CI_PIPELINE_SOURCE == "merge_request_event"
+      when: never
+    - if: $CI_COMMIT_TAG
+  script: make deploy
+review:
+  only: [merge_requests]
+  script: make review
+`,
+			want: &GitLabCI{
+				Includes: []GitLabCIInclude{
+					{Template: "Security/SAST.gitlab-ci.yml", Path: ".gitlab-ci.yml", Line: 2},
+					{
+						Component: "gitlab.com/components/secret-detection/secret-detection@1",
+						Path:      ".gitlab-ci.yml",
+						Line:      3,
+					},
+				},
+				Jobs: []GitLabCIJob{
+					{
+						Name:          "test",
+						Stage:         "test",
+						Path:          ".gitlab-ci.yml",
+						Line:          8,
+						Commands:      []GitLabCICommand{{Command: "make test", Path: ".gitlab-ci.yml", Line: 9}},
+						MergeRequests: true,
+					},
+					{
+						Name:     "deploy",
+						Stage:    "test",
+						Path:     ".gitlab-ci.yml",
+						Line:     10,
+						Commands: []GitLabCICommand{{Command: "make deploy", Path: ".gitlab-ci.yml", Line: 15}},
+					},
+					{
+						Name:          "review",
+						Stage:         "test",
+						Path:          ".gitlab-ci.yml",
+						Line:          16,
+						Commands:      []GitLabCICommand{{Command: "make review", Path: ".gitlab-ci.yml", Line: 18}},
+						MergeRequests: true,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseGitLabCI(GitLabCIFile, []byte(tt.content), readFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseGitLabCI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, errInvalidGitLabCI) {
+					t.Errorf("ParseGitLabCI() error = %v, want %v", err, errInvalidGitLabCI)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var errUnterminatedString = errors.New("unterminated string")
+
+// jenkinsShellSteps are the steps of Jenkins which run a script, keyed to the
+// program running it.
+var jenkinsShellSteps = map[string]string{
+	"sh":         "sh",
+	"bat":        "cmd",
+	"powershell": "powershell",
+	"pwsh":       "pwsh",
+}
+
+// jenkinsUntrustedPattern matches the environment variables of multibranch
+// pipelines which the authors of pull requests control. Groovy interpolates
+// them in double-quoted strings before the step runs.
+var jenkinsUntrustedPattern = regexp.MustCompile(`\$(\{\s*(env\.)?` + jenkinsUntrustedVariables + `\s*\}|` +
+	`(env\.)?` + jenkinsUntrustedVariables + `\b)`)
+
+const jenkinsUntrustedVariables = `(CHANGE_TITLE|CHANGE_BRANCH|CHANGE_AUTHOR_DISPLAY_NAME|CHANGE_AUTHOR_EMAIL)`
+
+type groovyTokenKind int
+
+const (
+	groovyIdentifier groovyTokenKind = iota
+	groovyString
+	groovyPunctuation
+)
+
+type groovyToken struct {
+	// text is the name of an identifier, the punctuation, or the value of a
+	// string with its escapes replaced.
+	text string
+	// raw is the content of a string as written.
+	raw  string
+	kind groovyTokenKind
+	line uint
+	// interpolated is whether a string is double-quoted.
+	interpolated bool
+}
+
+// parseJenkinsfile parses the stages of a declarative or scripted Jenkins
+// pipeline, the scripts of their steps, the images of their docker agents and
+// the shared libraries the pipeline loads. Groovy isn't evaluated, so scripts
+// built at runtime are missed.
+func parseJenkinsfile(content []byte) (*Pipeline, error) {
+	tokens, err := lexGroovy(string(content))
+	if err != nil {
+		return nil, err
+	}
+	p := &jenkinsParser{tokens: tokens, pipeline: &Pipeline{}, jobs: map[string]int{}}
+	p.parse()
+	return p.pipeline, nil
+}
+
+type jenkinsStage struct {
+	name  string
+	depth int
+}
+
+type jenkinsParser struct {
+	pipeline *Pipeline
+	// jobs are the indexes of the jobs of stages, by name.
+	jobs   map[string]int
+	tokens []groovyToken
+	stages []jenkinsStage
+}
+
+func (p *jenkinsParser) parse() {
+	depth := 0
+	var pending *jenkinsStage
+	for i := 0; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		// the block of a stage follows its name.
+		opened := pending
+		pending = nil
+		if t.kind == groovyPunctuation {
+			switch t.text {
+			case "{":
+				if opened != nil {
+					opened.depth = depth
+					p.stages = append(p.stages, *opened)
+				}
+				depth++
+			case "}":
+				depth--
+				if n := len(p.stages); n > 0 && p.stages[n-1].depth == depth {
+					p.stages = p.stages[:n-1]
+				}
+			}
+			continue
+		}
+		// methods of objects aren't steps, except docker.image.
+		if t.kind != groovyIdentifier || (p.token(i-1).text == "." && t.text != "image") {
+			continue
+		}
+		switch {
+		case t.text == "stage":
+			if s, ok := p.argument(i + 1); ok {
+				pending = &jenkinsStage{name: s.text}
+				p.job(s.text, t.line)
+				i = p.skipArguments(i + 1)
+			}
+		case jenkinsShellSteps[t.text] != "":
+			if s, ok := p.scriptArgument(i + 1); ok {
+				p.addCommand(s, jenkinsShellSteps[t.text])
+			}
+		case t.text == "image":
+			if s, ok := p.argument(i + 1); ok {
+				job := p.currentJob(t.line)
+				job.Images = append(job.Images, PipelineImage{Name: s.text, Line: s.line})
+			}
+		case t.text == "library" || (t.text == "Library" && p.token(i-1).text == "@"):
+			for _, s := range p.libraryArguments(i + 1) {
+				name, version, _ := strings.Cut(s.text, "@")
+				p.pipeline.Plugins = append(p.pipeline.Plugins, PipelinePlugin{
+					Name:    name,
+					Version: version,
+					Line:    s.line,
+					Pinned:  gitCommitHash.MatchString(version),
+				})
+			}
+		}
+	}
+}
+
+// job returns the job of a stage, which stages of the same name share.
+func (p *jenkinsParser) job(name string, line uint) *PipelineJob {
+	i, ok := p.jobs[name]
+	if !ok {
+		i = len(p.pipeline.Jobs)
+		p.jobs[name] = i
+		p.pipeline.Jobs = append(p.pipeline.Jobs, PipelineJob{Name: name, Line: line})
+	}
+	return &p.pipeline.Jobs[i]
+}
+
+// currentJob returns the job of the innermost stage, or the job of the
+// commands outside stages.
+func (p *jenkinsParser) currentJob(line uint) *PipelineJob {
+	if n := len(p.stages); n > 0 {
+		return p.job(p.stages[n-1].name, line)
+	}
+	return p.job("", line)
+}
+
+func (p *jenkinsParser) addCommand(s groovyToken, shell string) {
+	command := PipelineCommand{Command: s.text, Shell: shell, Line: s.line}
+	if s.interpolated {
+		command.Untrusted = findExpressions(jenkinsUntrustedPattern, s.raw, s.line, isGroovyEscaped)
+	}
+	job := p.currentJob(s.line)
+	job.Commands = append(job.Commands, command)
+}
+
+// token returns the token at i, or an empty punctuation out of range.
+func (p *jenkinsParser) token(i int) groovyToken {
+	if i >= 0 && i < len(p.tokens) {
+		return p.tokens[i]
+	}
+	return groovyToken{kind: groovyPunctuation}
+}
+
+// argument returns the string argument of a call with or without parentheses,
+// e.g. stage('build') or image 'golang'.
+func (p *jenkinsParser) argument(i int) (groovyToken, bool) {
+	if t := p.token(i); t.kind == groovyPunctuation && t.text == "(" {
+		i++
+	}
+	t := p.token(i)
+	return t, t.kind == groovyString
+}
+
+// skipArguments returns the index of the last token of the arguments of a
+// call at i.
+func (p *jenkinsParser) skipArguments(i int) int {
+	if t := p.token(i); t.kind != groovyPunctuation || t.text != "(" {
+		return i
+	}
+	for nested := 0; i < len(p.tokens); i++ {
+		switch p.tokens[i].text {
+		case "(":
+			nested++
+		case ")":
+			nested--
+			if nested == 0 {
+				return i
+			}
+		}
+	}
+	return i
+}
+
+// scriptArgument returns the script of a step, given as its first argument
+// or as the script argument, e.g. sh(script: 'make', returnStdout: true).
+func (p *jenkinsParser) scriptArgument(i int) (groovyToken, bool) {
+	if s, ok := p.argument(i); ok {
+		return s, true
+	}
+	end := p.skipArguments(i)
+	if end == i {
+		// the arguments of a call without parentheses end with its line.
+		line := p.token(i - 1).line
+		for end < len(p.tokens) && p.tokens[end].line == line {
+			end++
+		}
+	}
+	for j := i; j < end && j+2 < len(p.tokens); j++ {
+		if p.tokens[j].text == "script" && p.tokens[j+1].text == ":" && p.tokens[j+2].kind == groovyString {
+			return p.tokens[j+2], true
+		}
+	}
+	return groovyToken{}, false
+}
+
+// libraryArguments returns the libraries loaded by @Library or library, e.g.
+// @Library(['a@1.0', 'b']) or library identifier: 'c@main'.
+func (p *jenkinsParser) libraryArguments(i int) []groovyToken {
+	if t := p.token(i); t.text == "(" && t.kind == groovyPunctuation {
+		i++
+	}
+	if p.token(i).text == "identifier" && p.token(i+1).text == ":" {
+		i += 2
+	}
+	if t := p.token(i); t.kind == groovyString {
+		return []groovyToken{t}
+	}
+	if t := p.token(i); t.kind != groovyPunctuation || t.text != "[" {
+		return nil
+	}
+	var libraries []groovyToken
+	for i++; i < len(p.tokens); i++ {
+		switch t := p.tokens[i]; {
+		case t.kind == groovyString:
+			libraries = append(libraries, t)
+		case t.text == ",":
+		default:
+			return libraries
+		}
+	}
+	return libraries
+}
+
+// isGroovyEscaped reports whether the $ at start is escaped by a backslash.
+func isGroovyEscaped(s string, start int) bool {
+	backslashes := 0
+	for i := start - 1; i >= 0 && s[i] == '\\'; i-- {
+		backslashes++
+	}
+	return backslashes%2 == 1
+}
+
+// lexGroovy splits Groovy code into identifiers, strings and punctuation,
+// skipping comments. Slashy strings aren't recognized.
+func lexGroovy(code string) ([]groovyToken, error) {
+	var tokens []groovyToken
+	line := uint(1)
+	for i := 0; i < len(code); {
+		c := code[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(code[i:], "//"):
+			end := strings.IndexByte(code[i:], '\n')
+			if end < 0 {
+				end = len(code) - i
+			}
+			i += end
+		case strings.HasPrefix(code[i:], "/*"):
+			end := strings.Index(code[i+2:], "*/")
+			if end < 0 {
+				end = len(code) - i - 4
+			}
+			line += uint(strings.Count(code[i:i+end+4], "\n"))
+			i += end + 4
+		case c == '\'' || c == '"':
+			t, n, err := lexGroovyString(code[i:])
+			if err != nil {
+				return nil, err
+			}
+			t.line = line
+			tokens = append(tokens, t)
+			line += uint(strings.Count(code[i:i+n], "\n"))
+			i += n
+		case isGroovyIdentifierStart(c):
+			j := i + 1
+			for j < len(code) && (isGroovyIdentifierStart(code[j]) || code[j] >= '0' && code[j] <= '9') {
+				j++
+			}
+			tokens = append(tokens, groovyToken{kind: groovyIdentifier, text: code[i:j], line: line})
+			i = j
+		default:
+			tokens = append(tokens, groovyToken{kind: groovyPunctuation, text: string(c), line: line})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+func isGroovyIdentifierStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// lexGroovyString lexes the string at the start of s, which is quoted by a
+// single or double quote, or by three of them, and returns it with the length
+// it spans. The expressions interpolated in double-quoted strings are kept as
+// written.
+func lexGroovyString(s string) (groovyToken, int, error) {
+	quote := s[:1]
+	if strings.HasPrefix(s, quote+quote+quote) {
+		quote = s[:3]
+	}
+	t := groovyToken{kind: groovyString, interpolated: quote[0] == '"'}
+	var value strings.Builder
+	start := len(quote)
+	for i := start; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			value.WriteString(groovyEscape(s[i]))
+		case t.interpolated && strings.HasPrefix(s[i:], "${"):
+			end := i + 2
+			for nested := 1; end < len(s) && nested > 0; end++ {
+				switch s[end] {
+				case '{':
+					nested++
+				case '}':
+					nested--
+				}
+			}
+			value.WriteString(s[i:end])
+			i = end - 1
+		case strings.HasPrefix(s[i:], quote):
+			t.text = value.String()
+			t.raw = s[start:i]
+			return t, i + len(quote), nil
+		case s[i] == '\n' && len(quote) == 1:
+			return groovyToken{}, 0, errUnterminatedString
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+	return groovyToken{}, 0, errUnterminatedString
+}
+
+// groovyEscape returns the character of an escape sequence. A backslash at
+// the end of a line continues the line.
+func groovyEscape(c byte) string {
+	switch c {
+	case 'n':
+		return "\n"
+	case 't':
+		return "\t"
+	case 'r':
+		return "\r"
+	case '\n':
+		return ""
+	default:
+		return string(c)
+	}
+}
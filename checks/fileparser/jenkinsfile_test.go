@@ -0,0 +1,102 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseJenkinsfile(t *testing.T) {
+	t.Parallel()
+	content := `@Library(['shared@0123456789abcdef0123456789abcdef01234567', 'utils']) _
+// sh 'commented out'
+pipeline {
+  agent { docker { image 'maven:3.9' } }
+  stages {
+    stage('Build') {
+      steps {
+        sh 'mvn -B package'
+        sh """
+          echo "${env.CHANGE_TITLE}" \${CHANGE_BRANCH}
+        """
+      }
+    }
+    stage("Test") {
+      steps {
+        script {
+          docker.image('golang:1.22').inside {
+            sh(script: "go test ./...", returnStdout: true)
+          }
+        }
+        bat 'test.bat'
+      }
+    }
+  }
+  post {
+    always {
+      /* clean up
+         the workspace */
+      sh label: 'clean', script: 'make clean'
+    }
+  }
+}
+`
+	want := &Pipeline{
+		System: Jenkins,
+		Path:   "Jenkinsfile",
+		Plugins: []PipelinePlugin{
+			{Name: "shared", Version: "0123456789abcdef0123456789abcdef01234567", Line: 1, Pinned: true},
+			{Name: "utils", Line: 1},
+		},
+		Jobs: []PipelineJob{
+			{
+				Line:     4,
+				Images:   []PipelineImage{{Name: "maven:3.9", Line: 4}},
+				Commands: []PipelineCommand{{Command: "make clean", Shell: "sh", Line: 29}},
+			},
+			{
+				Name: "Build",
+				Line: 6,
+				Commands: []PipelineCommand{
+					{Command: "mvn -B package", Shell: "sh", Line: 8},
+					{
+						Command:   "\n          echo \"${env.CHANGE_TITLE}\" ${CHANGE_BRANCH}\n        ",
+						Shell:     "sh",
+						Untrusted: []PipelineExpression{{Expression: "${env.CHANGE_TITLE}", Line: 10}},
+						Line:      9,
+					},
+				},
+			},
+			{
+				Name:   "Test",
+				Line:   14,
+				Images: []PipelineImage{{Name: "golang:1.22", Line: 17}},
+				Commands: []PipelineCommand{
+					{Command: "go test ./...", Shell: "sh", Line: 18},
+					{Command: "test.bat", Shell: "cmd", Line: 21},
+				},
+			},
+		},
+	}
+	got, err := ParsePipeline("Jenkinsfile", []byte(content))
+	if err != nil {
+		t.Fatalf("ParsePipeline() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// PipelineSystem is a CI system whose configuration is parsed into a Pipeline.
+type PipelineSystem string
+
+const (
+	// CircleCI is configured by .circleci/config.yml.
+	CircleCI PipelineSystem = "CircleCI"
+	// Jenkins is configured by a Jenkinsfile.
+	Jenkins PipelineSystem = "Jenkins"
+	// Buildkite is configured by the pipelines of the .buildkite directory.
+	Buildkite PipelineSystem = "Buildkite"
+	// Tekton is configured by the Task and Pipeline resources of the .tekton
+	// or tekton directories.
+	Tekton PipelineSystem = "Tekton"
+)
+
+// Pipeline is the configuration of a CI system, normalized to the parts used
+// by checks: the jobs, the images they run in, the commands they run and the
+// plugins they use.
+type Pipeline struct {
+	System PipelineSystem
+	Path   string
+	Jobs   []PipelineJob
+	// Plugins are the orbs, plugins, shared libraries and remote tasks of the
+	// pipeline, which are fetched from other repositories or registries.
+	Plugins []PipelinePlugin
+}
+
+// PipelineJob is a job of a pipeline: a CircleCI job, a Jenkins stage, a
+// Buildkite command step or a Tekton task.
+type PipelineJob struct {
+	// Name is empty for the commands of a Jenkinsfile outside stages.
+	Name string
+	// Images are the container images the job and its services run in.
+	Images   []PipelineImage
+	Commands []PipelineCommand
+	Line     uint
+}
+
+// PipelineImage is a container image of a job.
+type PipelineImage struct {
+	Name string
+	Line uint
+}
+
+// PipelinePlugin is a dependency of a pipeline on an orb, plugin, shared
+// library or remote task.
+type PipelinePlugin struct {
+	// Name is the plugin as written, e.g. circleci/node or docker.
+	Name    string
+	Version string
+	Line    uint
+	// Pinned is whether Version refers to a single immutable release: a
+	// commit hash, an image digest, or the full version of a CircleCI orb.
+	Pinned bool
+}
+
+// PipelineCommand is a command, or a script, of a job.
+type PipelineCommand struct {
+	// Command is the command as written. The expressions which the CI system
+	// substitutes aren't, e.g. Groovy interpolations or CircleCI parameters.
+	Command string
+	// Shell is the name of the program running Command, e.g. bash or cmd, and
+	// is empty if Command is a program and its arguments.
+	Shell string
+	// Untrusted are the expressions of Command which the CI system replaces by
+	// values the authors of commits and pull requests control, before Command
+	// runs.
+	Untrusted []PipelineExpression
+	Line      uint
+}
+
+// PipelineExpression is an expression of a command and the line it is on.
+type PipelineExpression struct {
+	Expression string
+	Line       uint
+}
+
+// IsPipelineFile determines if a file is the configuration of a CI system
+// parsed into a Pipeline, as a callback to use for repo client's ListFiles()
+// API.
+func IsPipelineFile(pathfn string) (bool, error) {
+	_, ok := pipelineSystem(pathfn)
+	return ok, nil
+}
+
+func pipelineSystem(pathfn string) (PipelineSystem, bool) {
+	dir, base := path.Split(pathfn)
+	dir = strings.TrimSuffix(dir, "/")
+	isYAML := path.Ext(base) == ".yml" || path.Ext(base) == ".yaml"
+	switch {
+	case pathfn == ".circleci/config.yml":
+		return CircleCI, true
+	case base == "Jenkinsfile" || strings.HasSuffix(base, ".Jenkinsfile"):
+		return Jenkins, true
+	case isYAML && (dir == ".buildkite" || pathfn == "buildkite.yml" || pathfn == "buildkite.yaml"):
+		return Buildkite, true
+	case isYAML && (dir == ".tekton" || dir == "tekton" || strings.HasPrefix(dir, ".tekton/") ||
+		strings.HasPrefix(dir, "tekton/")):
+		return Tekton, true
+	default:
+		return "", false
+	}
+}
+
+// ParsePipeline parses the configuration of a CI system at pathfn.
+func ParsePipeline(pathfn string, content []byte) (*Pipeline, error) {
+	system, ok := pipelineSystem(pathfn)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown CI system: %s", errInvalidPipeline, pathfn)
+	}
+	var pipeline *Pipeline
+	var err error
+	switch system {
+	case CircleCI:
+		pipeline, err = parseCircleCI(content)
+	case Jenkins:
+		pipeline, err = parseJenkinsfile(content)
+	case Buildkite:
+		pipeline, err = parseBuildkite(content)
+	case Tekton:
+		pipeline, err = parseTekton(content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", errInvalidPipeline, system, err)
+	}
+	pipeline.System = system
+	pipeline.Path = pathfn
+	return pipeline, nil
+}
+
+// ReadPipelines parses the configurations of the CI systems of a repository.
+// Configurations which aren't valid are skipped, as the CI systems don't run
+// them either.
+func ReadPipelines(c clients.RepoClient) ([]Pipeline, error) {
+	files, err := c.ListFiles(IsPipelineFile)
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	var pipelines []Pipeline
+	for _, pathfn := range files {
+		if _, ok := pipelineSystem(pathfn); !ok {
+			continue
+		}
+		r, err := c.GetFileReader(pathfn)
+		if err != nil {
+			return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading from file: %w", err)
+		}
+		pipeline, err := ParsePipeline(pathfn, content)
+		if err != nil {
+			continue
+		}
+		pipelines = append(pipelines, *pipeline)
+	}
+	return pipelines, nil
+}
+
+// parseYAMLMapping parses a YAML document, which must be a mapping if it
+// isn't empty.
+func parseYAMLMapping(content []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err //nolint:wrapcheck // wrapped by ParsePipeline.
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode}, nil
+	}
+	if root := doc.Content[0]; root.Kind == yaml.MappingNode {
+		return root, nil
+	}
+	return nil, errExpectedMapping
+}
+
+// scriptLine returns the line of the first line of a script. Block scalars
+// start on the line after their indicator.
+func scriptLine(node *yaml.Node) uint {
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		return uint(node.Line) + 1
+	}
+	return uint(node.Line)
+}
+
+// programName returns the name of the program of a command line, e.g. bash
+// for /bin/bash -eo pipefail, or python3 for /usr/bin/env python3.
+func programName(command string) string {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return ""
+	}
+	name := path.Base(fields[0])
+	if name == "env" && len(fields) > 1 {
+		name = path.Base(fields[1])
+	}
+	return name
+}
+
+// findExpressions returns the matches of pattern in a command starting on
+// line, except those which escaped reports to be escaped.
+func findExpressions(pattern *regexp.Regexp, command string, line uint,
+	escaped func(command string, start int) bool,
+) []PipelineExpression {
+	var expressions []PipelineExpression
+	for _, match := range pattern.FindAllStringIndex(command, -1) {
+		if escaped != nil && escaped(command, match[0]) {
+			continue
+		}
+		expressions = append(expressions, PipelineExpression{
+			Expression: command[match[0]:match[1]],
+			Line:       line + uint(strings.Count(command[:match[0]], "\n")),
+		})
+	}
+	return expressions
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsPipelineFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: ".circleci/config.yml", want: true},
+		{path: ".circleci/other.yml", want: false},
+		{path: "Jenkinsfile", want: true},
+		{path: "ci/release.Jenkinsfile", want: true},
+		{path: "Jenkinsfile.md", want: false},
+		{path: ".buildkite/pipeline.yml", want: true},
+		{path: ".buildkite/hooks/pre-command", want: false},
+		{path: "buildkite.yaml", want: true},
+		{path: ".tekton/pull-request.yaml", want: true},
+		{path: "tekton/tasks/build.yml", want: true},
+		{path: "docs/tekton.yaml", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			got, err := IsPipelineFile(tt.path)
+			if err != nil {
+				t.Fatalf("IsPipelineFile() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsPipelineFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePipeline(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want    *Pipeline
+		name    string
+		path    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "empty CircleCI configuration",
+			path:    ".circleci/config.yml",
+			content: "",
+			want:    &Pipeline{System: CircleCI, Path: ".circleci/config.yml"},
+		},
+		{
+			name:    "CircleCI configuration not a mapping",
+			path:    ".circleci/config.yml",
+			content: "- build",
+			wantErr: true,
+		},
+		{
+			name:    "unknown file",
+			path:    "README.md",
+			content: "",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated Groovy string",
+			path:    "Jenkinsfile",
+			content: "sh 'make\n",
+			wantErr: true,
+		},
+		{
+			name:    "Buildkite steps in the older format",
+			path:    ".buildkite/pipeline.yml",
+			content: "- command: make\n",
+			want: &Pipeline{
+				System: Buildkite,
+				Path:   ".buildkite/pipeline.yml",
+				Jobs: []PipelineJob{
+					{Line: 1, Commands: []PipelineCommand{{Command: "make", Shell: "bash", Line: 1}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParsePipeline(tt.path, []byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePipeline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, errInvalidPipeline) {
+					t.Errorf("ParsePipeline() error = %v, want %v", err, errInvalidPipeline)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
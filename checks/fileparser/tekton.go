@@ -0,0 +1,208 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// tektonDefaultShell runs the scripts of steps without a shebang.
+const tektonDefaultShell = "sh"
+
+// tektonUntrustedPattern matches the dynamic variables of Pipelines-as-Code
+// which the authors of pull requests control. They are substituted in the
+// resources of the .tekton directory before these run.
+var tektonUntrustedPattern = regexp.MustCompile(
+	`\{\{\s*(source_branch|body\.[\w.]+|headers\.[\w.-]+)\s*\}\}`)
+
+// imageDigest matches an image, or a bundle, pinned by digest.
+var imageDigest = regexp.MustCompile(`@sha256:[a-f\d]{64}$`)
+
+// parseTekton parses the Task, TaskRun, Pipeline and PipelineRun resources of
+// a file. Each task, and each task embedded in a pipeline, is a job. Other
+// resources, e.g. those of Tekton Triggers, are skipped.
+func parseTekton(content []byte) (*Pipeline, error) {
+	pipeline := &Pipeline{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err //nolint:wrapcheck // wrapped by ParsePipeline.
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		resource := doc.Content[0]
+		if !strings.HasPrefix(yamlString(resource, "apiVersion"), "tekton.dev/") {
+			continue
+		}
+		spec := yamlValue(resource, "spec")
+		switch yamlString(resource, "kind") {
+		case "Task", "ClusterTask":
+			name := yamlString(yamlValue(resource, "metadata"), "name")
+			addTektonTask(pipeline, name, spec, uint(resource.Line))
+		case "TaskRun":
+			name := yamlString(yamlValue(resource, "metadata"), "name")
+			addTektonRef(pipeline, yamlValue(spec, "taskRef"))
+			if taskSpec := yamlValue(spec, "taskSpec"); taskSpec != nil {
+				addTektonTask(pipeline, name, taskSpec, uint(taskSpec.Line))
+			}
+		case "Pipeline":
+			addTektonPipeline(pipeline, spec)
+		case "PipelineRun":
+			addTektonRef(pipeline, yamlValue(spec, "pipelineRef"))
+			addTektonPipeline(pipeline, yamlValue(spec, "pipelineSpec"))
+		default:
+		}
+	}
+	return pipeline, nil
+}
+
+// addTektonPipeline adds the tasks of a pipeline, which are embedded in it
+// or referred to.
+func addTektonPipeline(pipeline *Pipeline, spec *yaml.Node) {
+	for _, key := range []string{"tasks", "finally"} {
+		for _, task := range yamlItems(yamlValue(spec, key)) {
+			addTektonRef(pipeline, yamlValue(task, "taskRef"))
+			if taskSpec := yamlValue(task, "taskSpec"); taskSpec != nil {
+				addTektonTask(pipeline, yamlString(task, "name"), taskSpec, uint(task.Line))
+			}
+		}
+	}
+}
+
+// addTektonTask adds a task, whose steps and sidecars run in containers.
+func addTektonTask(pipeline *Pipeline, name string, spec *yaml.Node, line uint) {
+	job := PipelineJob{Name: name, Line: line}
+	containers := []*yaml.Node{yamlValue(spec, "stepTemplate")}
+	containers = append(containers, yamlItems(yamlValue(spec, "steps"))...)
+	containers = append(containers, yamlItems(yamlValue(spec, "sidecars"))...)
+	for _, c := range containers {
+		if image := yamlValue(c, "image"); image != nil && image.Kind == yaml.ScalarNode {
+			job.Images = append(job.Images, PipelineImage{Name: image.Value, Line: uint(image.Line)})
+		}
+		if command, ok := parseTektonCommand(c); ok {
+			job.Commands = append(job.Commands, command)
+		}
+	}
+	pipeline.Jobs = append(pipeline.Jobs, job)
+}
+
+// parseTektonCommand parses the script of a container, or its command and
+// arguments. The last argument of a shell run with -c is a script.
+func parseTektonCommand(node *yaml.Node) (PipelineCommand, bool) {
+	if script := yamlValue(node, "script"); script != nil && script.Kind == yaml.ScalarNode && script.Value != "" {
+		shell := tektonDefaultShell
+		if first, _, _ := strings.Cut(script.Value, "\n"); strings.HasPrefix(first, "#!") {
+			shell = programName(strings.TrimPrefix(first, "#!"))
+		}
+		return newTektonCommand(script.Value, shell, scriptLine(script)), true
+	}
+
+	var argv []*yaml.Node
+	argv = append(argv, yamlItems(yamlValue(node, "command"))...)
+	argv = append(argv, yamlItems(yamlValue(node, "args"))...)
+	if len(argv) == 0 {
+		return PipelineCommand{}, false
+	}
+	if len(argv) >= 3 && argv[len(argv)-2].Value == "-c" && isShellProgram(programName(argv[0].Value)) {
+		script := argv[len(argv)-1]
+		return newTektonCommand(script.Value, programName(argv[0].Value), scriptLine(script)), true
+	}
+	args := make([]string, 0, len(argv))
+	for _, a := range argv {
+		args = append(args, a.Value)
+	}
+	return newTektonCommand(strings.Join(args, " "), "", uint(argv[0].Line)), true
+}
+
+func newTektonCommand(command, shell string, line uint) PipelineCommand {
+	return PipelineCommand{
+		Command:   command,
+		Shell:     shell,
+		Untrusted: findExpressions(tektonUntrustedPattern, command, line, nil),
+		Line:      line,
+	}
+}
+
+func isShellProgram(name string) bool {
+	switch name {
+	case "sh", "bash", "dash", "ash", "zsh", "ksh":
+		return true
+	default:
+		return false
+	}
+}
+
+// addTektonRef adds the remote task or pipeline a reference resolves, from a
+// bundle, a git repository, Tekton Hub or a URL. Resources of the cluster
+// aren't remote.
+func addTektonRef(pipeline *Pipeline, ref *yaml.Node) {
+	if ref == nil {
+		return
+	}
+	params := map[string]string{}
+	for _, p := range yamlItems(yamlValue(ref, "params")) {
+		params[yamlString(p, "name")] = yamlString(p, "value")
+	}
+	plugin := PipelinePlugin{Line: uint(ref.Line)}
+	switch resolver := yamlString(ref, "resolver"); {
+	// the bundle key is the older syntax of the bundles resolver.
+	case resolver == "bundles" || yamlString(ref, "bundle") != "":
+		bundle := params["bundle"]
+		if bundle == "" {
+			bundle = yamlString(ref, "bundle")
+		}
+		plugin.Name, plugin.Version = splitImage(bundle)
+		plugin.Pinned = imageDigest.MatchString(bundle)
+	case resolver == "git":
+		plugin.Name = params["url"]
+		if plugin.Name == "" {
+			plugin.Name = params["org"] + "/" + params["repo"]
+		}
+		plugin.Name += "/" + strings.TrimPrefix(params["pathInRepo"], "/")
+		plugin.Version = params["revision"]
+		plugin.Pinned = gitCommitHash.MatchString(plugin.Version)
+	case resolver == "hub":
+		plugin.Name = params["name"]
+		plugin.Version = params["version"]
+	case resolver == "http":
+		plugin.Name = params["url"]
+	default:
+		return
+	}
+	pipeline.Plugins = append(pipeline.Plugins, plugin)
+}
+
+// splitImage splits an image into its name and its digest or tag.
+func splitImage(image string) (string, string) {
+	if name, digest, ok := strings.Cut(image, "@"); ok {
+		return name, digest
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+	return image, ""
+}
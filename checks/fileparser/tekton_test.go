@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTekton(t *testing.T) {
+	t.Parallel()
+	content := `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+spec:
+  stepTemplate:
+    image: golang:1.22
+  steps:
+    - name: build
+      script: |
+        #!/usr/bin/env bash
+        echo "{{ source_branch }}"
+    - name: push
+      image: gcr.io/kaniko-project/executor:v1.23.0
+      command: [/kaniko/executor]
+      args: ["--destination", "example/image"]
+  sidecars:
+    - image: docker:dind
+---
+apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: ci
+spec:
+  pipelineSpec:
+    tasks:
+      - name: clone
+        taskRef:
+          resolver: bundles
+          params:
+            - name: bundle
+              value: gcr.io/tekton-releases/catalog/upstream/git-clone:0.9
+            - name: name
+              value: git-clone
+      - name: lint
+        taskRef:
+          resolver: git
+          params:
+            - name: url
+              value: https://github.com/tektoncd/catalog.git
+            - name: revision
+              value: 0123456789abcdef0123456789abcdef01234567
+            - name: pathInRepo
+              value: task/golangci-lint/0.2/golangci-lint.yaml
+      - name: test
+        taskSpec:
+          steps:
+            - image: golang:1.22
+              command: [sh, -c]
+              args: ["go test ./..."]
+    finally:
+      - name: notify
+        taskRef:
+          name: local-task
+---
+apiVersion: triggers.tekton.dev/v1beta1
+kind: TriggerTemplate
+`
+	want := &Pipeline{
+		System: Tekton,
+		Path:   ".tekton/pull-request.yaml",
+		Plugins: []PipelinePlugin{
+			{Name: "gcr.io/tekton-releases/catalog/upstream/git-clone", Version: "0.9", Line: 29},
+			{
+				Name:    "https://github.com/tektoncd/catalog.git/task/golangci-lint/0.2/golangci-lint.yaml",
+				Version: "0123456789abcdef0123456789abcdef01234567",
+				Line:    37,
+				Pinned:  true,
+			},
+		},
+		Jobs: []PipelineJob{
+			{
+				Name: "build",
+				Line: 1,
+				Images: []PipelineImage{
+					{Name: "golang:1.22", Line: 7},
+					{Name: "gcr.io/kaniko-project/executor:v1.23.0", Line: 14},
+					{Name: "docker:dind", Line: 18},
+				},
+				Commands: []PipelineCommand{
+					{
+						Command:   "#!/usr/bin/env bash\necho \"{{ source_branch }}\"\n",
+						Shell:     "bash",
+						Untrusted: []PipelineExpression{{Expression: "{{ source_branch }}", Line: 12}},
+						Line:      11,
+					},
+					{Command: "/kaniko/executor --destination example/image", Line: 15},
+				},
+			},
+			{
+				Name:     "test",
+				Line:     45,
+				Images:   []PipelineImage{{Name: "golang:1.22", Line: 48}},
+				Commands: []PipelineCommand{{Command: "go test ./...", Shell: "sh", Line: 50}},
+			},
+		},
+	}
+	got, err := ParsePipeline(".tekton/pull-request.yaml", []byte(content))
+	if err != nil {
+		t.Fatalf("ParsePipeline() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
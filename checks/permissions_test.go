@@ -530,3 +530,32 @@ func TestGithubTokenPermissionsLineNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestGithubTokenPermissions_preconditions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().GetDefaultBranchName().Return("main", nil).AnyTimes()
+	mockRepo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+	mockRepo.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
+	c := checker.CheckRequest{
+		RepoClient: mockRepo,
+		Dlogger:    &scut.TestDetailLogger{},
+		RawResults: &checker.RawResults{},
+	}
+
+	// a project without workflows keeps the score of its not applicable probes,
+	// with the reason given by the unmet precondition.
+	result := TokenPermissions(&c)
+	if result.Score != checker.InconclusiveResultScore {
+		t.Errorf("score with unmet precondition = %d, want %d", result.Score, checker.InconclusiveResultScore)
+	}
+	if len(result.Findings) == 0 {
+		t.Fatal("no findings with unmet precondition")
+	}
+	for i := range result.Findings {
+		if result.Findings[i].Message != "project has no CI workflows" {
+			t.Errorf("unexpected finding %v", result.Findings[i])
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package azuredevops collects the raw data of checks from Azure Pipelines.
+package azuredevops
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// packagingTasks are the tasks which publish packages with one of the
+// commands, keyed by task name.
+var packagingTasks = map[string][]string{
+	"Docker":        {"push", "buildAndPush"},
+	"DotNetCoreCLI": {"push"},
+	"NuGetCommand":  {"push"},
+	"Npm":           {"publish"},
+}
+
+// packagingCommands are the commands of script steps which publish packages.
+var packagingCommands = []string{
+	"docker push",
+	"npm publish",
+	"nuget push",
+	"poetry publish",
+	"twine upload",
+}
+
+// Packaging checks for packages published by Azure Pipelines.
+func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
+	var data checker.PackagingData
+	matchedFiles, err := c.RepoClient.ListFiles(fileparser.IsAzurePipelinesFile)
+	if err != nil {
+		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+
+	for _, fp := range matchedFiles {
+		fr, err := c.RepoClient.GetFileReader(fp)
+		if err != nil {
+			return data, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		fc, err := io.ReadAll(fr)
+		fr.Close()
+		if err != nil {
+			return data, fmt.Errorf("reading from file: %w", err)
+		}
+
+		pipeline, err := fileparser.ParseAzurePipeline(fc)
+		if err != nil {
+			// pipelines of other tools may share the conventional locations.
+			continue
+		}
+		if job, line, found := findPackagingStep(pipeline); found {
+			data.Packages = append(data.Packages, checker.Package{
+				Name: new(string),
+				Job:  &checker.WorkflowJob{Name: &job},
+				File: &checker.File{
+					Path:   fp,
+					Offset: line,
+					Type:   finding.FileTypeSource,
+				},
+				Runs: []checker.Run{{URL: c.Repo.URI()}},
+			})
+			return data, nil
+		}
+	}
+
+	return data, nil
+}
+
+// findPackagingStep returns the job and line of the first step which
+// publishes a package.
+func findPackagingStep(pipeline *fileparser.AzurePipeline) (string, uint, bool) {
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		for j := range job.Steps {
+			if isPackagingStep(&job.Steps[j]) {
+				return job.Name, job.Steps[j].Line, true
+			}
+		}
+	}
+	return "", checker.OffsetDefault, false
+}
+
+func isPackagingStep(step *fileparser.AzurePipelineStep) bool {
+	if step.Task == "" {
+		for _, c := range packagingCommands {
+			if strings.Contains(step.Script, c) {
+				return true
+			}
+		}
+		return false
+	}
+
+	command, ok := step.Inputs["command"]
+	switch name := step.TaskName(); {
+	// Docker@2 builds and pushes images by default.
+	case name == "Docker" && !ok:
+		return true
+	case name == "Maven":
+		return strings.Contains(step.Inputs["goals"], "deploy")
+	default:
+		for _, c := range packagingTasks[name] {
+			if strings.EqualFold(command, c) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuredevops
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestAzurePackagingPipeline(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		job        string
+		lineNumber uint
+		filename   string
+		exists     bool
+	}{
+		{
+			name:       "No Publishing Detected",
+			filename:   "./testdata/no-publishing.yml",
+			lineNumber: checker.OffsetDefault,
+			exists:     false,
+		},
+		{
+			name:       "Docker task pushes by default",
+			filename:   "./testdata/docker.yml",
+			job:        "image",
+			lineNumber: 18,
+			exists:     true,
+		},
+		{
+			name:       "npm publish command",
+			filename:   "./testdata/npm.yml",
+			job:        "build",
+			lineNumber: 10,
+			exists:     true,
+		},
+		{
+			name:       "Twine script",
+			filename:   "./testdata/twine.yml",
+			lineNumber: 11,
+			exists:     true,
+		},
+		{
+			name:       "deployment job",
+			filename:   "./testdata/deployment.yml",
+			job:        "publish",
+			lineNumber: 11,
+			exists:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			pipeline, err := fileparser.ParseAzurePipeline(content)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+
+			job, line, found := findPackagingStep(pipeline)
+
+			if tt.exists && !found {
+				t.Errorf("Packaging %q should exist", tt.name)
+			} else if !tt.exists && found {
+				t.Errorf("No packaging information should have been found in %q", tt.name)
+			}
+			if job != tt.job {
+				t.Errorf("Expected job: %q != %q", tt.job, job)
+			}
+			if line != tt.lineNumber {
+				t.Errorf("Expected line number: %d != %d", tt.lineNumber, line)
+			}
+		})
+	}
+}
+
+func TestAzurePackaging(t *testing.T) {
+	t.Parallel()
+	const filename = "./testdata/docker.yml"
+
+	ctrl := gomock.NewController(t)
+	moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	moqRepo := mockrepo.NewMockRepo(ctrl)
+
+	moqRepoClient.EXPECT().ListFiles(gomock.Any()).
+		Return([]string{filename}, nil).AnyTimes()
+	moqRepoClient.EXPECT().GetFileReader(filename).
+		DoAndReturn(func(b string) (io.ReadCloser, error) {
+			return os.Open(b)
+		}).AnyTimes()
+	moqRepo.EXPECT().URI().Return("dev.azure.com/org/project/_git/repo")
+
+	req := checker.CheckRequest{
+		RepoClient: moqRepoClient,
+		Repo:       moqRepo,
+	}
+	packagingData, err := Packaging(&req)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(packagingData.Packages) != 1 {
+		t.Fatalf("Expected a single package, but received %d", len(packagingData.Packages))
+	}
+	pkg := packagingData.Packages[0]
+	if pkg.File.Path != filename || pkg.File.Offset != 18 {
+		t.Errorf("Expected %s:18, but received %s:%d", filename, pkg.File.Path, pkg.File.Offset)
+	}
+	if len(pkg.Runs) != 1 || pkg.Runs[0].URL != "dev.azure.com/org/project/_git/repo" {
+		t.Errorf("Unexpected runs %v", pkg.Runs)
+	}
+}
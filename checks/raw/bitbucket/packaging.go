@@ -0,0 +1,91 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bitbucket collects the raw data of checks from Bitbucket Pipelines.
+package bitbucket
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Packaging checks for packages published by Bitbucket Pipelines.
+func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
+	var data checker.PackagingData
+	matchedFiles, err := c.RepoClient.ListFiles(fileparser.IsBitbucketPipelinesFile)
+	if err != nil {
+		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+
+	for _, fp := range matchedFiles {
+		fr, err := c.RepoClient.GetFileReader(fp)
+		if err != nil {
+			return data, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		fc, err := io.ReadAll(fr)
+		fr.Close()
+		if err != nil {
+			return data, fmt.Errorf("reading from file: %w", err)
+		}
+
+		if file, found := isBitbucketPackagingPipeline(fc, fp); found {
+			data.Packages = append(data.Packages, checker.Package{
+				Name: new(string),
+				Job:  &checker.WorkflowJob{},
+				File: &file,
+				Runs: []checker.Run{{URL: c.Repo.URI()}},
+			})
+			return data, nil
+		}
+	}
+
+	return data, nil
+}
+
+func isBitbucketPackagingPipeline(fc []byte, fp string) (checker.File, bool) {
+	lineNumber := checker.OffsetDefault
+
+	packagingStrings := []string{
+		"docker push",
+		"npm publish",
+		"nuget push",
+		"poetry publish",
+		"twine upload",
+		// pipes maintained by Atlassian.
+		"atlassian/npm-publish",
+		"atlassian/pypi-publish",
+		"atlassian/aws-ecr-push-image",
+	}
+
+ParseLines:
+	for idx, val := range strings.Split(string(fc), "\n") {
+		for _, element := range packagingStrings {
+			if strings.Contains(val, element) {
+				lineNumber = uint(idx + 1)
+				break ParseLines
+			}
+		}
+	}
+
+	return checker.File{
+		Path:   fp,
+		Offset: lineNumber,
+		Type:   finding.FileTypeSource,
+	}, lineNumber != checker.OffsetDefault
+}
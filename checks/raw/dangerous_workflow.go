@@ -15,14 +15,18 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/rhysd/actionlint"
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v5/clients"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 )
@@ -66,6 +70,58 @@ func containsUntrustedContextPattern(variable string) bool {
 	return strings.Contains(variable, "github.event.") && untrustedContextPattern.MatchString(variable)
 }
 
+// gitLabCIUntrustedVariablePattern matches the predefined variables of GitLab
+// CI which the authors of commits and merge requests control.
+var gitLabCIUntrustedVariablePattern = regexp.MustCompile(`\$\{?(` +
+	`CI_COMMIT_BRANCH|` +
+	`CI_COMMIT_DESCRIPTION|` +
+	`CI_COMMIT_MESSAGE|` +
+	`CI_COMMIT_REF_NAME|` +
+	`CI_COMMIT_TAG_MESSAGE|` +
+	`CI_COMMIT_TITLE|` +
+	`CI_COMMIT_AUTHOR|` +
+	`CI_MERGE_REQUEST_DESCRIPTION|` +
+	`CI_MERGE_REQUEST_SOURCE_BRANCH_NAME|` +
+	`CI_MERGE_REQUEST_TITLE|` +
+	`CI_EXTERNAL_PULL_REQUEST_SOURCE_BRANCH_NAME)\b`)
+
+// gitLabCIEvalPattern matches commands which run a string as code.
+var gitLabCIEvalPattern = regexp.MustCompile(
+	`\beval\b|\b(sh|bash|zsh|dash|pwsh|python3?|node|perl|ruby)\s+(-\w+\s+)*-[ce]\b`)
+
+// artifactExecutionPattern matches commands which extract or execute files,
+// e.g. those of a downloaded artifact.
+var artifactExecutionPattern = regexp.MustCompile(
+	`\b(unzip|tar|7z|gunzip|bash|sh|source|python3?|node|chmod)\b|(^|\s)\.{1,2}/`)
+
+// artifactReadPattern matches commands which read the content of files.
+var artifactReadPattern = regexp.MustCompile(`\bcat\s|\$\(\s*<|\bjq\s`)
+
+// githubEnvironmentFilePattern matches the GITHUB_ENV and GITHUB_OUTPUT
+// variables, whose files set the environment of the next steps and the
+// outputs of a step.
+var githubEnvironmentFilePattern = regexp.MustCompile(`\$\{?(env:)?(GITHUB_ENV|GITHUB_OUTPUT)\b`)
+
+// githubSecretPattern matches the secrets of a workflow.
+var githubSecretPattern = regexp.MustCompile(`\bsecrets\.(\w+)`)
+
+// cacheActions are the actions which save caches. Caches saved in the context
+// of the base repository are restored by workflows of the default branch.
+var cacheActions = map[string]bool{
+	"actions/cache":      true,
+	"actions/cache/save": true,
+}
+
+// setupActionsWithCache are the setup actions which save caches when their
+// cache input is set.
+var setupActionsWithCache = map[string]bool{
+	"actions/setup-dotnet": true,
+	"actions/setup-go":     true,
+	"actions/setup-java":   true,
+	"actions/setup-node":   true,
+	"actions/setup-python": true,
+}
+
 type triggerName string
 
 var (
@@ -83,10 +139,125 @@ func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData,
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
 	}, validateGitHubActionWorkflowPatterns, &data)
+	if err != nil {
+		return data, err
+	}
+
+	// the visibility of the repository decides who can trigger the workflows
+	// of self-hosted runners.
+	if len(data.SelfHostedRunnerJobs) > 0 {
+		private, err := c.RepoClient.IsPrivate()
+		switch {
+		case err == nil:
+			data.Private = &private
+		case !errors.Is(err, clients.ErrUnsupportedFeature):
+			return data, fmt.Errorf("RepoClient.IsPrivate: %w", err)
+		}
+	}
+
+	if err := validateGitLabCIPatterns(c.RepoClient, &data); err != nil {
+		return data, err
+	}
 
+	err = validatePipelinePatterns(c.RepoClient, &data)
 	return data, err
 }
 
+// validatePipelinePatterns checks the pipelines of CI systems parsed by
+// fileparser.ReadPipelines for script injections. These CI systems substitute
+// the untrusted expressions of commands before running them, so the values
+// run as code.
+func validatePipelinePatterns(c clients.RepoClient, pdata *checker.DangerousWorkflowData) error {
+	pipelines, err := fileparser.ReadPipelines(c)
+	if err != nil {
+		return fmt.Errorf("reading pipelines: %w", err)
+	}
+	for i := range pipelines {
+		pipeline := &pipelines[i]
+		if len(pipeline.Jobs) == 0 {
+			continue
+		}
+		pdata.NumWorkflows += 1
+
+		for j := range pipeline.Jobs {
+			job := &pipeline.Jobs[j]
+			var workflowJob *checker.WorkflowJob
+			if job.Name != "" {
+				workflowJob = &checker.WorkflowJob{Name: &job.Name, ID: &job.Name}
+			}
+			for _, command := range job.Commands {
+				for _, expression := range command.Untrusted {
+					pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+						Type: checker.DangerousWorkflowScriptInjection,
+						Job:  workflowJob,
+						File: checker.File{
+							Path:    pipeline.Path,
+							Type:    finding.FileTypeSource,
+							Offset:  expression.Line,
+							Snippet: expression.Expression,
+						},
+					})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateGitLabCIPatterns checks the GitLab CI configuration of a repository
+// for script injections, and the settings of GitLab projects for pipelines of
+// forks running with the variables of the project.
+func validateGitLabCIPatterns(c clients.RepoClient, pdata *checker.DangerousWorkflowData) error {
+	ci, err := fileparser.ReadGitLabCI(c)
+	if err != nil {
+		return fmt.Errorf("reading GitLab CI configuration: %w", err)
+	}
+	if ci == nil || len(ci.Jobs) == 0 {
+		return nil
+	}
+	pdata.NumWorkflows += 1
+
+	for i := range ci.Jobs {
+		job := &ci.Jobs[i]
+		for _, command := range job.Commands {
+			checkGitLabCIScriptInjection(job, command, pdata)
+		}
+	}
+
+	workflows, err := gitlab.ForkPipelines(c, ci)
+	if err != nil {
+		return err
+	}
+	pdata.Workflows = append(pdata.Workflows, workflows...)
+	return nil
+}
+
+// checkGitLabCIScriptInjection checks a command for untrusted variables
+// evaluated as code. The shell doesn't evaluate the values of the variables it
+// expands, but a string which a command runs as code does.
+func checkGitLabCIScriptInjection(job *fileparser.GitLabCIJob, command fileparser.GitLabCICommand,
+	pdata *checker.DangerousWorkflowData,
+) {
+	if !gitLabCIEvalPattern.MatchString(command.Command) {
+		return
+	}
+	for _, match := range gitLabCIUntrustedVariablePattern.FindAllStringSubmatchIndex(command.Command, -1) {
+		variable := command.Command[match[2]:match[3]]
+		// commands of block scalars span several lines.
+		line := command.Line + uint(strings.Count(command.Command[:match[0]], "\n"))
+		pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+			Type: checker.DangerousWorkflowScriptInjection,
+			Job:  &checker.WorkflowJob{Name: &job.Name, ID: &job.Name},
+			File: checker.File{
+				Path:    command.Path,
+				Type:    finding.FileTypeSource,
+				Offset:  line,
+				Snippet: variable,
+			},
+		})
+	}
+}
+
 // Check file content.
 var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = func(path string,
 	content []byte,
@@ -129,6 +300,18 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
+	// 3. Check for artifacts of untrusted workflow runs executed with privileges.
+	validateArtifactPoisoning(workflow, path, pdata)
+
+	// 4. Check for caches written by pull_request_target workflows.
+	validateCachePoisoning(workflow, path, pdata)
+
+	// 5. Check for untrusted data written to GITHUB_ENV or GITHUB_OUTPUT.
+	validateEnvironmentInjection(workflow, path, pdata)
+
+	// 6. Record the jobs running on self-hosted runners.
+	collectSelfHostedRunnerJobs(workflow, path, pdata)
+
 	// TODO: Check other dangerous patterns.
 	return true, nil
 }
@@ -281,3 +464,350 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 	}
 	return nil
 }
+
+// validateArtifactPoisoning checks workflow_run workflows for artifacts of the
+// triggering run, which may be a run of a pull request from a fork, extracted
+// or executed by a job with secrets or a token with write permissions.
+func validateArtifactPoisoning(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	if !usesEventTrigger(workflow, triggerWorkflowRun) {
+		return
+	}
+	for _, job := range workflow.Jobs {
+		if job == nil || !hasPrivilegedToken(workflow, job) {
+			continue
+		}
+		for i, step := range job.Steps {
+			snippet, ok := workflowRunArtifactDownload(step)
+			if !ok || !executesFiles(job.Steps[i+1:]) {
+				continue
+			}
+			pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowArtifactPoisoning,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(step.Pos),
+					Snippet: snippet,
+				},
+				Job: createJob(job),
+			})
+		}
+	}
+}
+
+// workflowRunArtifactDownload returns the action, or the command, of a step
+// which downloads the artifacts of the triggering workflow run.
+func workflowRunArtifactDownload(step *actionlint.Step) (string, bool) {
+	if step == nil || !stepContains(step, "workflow_run") {
+		return "", false
+	}
+	switch e := step.Exec.(type) {
+	case *actionlint.ExecAction:
+		if e.Uses == nil {
+			return "", false
+		}
+		if strings.Contains(e.Uses.Value, "download-artifact") {
+			return e.Uses.Value, true
+		}
+		if script, ok := e.Inputs["script"]; ok && script.Value != nil &&
+			strings.Contains(e.Uses.Value, "actions/github-script") &&
+			strings.Contains(script.Value.Value, "downloadArtifact") {
+			return e.Uses.Value, true
+		}
+	case *actionlint.ExecRun:
+		if e.Run != nil && strings.Contains(e.Run.Value, "gh run download") {
+			return "gh run download", true
+		}
+	}
+	return "", false
+}
+
+// executesFiles reports whether any of the steps extracts or executes files,
+// or runs a local action.
+func executesFiles(steps []*actionlint.Step) bool {
+	for _, step := range steps {
+		if step == nil {
+			continue
+		}
+		switch e := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			if e.Uses != nil && strings.HasPrefix(e.Uses.Value, "./") {
+				return true
+			}
+		case *actionlint.ExecRun:
+			if e.Run != nil && artifactExecutionPattern.MatchString(e.Run.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// stepContains reports whether the script, inputs or environment of a step
+// contain s.
+func stepContains(step *actionlint.Step, s string) bool {
+	for _, v := range stepValues(step) {
+		if strings.Contains(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// stepValues returns the script, inputs and environment variables of a step.
+func stepValues(step *actionlint.Step) []string {
+	values := envValues(step.Env)
+	switch e := step.Exec.(type) {
+	case *actionlint.ExecAction:
+		for _, input := range e.Inputs {
+			if input != nil && input.Value != nil {
+				values = append(values, input.Value.Value)
+			}
+		}
+	case *actionlint.ExecRun:
+		if e.Run != nil {
+			values = append(values, e.Run.Value)
+		}
+	}
+	return values
+}
+
+func envValues(env *actionlint.Env) []string {
+	if env == nil {
+		return nil
+	}
+	var values []string
+	for _, v := range env.Vars {
+		if v != nil && v.Value != nil {
+			values = append(values, v.Value.Value)
+		}
+	}
+	return values
+}
+
+// secretNames returns the sorted names of the secrets, other than the
+// GITHUB_TOKEN, which values use.
+func secretNames(values []string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, v := range values {
+		for _, match := range githubSecretPattern.FindAllStringSubmatch(v, -1) {
+			if strings.EqualFold(match[1], "GITHUB_TOKEN") || seen[match[1]] {
+				continue
+			}
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// hasPrivilegedToken reports whether a job uses secrets other than the
+// GITHUB_TOKEN, or may use a GITHUB_TOKEN with write permissions. Without
+// permissions, the GITHUB_TOKEN has the default permissions of the
+// repository, which may be write permissions.
+func hasPrivilegedToken(workflow *actionlint.Workflow, job *actionlint.Job) bool {
+	permissions := job.Permissions
+	if permissions == nil {
+		permissions = workflow.Permissions
+	}
+	if permissions == nil || (permissions.All != nil && permissions.All.Value == "write-all") {
+		return true
+	}
+	for _, scope := range permissions.Scopes {
+		if scope != nil && scope.Value != nil && scope.Value.Value == "write" {
+			return true
+		}
+	}
+	for _, step := range job.Steps {
+		if step != nil && len(secretNames(stepValues(step))) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCachePoisoning checks pull_request_target workflows for caches they
+// save. These workflows run in the context of the base repository, so their
+// caches are restored by the workflows of the default branch, e.g. releases.
+func validateCachePoisoning(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	if !usesEventTrigger(workflow, triggerPullRequestTarget) {
+		return
+	}
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		for _, step := range job.Steps {
+			if step == nil {
+				continue
+			}
+			e, ok := step.Exec.(*actionlint.ExecAction)
+			if !ok || e.Uses == nil || !savesCache(e) {
+				continue
+			}
+			pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowCachePoisoning,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(step.Pos),
+					Snippet: e.Uses.Value,
+				},
+				Job: createJob(job),
+			})
+		}
+	}
+}
+
+func savesCache(e *actionlint.ExecAction) bool {
+	action, _, _ := strings.Cut(strings.ToLower(e.Uses.Value), "@")
+	if cacheActions[action] {
+		return true
+	}
+	if !setupActionsWithCache[action] {
+		return false
+	}
+	cache, ok := e.Inputs["cache"]
+	return ok && cache.Value != nil && cache.Value.Value != "" && cache.Value.Value != "false"
+}
+
+// validateEnvironmentInjection checks the scripts of workflows for untrusted
+// data written to the files of GITHUB_ENV and GITHUB_OUTPUT: environment
+// variables set to untrusted context variables, and, in jobs which download
+// the artifacts of the triggering workflow run, the content of files. Unlike
+// script injections, quoting doesn't help, as a newline in the data sets
+// other variables, e.g. BASH_ENV or LD_PRELOAD, or other outputs.
+func validateEnvironmentInjection(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		downloadsArtifacts := false
+		for _, step := range job.Steps {
+			if step == nil {
+				continue
+			}
+			if _, ok := workflowRunArtifactDownload(step); ok && usesEventTrigger(workflow, triggerWorkflowRun) {
+				downloadsArtifacts = true
+			}
+			run, ok := step.Exec.(*actionlint.ExecRun)
+			if !ok || run.Run == nil {
+				continue
+			}
+			untrusted := untrustedEnvVars(workflow.Env, job.Env, step.Env)
+			for _, line := range strings.Split(run.Run.Value, "\n") {
+				if !githubEnvironmentFilePattern.MatchString(line) {
+					continue
+				}
+				if !referencesEnvVar(line, untrusted) && !(downloadsArtifacts && artifactReadPattern.MatchString(line)) {
+					continue
+				}
+				pdata.Workflows = append(pdata.Workflows, checker.DangerousWorkflow{
+					Type: checker.DangerousWorkflowEnvironmentInjection,
+					File: checker.File{
+						Path:    path,
+						Type:    finding.FileTypeSource,
+						Offset:  fileparser.GetLineNumber(run.Run.Pos),
+						Snippet: strings.TrimSpace(line),
+					},
+					Job: createJob(job),
+				})
+			}
+		}
+	}
+}
+
+// untrustedEnvVars returns the names of the environment variables set to
+// untrusted context variables. Variables of steps override those of jobs,
+// which override those of workflows.
+func untrustedEnvVars(envs ...*actionlint.Env) map[string]bool {
+	untrusted := make(map[string]bool)
+	for _, env := range envs {
+		if env == nil {
+			continue
+		}
+		for _, v := range env.Vars {
+			if v == nil || v.Name == nil || v.Value == nil {
+				continue
+			}
+			untrusted[v.Name.Value] = containsUntrustedContextPattern(v.Value.Value)
+		}
+	}
+	return untrusted
+}
+
+// referencesEnvVar reports whether a line of a script expands any of the
+// untrusted environment variables, e.g. $TITLE, ${TITLE} or $env:TITLE.
+func referencesEnvVar(line string, untrusted map[string]bool) bool {
+	for name, ok := range untrusted {
+		if !ok {
+			continue
+		}
+		if regexp.MustCompile(`\$\{?(env:)?` + regexp.QuoteMeta(name) + `\b`).MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectSelfHostedRunnerJobs records the jobs which run on self-hosted
+// runners, with the events triggering them and the secrets they use. Labels
+// set by expressions, e.g. ${{ matrix.runner }}, aren't resolved.
+func collectSelfHostedRunnerJobs(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	var triggers []string
+	for _, event := range workflow.On {
+		triggers = append(triggers, event.EventName())
+	}
+	for _, job := range workflow.Jobs {
+		if job == nil || job.RunsOn == nil {
+			continue
+		}
+		label := selfHostedLabel(job.RunsOn)
+		if label == nil {
+			continue
+		}
+		labels := make([]string, 0, len(job.RunsOn.Labels))
+		for _, l := range job.RunsOn.Labels {
+			labels = append(labels, l.Value)
+		}
+		values := envValues(workflow.Env)
+		values = append(values, envValues(job.Env)...)
+		for _, step := range job.Steps {
+			if step != nil {
+				values = append(values, stepValues(step)...)
+			}
+		}
+		pdata.SelfHostedRunnerJobs = append(pdata.SelfHostedRunnerJobs, checker.SelfHostedRunnerJob{
+			Job:      createJob(job),
+			Triggers: triggers,
+			Secrets:  secretNames(values),
+			File: checker.File{
+				Path:    path,
+				Type:    finding.FileTypeSource,
+				Offset:  fileparser.GetLineNumber(label.Pos),
+				Snippet: strings.Join(labels, ", "),
+			},
+		})
+	}
+}
+
+// selfHostedLabel returns the self-hosted label of a runner, which every
+// self-hosted runner has.
+func selfHostedLabel(runner *actionlint.Runner) *actionlint.String {
+	for _, label := range runner.Labels {
+		if label != nil && strings.EqualFold(label.Value, "self-hosted") {
+			return label
+		}
+	}
+	return nil
+}
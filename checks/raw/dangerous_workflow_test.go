@@ -18,6 +18,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -25,7 +26,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
 )
 
 func errCmp(e1, e2 error) bool {
@@ -213,6 +216,21 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-script-injection-tojson.yml",
 			expected: ret{nb: 1},
 		},
+		{
+			name:     "artifacts of workflow run executed with privileges",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-artifact-poisoning.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "caches saved by pull_request_target",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-cache-poisoning.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "untrusted data written to environment files",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-environment-injection.yml",
+			expected: ret{nb: 2},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -220,7 +238,7 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
-			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil).AnyTimes()
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("../testdata/" + file)
 			})
@@ -246,3 +264,228 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestGitLabCIDangerousWorkflow(t *testing.T) {
+	t.Parallel()
+	type dangerousWorkflow struct {
+		job     string
+		snippet string
+		line    uint
+	}
+	tests := []struct {
+		name string
+		ci   string
+		want []dangerousWorkflow
+	}{
+		{
+			name: "variables expanded by the shell",
+			ci: `build:
+  script:
+    - echo "$CI_COMMIT_MESSAGE"
+    - git log -1 $CI_COMMIT_BRANCH
+`,
+		},
+		{
+			name: "variables evaluated as code",
+			ci: `notify:
+  script:
+    - eval "echo $CI_MERGE_REQUEST_TITLE"
+    - |
+      echo start
+      bash -c "echo ${CI_COMMIT_MESSAGE}"
+    - python3 -c "print('$CI_COMMIT_REF_NAME')"
+`,
+			want: []dangerousWorkflow{
+				{job: "notify", snippet: "CI_MERGE_REQUEST_TITLE", line: 3},
+				{job: "notify", snippet: "CI_COMMIT_MESSAGE", line: 6},
+				{job: "notify", snippet: "CI_COMMIT_REF_NAME", line: 7},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).
+				DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+					if ok, err := predicate(".gitlab-ci.yml"); ok && err == nil {
+						return []string{".gitlab-ci.yml"}, nil
+					}
+					return nil, nil
+				}).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(".gitlab-ci.yml").
+				Return(io.NopCloser(strings.NewReader(tt.ci)), nil)
+
+			dw, err := DangerousWorkflow(&checker.CheckRequest{Ctx: t.Context(), RepoClient: mockRepoClient})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dw.NumWorkflows != 1 {
+				t.Errorf("NumWorkflows = %d, want 1", dw.NumWorkflows)
+			}
+			var got []dangerousWorkflow
+			for _, w := range dw.Workflows {
+				if w.Type != checker.DangerousWorkflowScriptInjection {
+					t.Errorf("unexpected type %s", w.Type)
+				}
+				got = append(got, dangerousWorkflow{job: *w.Job.Name, snippet: w.File.Snippet, line: w.File.Offset})
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dangerousWorkflow{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipelinesDangerousWorkflow(t *testing.T) {
+	t.Parallel()
+	type dangerousWorkflow struct {
+		job     string
+		snippet string
+		line    uint
+	}
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     []dangerousWorkflow
+	}{
+		{
+			name:     "CircleCI branch substituted in a run step",
+			filename: ".circleci/config.yml",
+			content: `jobs:
+  build:
+    docker:
+      - image: cimg/base:current
+    steps:
+      - run: echo "$CIRCLE_BRANCH"
+      - run: |
+          echo start
+          git checkout << pipeline.git.branch >>
+`,
+			want: []dangerousWorkflow{
+				{job: "build", snippet: "<< pipeline.git.branch >>", line: 9},
+			},
+		},
+		{
+			name:     "Jenkins interpolation of a pull request title",
+			filename: "Jenkinsfile",
+			content: `pipeline {
+  agent any
+  stages {
+    stage('Notify') {
+      steps {
+        sh 'echo $CHANGE_TITLE'
+        sh "echo ${env.CHANGE_TITLE}"
+      }
+    }
+  }
+}
+`,
+			want: []dangerousWorkflow{
+				{job: "Notify", snippet: "${env.CHANGE_TITLE}", line: 7},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).
+				DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+					if ok, err := predicate(tt.filename); ok && err == nil {
+						return []string{tt.filename}, nil
+					}
+					return nil, nil
+				}).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(tt.filename).
+				Return(io.NopCloser(strings.NewReader(tt.content)), nil)
+
+			dw, err := DangerousWorkflow(&checker.CheckRequest{Ctx: t.Context(), RepoClient: mockRepoClient})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if dw.NumWorkflows != 1 {
+				t.Errorf("NumWorkflows = %d, want 1", dw.NumWorkflows)
+			}
+			var got []dangerousWorkflow
+			for _, w := range dw.Workflows {
+				if w.Type != checker.DangerousWorkflowScriptInjection {
+					t.Errorf("unexpected type %s", w.Type)
+				}
+				got = append(got, dangerousWorkflow{job: *w.Job.Name, snippet: w.File.Snippet, line: w.File.Offset})
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(dangerousWorkflow{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelfHostedRunnerJobs(t *testing.T) {
+	t.Parallel()
+	const workflow = `on:
+  pull_request_target:
+  push:
+    branches: [main]
+
+env:
+  SLACK_WEBHOOK: ${{ secrets.SLACK_WEBHOOK }}
+
+jobs:
+  build:
+    runs-on: [self-hosted, linux]
+    steps:
+      - run: make
+        env:
+          GITHUB_TOKEN: ${{ secrets.GITHUB_TOKEN }}
+      - uses: some/deploy@v1
+        with:
+          token: ${{ secrets.NPM_TOKEN }}
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - run: make lint
+`
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).
+		DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+			if ok, err := predicate(".github/workflows/ci.yml"); ok && err == nil {
+				return []string{".github/workflows/ci.yml"}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(".github/workflows/ci.yml").
+		Return(io.NopCloser(strings.NewReader(workflow)), nil)
+	mockRepoClient.EXPECT().IsPrivate().Return(false, clients.ErrUnsupportedFeature)
+
+	dw, err := DangerousWorkflow(&checker.CheckRequest{Ctx: t.Context(), RepoClient: mockRepoClient})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build := "build"
+	want := []checker.SelfHostedRunnerJob{
+		{
+			Job:      &checker.WorkflowJob{ID: &build},
+			Triggers: []string{"pull_request_target", "push"},
+			Secrets:  []string{"NPM_TOKEN", "SLACK_WEBHOOK"},
+			File: checker.File{
+				Path:    ".github/workflows/ci.yml",
+				Type:    finding.FileTypeSource,
+				Offset:  11,
+				Snippet: "self-hosted, linux",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, dw.SelfHostedRunnerJobs); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if dw.Private != nil {
+		t.Errorf("Private = %v, want nil", *dw.Private)
+	}
+}
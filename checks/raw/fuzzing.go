@@ -250,7 +250,7 @@ func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 			)
 		}
 	}
-	return checker.FuzzingData{Fuzzers: detectedFuzzers}, nil
+	return checker.FuzzingData{Fuzzers: detectedFuzzers, Languages: langs}, nil
 }
 
 func checkCFLite(c *checker.CheckRequest) (bool, error) {
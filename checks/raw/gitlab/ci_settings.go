@@ -0,0 +1,132 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// jobToken is the name of the token of GitLab CI/CD jobs.
+const jobToken = "CI_JOB_TOKEN"
+
+// ciSettings returns the CI/CD settings of a GitLab project, or nil if the
+// repository isn't hosted on GitLab or the token can't read them.
+func ciSettings(c clients.RepoClient) (*gitlabrepo.CISettings, error) {
+	client, ok := c.(*gitlabrepo.Client)
+	if !ok {
+		return nil, nil
+	}
+	settings, err := client.GetCISettings()
+	if err != nil {
+		return nil, fmt.Errorf("GitLab CI/CD settings: %w", err)
+	}
+	return settings, nil
+}
+
+// ForkPipelines returns the dangerous workflow of a project which runs
+// pipelines for merge requests from forks in the project, where the code of
+// the fork can read the CI/CD variables of unprotected refs.
+func ForkPipelines(c clients.RepoClient, ci *fileparser.GitLabCI) ([]checker.DangerousWorkflow, error) {
+	settings, err := ciSettings(c)
+	if err != nil || settings == nil || ci == nil {
+		return nil, err
+	}
+	return forkPipelines(settings, ci), nil
+}
+
+func forkPipelines(settings *gitlabrepo.CISettings, ci *fileparser.GitLabCI) []checker.DangerousWorkflow {
+	if !settings.ForkPipelines || len(settings.ExposedVariables) == 0 {
+		return nil
+	}
+	for i := range ci.Jobs {
+		job := &ci.Jobs[i]
+		if !job.MergeRequests {
+			continue
+		}
+		return []checker.DangerousWorkflow{{
+			Type: checker.DangerousWorkflowUntrustedCheckout,
+			Job:  &checker.WorkflowJob{Name: &job.Name, ID: &job.Name},
+			File: checker.File{
+				Path: settings.URL,
+				Type: finding.FileTypeURL,
+				Snippet: "pipelines for merge requests from forks can read " +
+					strings.Join(settings.ExposedVariables, ", "),
+			},
+		}}
+	}
+	return nil
+}
+
+// TokenPermissions returns the permissions of the CI/CD job tokens of a
+// GitLab project: whether they can push to the repository, and whether the
+// tokens of any project can access it.
+func TokenPermissions(c clients.RepoClient) (checker.TokenPermissionsData, error) {
+	settings, err := ciSettings(c)
+	if err != nil || settings == nil {
+		return checker.TokenPermissionsData{}, err
+	}
+	return tokenPermissions(settings), nil
+}
+
+func tokenPermissions(settings *gitlabrepo.CISettings) checker.TokenPermissionsData {
+	data := checker.TokenPermissionsData{NumTokens: 1}
+
+	location := checker.PermissionLocationTop
+	file := &checker.File{Path: settings.URL, Type: finding.FileTypeURL}
+	name := jobToken
+	if settings.JobTokenPush {
+		// pushing is the write permission on contents of GitHub workflows.
+		contents, write := "contents", "write"
+		msg := "CI/CD job tokens can push to the repository"
+		data.TokenPermissions = append(data.TokenPermissions, checker.TokenPermission{
+			LocationType: &location,
+			Name:         &contents,
+			Value:        &write,
+			File:         file,
+			Msg:          &msg,
+			Type:         checker.PermissionLevelWrite,
+		})
+	}
+	if !settings.JobTokenAllowlist {
+		msg := "CI/CD job tokens of any project can access the project"
+		data.TokenPermissions = append(data.TokenPermissions, checker.TokenPermission{
+			LocationType: &location,
+			Name:         &name,
+			File:         file,
+			Msg:          &msg,
+			Type:         checker.PermissionLevelUndeclared,
+		})
+	}
+	if len(data.TokenPermissions) == 0 {
+		read := "read"
+		msg := "CI/CD job tokens are limited to allowlisted projects and can't push"
+		data.TokenPermissions = append(data.TokenPermissions, checker.TokenPermission{
+			LocationType: &location,
+			Name:         &name,
+			Value:        &read,
+			File:         file,
+			Msg:          &msg,
+			Type:         checker.PermissionLevelRead,
+		})
+	}
+	return data
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+)
+
+const settingsURL = "https://gitlab.com/owner/project/-/settings/ci_cd"
+
+func TestForkPipelines(t *testing.T) {
+	t.Parallel()
+	ci := &fileparser.GitLabCI{
+		Jobs: []fileparser.GitLabCIJob{
+			{Name: "build"},
+			{Name: "test", MergeRequests: true},
+		},
+	}
+	tests := []struct {
+		settings gitlabrepo.CISettings
+		ci       *fileparser.GitLabCI
+		name     string
+		want     string
+	}{
+		{
+			name: "fork pipelines with exposed variables",
+			settings: gitlabrepo.CISettings{
+				URL:              settingsURL,
+				ForkPipelines:    true,
+				ExposedVariables: []string{"NPM_TOKEN", "SONAR_TOKEN"},
+			},
+			ci:   ci,
+			want: "pipelines for merge requests from forks can read NPM_TOKEN, SONAR_TOKEN",
+		},
+		{
+			name:     "only protected variables",
+			settings: gitlabrepo.CISettings{URL: settingsURL, ForkPipelines: true},
+			ci:       ci,
+		},
+		{
+			name: "fork pipelines disabled",
+			settings: gitlabrepo.CISettings{
+				URL:              settingsURL,
+				ExposedVariables: []string{"NPM_TOKEN"},
+			},
+			ci: ci,
+		},
+		{
+			name: "no merge request pipelines",
+			settings: gitlabrepo.CISettings{
+				URL:              settingsURL,
+				ForkPipelines:    true,
+				ExposedVariables: []string{"NPM_TOKEN"},
+			},
+			ci: &fileparser.GitLabCI{Jobs: []fileparser.GitLabCIJob{{Name: "build"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			workflows := forkPipelines(&tt.settings, tt.ci)
+			if tt.want == "" {
+				if len(workflows) != 0 {
+					t.Errorf("unexpected dangerous workflows: %v", workflows)
+				}
+				return
+			}
+			if len(workflows) != 1 {
+				t.Fatalf("got %d dangerous workflows, want 1", len(workflows))
+			}
+			w := workflows[0]
+			if w.Type != checker.DangerousWorkflowUntrustedCheckout || *w.Job.Name != "test" {
+				t.Errorf("got %s for job %s, want untrustedCheckout for job test", w.Type, *w.Job.Name)
+			}
+			if w.File.Path != settingsURL || w.File.Snippet != tt.want {
+				t.Errorf("got %s: %q, want %s: %q", w.File.Path, w.File.Snippet, settingsURL, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenPermissions(t *testing.T) {
+	t.Parallel()
+	type permission struct {
+		name  string
+		level checker.PermissionLevel
+	}
+	tests := []struct {
+		name     string
+		want     []permission
+		settings gitlabrepo.CISettings
+	}{
+		{
+			name:     "allowlist without push",
+			settings: gitlabrepo.CISettings{JobTokenAllowlist: true},
+			want:     []permission{{name: "CI_JOB_TOKEN", level: checker.PermissionLevelRead}},
+		},
+		{
+			name:     "push without allowlist",
+			settings: gitlabrepo.CISettings{JobTokenPush: true},
+			want: []permission{
+				{name: "contents", level: checker.PermissionLevelWrite},
+				{name: "CI_JOB_TOKEN", level: checker.PermissionLevelUndeclared},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.settings.URL = settingsURL
+			data := tokenPermissions(&tt.settings)
+			if data.NumTokens != 1 {
+				t.Errorf("NumTokens = %d, want 1", data.NumTokens)
+			}
+			var got []permission
+			for _, p := range data.TokenPermissions {
+				if *p.LocationType != checker.PermissionLocationTop || p.File.Path != settingsURL {
+					t.Errorf("unexpected location %s %s", *p.LocationType, p.File.Path)
+				}
+				got = append(got, permission{name: *p.Name, level: p.Type})
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(permission{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
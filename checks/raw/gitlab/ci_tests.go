@@ -0,0 +1,64 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// testCommands are the commands of job scripts which run tests.
+var testCommands = []string{
+	"go test", "gotestsum", "pytest", "tox", "nox", "npm test", "npm run test", "yarn test",
+	"pnpm test", "jest", "mvn test", "mvn verify", "gradle test", "gradlew test", "gradle check",
+	"gradlew check", "cargo test", "cargo nextest", "make test", "make check", "ctest", "rspec",
+	"rake test", "phpunit", "dotnet test", "mix test", "bazel test", "swift test",
+}
+
+// CITestJobs returns the jobs of the GitLab CI configuration which run tests,
+// either named after tests or running a test command.
+func CITestJobs(c clients.RepoClient) ([]string, error) {
+	ci, err := fileparser.ReadGitLabCI(c)
+	if err != nil {
+		return nil, fmt.Errorf("reading GitLab CI configuration: %w", err)
+	}
+	if ci == nil {
+		return nil, nil
+	}
+	var jobs []string
+	for i := range ci.Jobs {
+		if isTestJob(&ci.Jobs[i]) {
+			jobs = append(jobs, ci.Jobs[i].Name)
+		}
+	}
+	return jobs, nil
+}
+
+func isTestJob(job *fileparser.GitLabCIJob) bool {
+	if strings.Contains(strings.ToLower(job.Name), "test") {
+		return true
+	}
+	for _, command := range job.Commands {
+		for _, t := range testCommands {
+			if strings.Contains(command.Command, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestCITestJobs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		ci   string
+		want []string
+	}{
+		{
+			name: "test command",
+			ci: `.go:
+  image: golang:1.25
+check:
+  extends: .go
+  script:
+    - go vet ./...
+    - go test ./...
+build:
+  script:
+    - go build ./...
+`,
+			want: []string{"check"},
+		},
+		{
+			name: "test job name",
+			ci: `unit-tests:
+  script:
+    - ./run.sh
+deploy:
+  script:
+    - docker build -t image:latest .
+`,
+			want: []string{"unit-tests"},
+		},
+		{
+			name: "no tests",
+			ci: `lint:
+  script:
+    - golangci-lint run
+`,
+		},
+		{
+			name: "invalid configuration",
+			ci:   "script: [",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			moqRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{".gitlab-ci.yml"}, nil)
+			moqRepoClient.EXPECT().GetFileReader(".gitlab-ci.yml").
+				Return(io.NopCloser(strings.NewReader(tt.ci)), nil)
+
+			got, err := CITestJobs(moqRepoClient)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,9 +24,38 @@ import (
 	"github.com/ossf/scorecard/v5/finding"
 )
 
+// packagingCommands are the commands of job scripts which publish packages.
+var packagingCommands = []string{
+	"docker push",
+	"nuget push",
+	"poetry publish",
+	"twine upload",
+}
+
 // Packaging checks for packages.
 func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 	var data checker.PackagingData
+	ci, err := fileparser.ReadGitLabCI(c.RepoClient)
+	if err != nil {
+		return data, fmt.Errorf("reading GitLab CI configuration: %w", err)
+	}
+	if ci != nil {
+		if job, command, found := findPackagingCommand(ci); found {
+			data.Packages = append(data.Packages, checker.Package{
+				Name: new(string),
+				Job:  &checker.WorkflowJob{Name: &job},
+				File: &checker.File{
+					Path:   command.Path,
+					Offset: command.Line,
+					Type:   finding.FileTypeSource,
+				},
+				Runs: []checker.Run{{URL: c.Repo.URI()}},
+			})
+			return data, nil
+		}
+	}
+
+	// the configuration merged by GitLab also has the jobs of remote includes.
 	matchedFiles, err := c.RepoClient.ListFiles(fileparser.IsGitlabWorkflowFile)
 	if err != nil {
 		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
@@ -60,6 +89,24 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 	return data, nil
 }
 
+// findPackagingCommand returns the first job, and its command, which
+// publishes a package.
+func findPackagingCommand(ci *fileparser.GitLabCI) (string, fileparser.GitLabCICommand, bool) {
+	for i := range ci.Jobs {
+		job := &ci.Jobs[i]
+		for _, command := range job.Commands {
+			for _, p := range packagingCommands {
+				if i := strings.Index(command.Command, p); i >= 0 {
+					// commands of block scalars span several lines.
+					command.Line += uint(strings.Count(command.Command[:i], "\n"))
+					return job.Name, command, true
+				}
+			}
+		}
+	}
+	return "", fileparser.GitLabCICommand{}, false
+}
+
 func StringPointer(s string) *string {
 	return &s
 }
@@ -67,16 +114,9 @@ func StringPointer(s string) *string {
 func isGitlabPackagingWorkflow(fc []byte, fp string) (checker.File, bool) {
 	lineNumber := checker.OffsetDefault
 
-	packagingStrings := []string{
-		"docker push",
-		"nuget push",
-		"poetry publish",
-		"twine upload",
-	}
-
 ParseLines:
 	for idx, val := range strings.Split(string(fc), "\n") {
-		for _, element := range packagingStrings {
+		for _, element := range packagingCommands {
 			if strings.Contains(val, element) {
 				lineNumber = uint(idx + 1)
 				break ParseLines
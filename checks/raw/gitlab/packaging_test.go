@@ -17,6 +17,7 @@ package gitlab
 import (
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"go.uber.org/mock/gomock"
@@ -184,3 +185,56 @@ func TestGitlabPackagingPackager(t *testing.T) {
 		})
 	}
 }
+
+func TestGitlabPackagingIncludes(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		".gitlab-ci.yml": `include:
+  - local: /ci/release.yml
+release:
+  extends: .publish
+  image: python:3.12
+`,
+		"ci/release.yml": `.publish:
+  script:
+    - pip install twine
+    - |
+      python -m build
+      twine upload dist/*
+`,
+	}
+
+	ctrl := gomock.NewController(t)
+	moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	moqRepo := mockrepo.NewMockRepo(ctrl)
+	moqRepoClient.EXPECT().ListFiles(gomock.Any()).
+		DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+			var matched []string
+			for path := range files {
+				if ok, err := predicate(path); ok && err == nil {
+					matched = append(matched, path)
+				}
+			}
+			return matched, nil
+		}).AnyTimes()
+	moqRepoClient.EXPECT().GetFileReader(gomock.Any()).
+		DoAndReturn(func(path string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(files[path])), nil
+		}).AnyTimes()
+	moqRepo.EXPECT().URI().Return("gitlab.com/owner/project")
+
+	packagingData, err := Packaging(&checker.CheckRequest{RepoClient: moqRepoClient, Repo: moqRepo})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(packagingData.Packages) != 1 {
+		t.Fatalf("expected a package, got %d", len(packagingData.Packages))
+	}
+	pkg := packagingData.Packages[0]
+	if *pkg.Job.Name != "release" {
+		t.Errorf("job = %q, want release", *pkg.Job.Name)
+	}
+	if pkg.File.Path != "ci/release.yml" || pkg.File.Offset != 6 {
+		t.Errorf("file = %s:%d, want ci/release.yml:6", pkg.File.Path, pkg.File.Offset)
+	}
+}
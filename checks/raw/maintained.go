@@ -15,18 +15,21 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 )
 
-// Maintained checks for maintenance.
+// Maintained checks for maintenance. Clients without an API, like local git
+// checkouts, have no archived status, issues or creation date.
 func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 	var result checker.MaintainedData
 
 	// Archived status.
 	archived, err := c.RepoClient.IsArchived()
-	if err != nil {
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.ArchivedStatus.Status = archived
@@ -40,13 +43,13 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 
 	// Recent issues.
 	issues, err := c.RepoClient.ListIssues()
-	if err != nil {
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.Issues = issues
 
 	createdAt, err := c.RepoClient.GetCreatedAt()
-	if err != nil {
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.CreatedAt = createdAt
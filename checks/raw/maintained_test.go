@@ -118,4 +118,23 @@ func TestMaintained(t *testing.T) {
 			t.Fatal("expected an error but got none")
 		}
 	})
+
+	t.Run("ignores features unsupported by the client", func(t *testing.T) {
+		commits := []clients.Commit{{SHA: "commit1"}}
+		mockRepoClient.EXPECT().IsArchived().Return(false, fmt.Errorf("IsArchived: %w", clients.ErrUnsupportedFeature))
+		mockRepoClient.EXPECT().ListCommits().Return(commits, nil)
+		mockRepoClient.EXPECT().ListIssues().Return(nil, fmt.Errorf("ListIssues: %w", clients.ErrUnsupportedFeature))
+		mockRepoClient.EXPECT().GetCreatedAt().Return(time.Time{}, fmt.Errorf("GetCreatedAt: %w", clients.ErrUnsupportedFeature))
+
+		data, err := Maintained(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(data.DefaultBranchCommits) != len(commits) {
+			t.Errorf("unexpected number of commits: got %v, want %v", len(data.DefaultBranchCommits), len(commits))
+		}
+		if !data.CreatedAt.IsZero() || data.ArchivedStatus.Status || len(data.Issues) != 0 {
+			t.Errorf("unexpected data for unsupported features: %+v", data)
+		}
+	})
 }
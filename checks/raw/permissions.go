@@ -23,6 +23,7 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/checks/fileparser"
 	"github.com/ossf/scorecard/v5/checks/raw/github"
+	"github.com/ossf/scorecard/v5/checks/raw/gitlab"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
 )
@@ -58,8 +59,18 @@ func TokenPermissions(c *checker.CheckRequest) (checker.TokenPermissionsData, er
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
 	}, validateGitHubActionTokenPermissions, &data)
+	if err != nil {
+		return data.results, err
+	}
 
-	return data.results, err
+	// the CI/CD job tokens of GitLab are configured in the project settings.
+	jobTokens, err := gitlab.TokenPermissions(c.RepoClient)
+	if err != nil {
+		return data.results, err
+	}
+	data.results.NumTokens += jobTokens.NumTokens
+	data.results.TokenPermissions = append(data.results.TokenPermissions, jobTokens.TokenPermissions...)
+	return data.results, nil
 }
 
 // Check file content.
@@ -74,6 +74,21 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Azure Pipelines container images and script downloads.
+	if err := collectAzurePipelinesPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// GitLab CI container images and script downloads.
+	if err := collectGitLabCIPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// CircleCI, Jenkins, Buildkite and Tekton images, plugins and script downloads.
+	if err := collectPipelinesPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Nuget Post Processing
 	if err := postProcessNugetDependencies(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -708,6 +723,231 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 	return true, nil
 }
 
+func collectAzurePipelinesPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*.y*ml",
+		CaseSensitive: false,
+	}, validateAzurePipelinesPinning, r)
+}
+
+// validateAzurePipelinesPinning records the container images of an Azure
+// Pipelines file, and the dependencies downloaded by its scripts.
+var validateAzurePipelinesPinning fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, err := fileparser.IsAzurePipelinesFile(pathfn); !ok || err != nil {
+		return true, err
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateAzurePipelinesPinning requires exactly 1 arguments: got %v: %w",
+			len(args), errInvalidArgLength)
+	}
+
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	pipeline, err := fileparser.ParseAzurePipeline(content)
+	if err != nil {
+		// YAML files at the conventional locations need not be pipelines.
+		return true, nil
+	}
+
+	containers := pipeline.Containers
+	for i := range pipeline.Jobs {
+		if pipeline.Jobs[i].Container != nil {
+			containers = append(containers, *pipeline.Jobs[i].Container)
+		}
+	}
+	for i := range containers {
+		pdata.Dependencies = append(pdata.Dependencies,
+			newContainerImageDependency(pathfn, containers[i].Image, containers[i].Line))
+	}
+
+	// We replace the `${{ parameters.name }}` template expressions to avoid shell parsing failures.
+	templateExprRegex := regexp.MustCompile(`{{[^{}]*}}`)
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		// script steps run in cmd.exe on Windows agents.
+		windows := strings.Contains(strings.ToLower(job.VMImage), "windows")
+		taintedFiles := make(map[string]bool)
+		for j := range job.Steps {
+			step := &job.Steps[j]
+			if step.Script == "" || !isSupportedShell(step.Shell) || (windows && step.Shell == "sh") {
+				continue
+			}
+			script := templateExprRegex.ReplaceAll([]byte(step.Script), []byte("AZURE_REDACTED_VAR"))
+			// lines of the script are counted from 1.
+			startLine := step.ScriptLine - 1
+			if err := validateShellFile(pathfn, startLine, startLine, script, taintedFiles, pdata); err != nil {
+				pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+					Msg: asPointer(err.Error()),
+				})
+			}
+		}
+	}
+	return true, nil
+}
+
+// collectGitLabCIPinning records the images of the jobs and services of the
+// GitLab CI configuration, and the dependencies downloaded by job scripts.
+func collectGitLabCIPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	ci, err := fileparser.ReadGitLabCI(c.RepoClient)
+	if err != nil {
+		return fmt.Errorf("reading GitLab CI configuration: %w", err)
+	}
+	if ci == nil {
+		return nil
+	}
+
+	// jobs share the images and commands they inherit, which are recorded once.
+	seen := make(map[string]bool)
+	isNew := func(pathfn string, line uint) bool {
+		key := fmt.Sprintf("%s:%d", pathfn, line)
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		return true
+	}
+	for i := range ci.Jobs {
+		job := &ci.Jobs[i]
+		images := job.Services
+		if job.Image != nil {
+			images = append([]fileparser.GitLabCIImage{*job.Image}, images...)
+		}
+		for _, image := range images {
+			if isNew(image.Path, image.Line) {
+				r.Dependencies = append(r.Dependencies, newContainerImageDependency(image.Path, image.Name, image.Line))
+			}
+		}
+
+		taintedFiles := make(map[string]bool)
+		for _, command := range job.Commands {
+			if !isNew(command.Path, command.Line) {
+				continue
+			}
+			// lines of the script are counted from 1.
+			startLine := command.Line - 1
+			if err := validateShellFile(command.Path, startLine, startLine, []byte(command.Command),
+				taintedFiles, r); err != nil {
+				r.Dependencies = append(r.Dependencies, checker.Dependency{
+					Msg: asPointer(err.Error()),
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// pipelineExpressions match the expressions which CI systems substitute in
+// commands and which aren't valid shell.
+var pipelineExpressions = map[fileparser.PipelineSystem]*regexp.Regexp{
+	fileparser.CircleCI: regexp.MustCompile(`<<[^<>]*>>`),
+	fileparser.Jenkins:  regexp.MustCompile(`\$\{[^{}]*\}`),
+}
+
+// collectPipelinesPinning records the images and plugins of the pipelines of
+// CI systems parsed by fileparser.ReadPipelines, and the dependencies
+// downloaded by their shell commands.
+func collectPipelinesPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	pipelines, err := fileparser.ReadPipelines(c.RepoClient)
+	if err != nil {
+		return fmt.Errorf("reading pipelines: %w", err)
+	}
+	for i := range pipelines {
+		pipeline := &pipelines[i]
+		for _, plugin := range pipeline.Plugins {
+			r.Dependencies = append(r.Dependencies, newPipelinePluginDependency(pipeline.Path, plugin))
+		}
+
+		// jobs share the images of executors and the commands of reusable
+		// commands, which are recorded once.
+		seenImages := make(map[uint]bool)
+		seenCommands := make(map[uint]bool)
+		for j := range pipeline.Jobs {
+			job := &pipeline.Jobs[j]
+			for _, image := range job.Images {
+				if !seenImages[image.Line] {
+					seenImages[image.Line] = true
+					r.Dependencies = append(r.Dependencies, newContainerImageDependency(pipeline.Path, image.Name, image.Line))
+				}
+			}
+
+			taintedFiles := make(map[string]bool)
+			for _, command := range job.Commands {
+				if !isSupportedShell(command.Shell) || seenCommands[command.Line] {
+					continue
+				}
+				seenCommands[command.Line] = true
+				script := []byte(command.Command)
+				if re, ok := pipelineExpressions[pipeline.System]; ok {
+					script = re.ReplaceAll(script, []byte("PIPELINE_REDACTED_VAR"))
+				}
+				// lines of the script are counted from 1.
+				startLine := command.Line - 1
+				if err := validateShellFile(pipeline.Path, startLine, startLine, script, taintedFiles, r); err != nil {
+					r.Dependencies = append(r.Dependencies, checker.Dependency{
+						Msg: asPointer(err.Error()),
+					})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func newPipelinePluginDependency(pathfn string, plugin fileparser.PipelinePlugin) checker.Dependency {
+	snippet := plugin.Name
+	if plugin.Version != "" {
+		snippet += "@" + plugin.Version
+	}
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    plugin.Line,
+			EndOffset: plugin.Line,
+			Snippet:   snippet,
+		},
+		Name:   asPointer(plugin.Name),
+		Pinned: asBoolPointer(plugin.Pinned),
+		Type:   checker.DependencyUseTypePipelinePlugin,
+	}
+	if plugin.Version != "" {
+		dep.PinnedAt = asPointer(plugin.Version)
+	}
+	return dep
+}
+
+func newContainerImageDependency(pathfn, image string, line uint) checker.Dependency {
+	// The image must be pinned by sha256 hash, e.g., ubuntu@sha256:45b23dee...
+	regex := regexp.MustCompile(`@sha256:[a-f\d]{64}$`)
+	name, pinnedAt := image, ""
+	if n, digest, ok := strings.Cut(image, "@"); ok {
+		name, pinnedAt = n, digest
+	} else if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		name, pinnedAt = image[:i], image[i+1:]
+	}
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    line,
+			EndOffset: line,
+			Snippet:   image,
+		},
+		Name:   asPointer(name),
+		Pinned: asBoolPointer(regex.MatchString(image)),
+		Type:   checker.DependencyUseTypeDockerfileContainerImage,
+	}
+	if pinnedAt != "" {
+		dep.PinnedAt = asPointer(pinnedAt)
+	}
+	return dep
+}
+
 // Check pinning of github actions in workflows.
 func collectGitHubActionsWorkflowPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
 	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
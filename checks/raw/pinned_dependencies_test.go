@@ -2707,6 +2707,133 @@ func TestAnalyseCentralPackageManagementPinned(t *testing.T) {
 	}
 }
 
+func TestAzurePipelinesPinning(t *testing.T) {
+	t.Parallel()
+	const filename = "./testdata/azure-pipelines/pinning.yml"
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+	p := strings.Replace(filename, "./testdata/", "", 1)
+
+	var r checker.PinningDependenciesData
+	if _, err := validateAzurePipelinesPinning(p, content, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type dependency struct {
+		name     string
+		pinnedAt string
+		depType  checker.DependencyUseType
+		line     uint
+		pinned   bool
+	}
+	var got []dependency
+	for _, d := range r.Dependencies {
+		got = append(got, dependency{
+			name:     valueOrEmpty(d.Name),
+			pinnedAt: valueOrEmpty(d.PinnedAt),
+			depType:  d.Type,
+			line:     d.Location.Offset,
+			pinned:   *d.Pinned,
+		})
+	}
+	want := []dependency{
+		{name: "golang", pinnedAt: "1.25", depType: checker.DependencyUseTypeDockerfileContainerImage, line: 7},
+		{
+			name:     "ubuntu",
+			pinnedAt: "sha256:45b23dee08af5e43a7fea6c4cf9c25ccf269ee113168c19722f87876677c5cb2",
+			depType:  checker.DependencyUseTypeDockerfileContainerImage,
+			line:     9,
+			pinned:   true,
+		},
+		{
+			name:     "registry.example.com:5000/tools/node",
+			pinnedAt: "20",
+			depType:  checker.DependencyUseTypeDockerfileContainerImage,
+			line:     31,
+		},
+		{depType: checker.DependencyUseTypeDownloadThenRun, line: 18},
+		{depType: checker.DependencyUseTypePipCommand, line: 19},
+		{depType: checker.DependencyUseTypeNpmCommand, line: 20, pinned: true},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dependency{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func newString(s string) *string {
 	return &s
 }
+
+func TestPipelinesPinning(t *testing.T) {
+	t.Parallel()
+	const config = `version: 2.1
+orbs:
+  node: circleci/node@5.2.0
+  aws: circleci/aws-cli@4
+jobs:
+  build:
+    docker:
+      - image: cimg/go:1.22
+    steps:
+      - run: curl -sSL https://example.com/install.sh | bash
+      - run: echo << pipeline.git.branch >>
+`
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).
+		DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+			if ok, err := predicate(".circleci/config.yml"); ok && err == nil {
+				return []string{".circleci/config.yml"}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(".circleci/config.yml").
+		Return(io.NopCloser(strings.NewReader(config)), nil)
+
+	var r checker.PinningDependenciesData
+	if err := collectPipelinesPinning(&checker.CheckRequest{RepoClient: mockRepoClient}, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type dependency struct {
+		name     string
+		pinnedAt string
+		depType  checker.DependencyUseType
+		line     uint
+		pinned   bool
+	}
+	var got []dependency
+	for _, d := range r.Dependencies {
+		got = append(got, dependency{
+			name:     valueOrEmpty(d.Name),
+			pinnedAt: valueOrEmpty(d.PinnedAt),
+			depType:  d.Type,
+			line:     d.Location.Offset,
+			pinned:   *d.Pinned,
+		})
+	}
+	want := []dependency{
+		{
+			name:     "circleci/node",
+			pinnedAt: "5.2.0",
+			depType:  checker.DependencyUseTypePipelinePlugin,
+			line:     3,
+			pinned:   true,
+		},
+		{name: "circleci/aws-cli", pinnedAt: "4", depType: checker.DependencyUseTypePipelinePlugin, line: 4},
+		{name: "cimg/go", pinnedAt: "1.22", depType: checker.DependencyUseTypeDockerfileContainerImage, line: 8},
+		{depType: checker.DependencyUseTypeDownloadThenRun, line: 10},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(dependency{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
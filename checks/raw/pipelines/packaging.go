@@ -0,0 +1,78 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pipelines collects the raw data of checks from the pipelines of CI
+// systems which projects on any forge use, e.g. CircleCI or Jenkins.
+package pipelines
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// packagingCommands are the commands which publish packages.
+var packagingCommands = []string{
+	"docker push",
+	"npm publish",
+	"nuget push",
+	"poetry publish",
+	"twine upload",
+}
+
+// Packaging checks for packages published by the pipelines parsed by
+// fileparser.ReadPipelines.
+func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
+	var data checker.PackagingData
+	pipelines, err := fileparser.ReadPipelines(c.RepoClient)
+	if err != nil {
+		return data, fmt.Errorf("reading pipelines: %w", err)
+	}
+	for i := range pipelines {
+		if job, line, found := findPackagingCommand(&pipelines[i]); found {
+			data.Packages = append(data.Packages, checker.Package{
+				Name: new(string),
+				Job:  &checker.WorkflowJob{Name: &job},
+				File: &checker.File{
+					Path:   pipelines[i].Path,
+					Offset: line,
+					Type:   finding.FileTypeSource,
+				},
+				Runs: []checker.Run{{URL: c.Repo.URI()}},
+			})
+			return data, nil
+		}
+	}
+	return data, nil
+}
+
+// findPackagingCommand returns the first job, and the line of its command,
+// which publishes a package.
+func findPackagingCommand(pipeline *fileparser.Pipeline) (string, uint, bool) {
+	for i := range pipeline.Jobs {
+		job := &pipeline.Jobs[i]
+		for _, command := range job.Commands {
+			for _, p := range packagingCommands {
+				if start := strings.Index(command.Command, p); start >= 0 {
+					// commands may span several lines.
+					return job.Name, command.Line + uint(strings.Count(command.Command[:start], "\n")), true
+				}
+			}
+		}
+	}
+	return "", checker.OffsetDefault, false
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelines
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+)
+
+func TestFindPackagingCommand(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		pathfn     string
+		content    string
+		job        string
+		lineNumber uint
+		exists     bool
+	}{
+		{
+			name:   "No Publishing Detected",
+			pathfn: ".circleci/config.yml",
+			content: `jobs:
+  test:
+    steps:
+      - run: go test ./...
+`,
+			lineNumber: checker.OffsetDefault,
+		},
+		{
+			name:   "CircleCI npm publish",
+			pathfn: ".circleci/config.yml",
+			content: `jobs:
+  release:
+    steps:
+      - run: |
+          npm ci
+          npm publish
+`,
+			job:        "release",
+			lineNumber: 6,
+			exists:     true,
+		},
+		{
+			name:   "Jenkins docker push",
+			pathfn: "Jenkinsfile",
+			content: `pipeline {
+  stages {
+    stage('Publish') {
+      steps {
+        sh 'docker push example/app:latest'
+      }
+    }
+  }
+}
+`,
+			job:        "Publish",
+			lineNumber: 5,
+			exists:     true,
+		},
+		{
+			name:   "Buildkite twine upload",
+			pathfn: ".buildkite/pipeline.yml",
+			content: `steps:
+  - label: upload
+    command: twine upload dist/*
+`,
+			job:        "upload",
+			lineNumber: 3,
+			exists:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			pipeline, err := fileparser.ParsePipeline(tt.pathfn, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+
+			job, line, found := findPackagingCommand(pipeline)
+
+			if tt.exists && !found {
+				t.Errorf("Packaging %q should exist", tt.name)
+			} else if !tt.exists && found {
+				t.Errorf("No packaging information should have been found in %q", tt.name)
+			}
+			if job != tt.job {
+				t.Errorf("Expected job: %q != %q", tt.job, job)
+			}
+			if line != tt.lineNumber {
+				t.Errorf("Expected line number: %d != %d", tt.lineNumber, line)
+			}
+		})
+	}
+}
+
+func TestPackaging(t *testing.T) {
+	t.Parallel()
+	const filename = "Jenkinsfile"
+
+	ctrl := gomock.NewController(t)
+	moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	moqRepo := mockrepo.NewMockRepo(ctrl)
+
+	moqRepoClient.EXPECT().ListFiles(gomock.Any()).
+		Return([]string{filename, "README.md"}, nil).AnyTimes()
+	moqRepoClient.EXPECT().GetFileReader(filename).
+		Return(io.NopCloser(strings.NewReader("stage('release') {\n  sh 'poetry publish --build'\n}\n")), nil)
+	moqRepo.EXPECT().URI().Return("github.com/owner/repo")
+
+	req := checker.CheckRequest{
+		RepoClient: moqRepoClient,
+		Repo:       moqRepo,
+	}
+	packagingData, err := Packaging(&req)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(packagingData.Packages) != 1 {
+		t.Fatalf("Expected a single package, but received %d", len(packagingData.Packages))
+	}
+	pkg := packagingData.Packages[0]
+	if pkg.File.Path != filename || pkg.File.Offset != 2 {
+		t.Errorf("Expected %s:2, but received %s:%d", filename, pkg.File.Path, pkg.File.Offset)
+	}
+	if *pkg.Job.Name != "release" {
+		t.Errorf("Expected job release, but received %q", *pkg.Job.Name)
+	}
+	if len(pkg.Runs) != 1 || pkg.Runs[0].URL != "github.com/owner/repo" {
+		t.Errorf("Unexpected runs %v", pkg.Runs)
+	}
+}
@@ -45,6 +45,45 @@ var sastTools = map[string]bool{
 	"sonarqubecloud":           true,
 }
 
+// azureSASTTasks are the Azure Pipelines tasks which run SAST tools.
+var azureSASTTasks = map[string]checker.SASTWorkflowType{
+	"AdvancedSecurity-Codeql-Analyze": checker.CodeQLWorkflow,
+	"SonarCloudAnalyze":               checker.SonarWorkflow,
+	"SonarQubeAnalyze":                checker.SonarWorkflow,
+	"SnykSecurityScan":                checker.SnykWorkflow,
+}
+
+// gitlabSASTTemplates are the templates of GitLab which run its SAST analyzers.
+var gitlabSASTTemplates = map[string]bool{
+	"Security/SAST.gitlab-ci.yml":        true,
+	"Security/SAST.latest.gitlab-ci.yml": true,
+	"Jobs/SAST.gitlab-ci.yml":            true,
+	"Jobs/SAST.latest.gitlab-ci.yml":     true,
+}
+
+// gitlabSASTComponent matches the address of the SAST component of GitLab,
+// e.g. $CI_SERVER_FQDN/components/sast/sast@3.
+var gitlabSASTComponent = regexp.MustCompile(`/components/sast/sast(@|$)`)
+
+// pipelineSASTPlugins are the CircleCI orbs which run SAST tools.
+var pipelineSASTPlugins = map[string]checker.SASTWorkflowType{
+	"sonarsource/sonarcloud": checker.SonarWorkflow,
+	"snyk/snyk":              checker.SnykWorkflow,
+}
+
+// pipelineSASTCommands are the commands which run SAST tools.
+var pipelineSASTCommands = []struct {
+	pattern *regexp.Regexp
+	tool    checker.SASTWorkflowType
+}{
+	{regexp.MustCompile(`\bcodeql\s+database\s+analyze\b`), checker.CodeQLWorkflow},
+	{regexp.MustCompile(`\bsonar-scanner\b|\bsonar:sonar\b`), checker.SonarWorkflow},
+	{regexp.MustCompile(`\bsnyk\s+code\s+test\b`), checker.SnykWorkflow},
+	{regexp.MustCompile(`\bpyre\b.*\banalyze\b`), checker.PysaWorkflow},
+	{regexp.MustCompile(`\bqodana\s+scan\b`), checker.QodanaWorkflow},
+	{regexp.MustCompile(`\bhadolint\b`), checker.HadolintWorkflow},
+}
+
 var allowedConclusions = map[string]bool{"success": true, "neutral": true}
 
 // SAST checks for presence of static analysis tools.
@@ -93,6 +132,24 @@ func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 	}
 	data.Workflows = append(data.Workflows, hadolintWorkflows...)
 
+	azureWorkflows, err := getAzurePipelinesSASTWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, azureWorkflows...)
+
+	gitlabWorkflows, err := getGitLabSASTWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, gitlabWorkflows...)
+
+	pipelineWorkflows, err := getPipelinesSASTWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, pipelineWorkflows...)
+
 	return data, nil
 }
 
@@ -118,6 +175,10 @@ func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error)
 
 		checked := false
 		crs, err := c.RepoClient.ListCheckRunsForRef(pr.HeadSHA)
+		// local git checkouts know merged pull requests, but not their check runs.
+		if errors.Is(err, clients.ErrUnsupportedFeature) {
+			return sastCommits, nil
+		}
 		if err != nil {
 			return sastCommits,
 				sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("Client.Checks.ListCheckRunsForRef: %v", err))
@@ -237,6 +298,132 @@ var searchGitHubActionWorkflowUseRegex fileparser.DoWhileTrueOnFileContent = fun
 	return true, nil
 }
 
+// getAzurePipelinesSASTWorkflows returns the Azure Pipelines steps which run a
+// SAST task.
+func getAzurePipelinesSASTWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	var sastWorkflows []checker.SASTWorkflow
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*.y*ml",
+		CaseSensitive: false,
+	}, searchAzurePipelinesSASTTasks, &sastWorkflows)
+	if err != nil {
+		return nil, err
+	}
+	return sastWorkflows, nil
+}
+
+var searchAzurePipelinesSASTTasks fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if ok, err := fileparser.IsAzurePipelinesFile(path); !ok || err != nil {
+		return true, err
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"searchAzurePipelinesSASTTasks requires exactly 1 argument: %w", errInvalid)
+	}
+
+	workflows, ok := args[0].(*[]checker.SASTWorkflow)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchAzurePipelinesSASTTasks expects arg[0] of type *[]checker.SASTWorkflow: %w", errInvalid)
+	}
+
+	pipeline, err := fileparser.ParseAzurePipeline(content)
+	if err != nil {
+		// YAML files at the conventional locations need not be pipelines.
+		return true, nil
+	}
+	for i := range pipeline.Jobs {
+		for j := range pipeline.Jobs[i].Steps {
+			step := &pipeline.Jobs[i].Steps[j]
+			tool, ok := azureSASTTasks[step.TaskName()]
+			if !ok {
+				continue
+			}
+			*workflows = append(*workflows, checker.SASTWorkflow{
+				Type: tool,
+				File: checker.File{
+					Path:   path,
+					Offset: step.Line,
+					Type:   finding.FileTypeSource,
+				},
+			})
+		}
+	}
+	return true, nil
+}
+
+func getGitLabSASTWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	ci, err := fileparser.ReadGitLabCI(c.RepoClient)
+	if err != nil {
+		return nil, fmt.Errorf("reading GitLab CI configuration: %w", err)
+	}
+	if ci == nil {
+		return nil, nil
+	}
+	var sastWorkflows []checker.SASTWorkflow
+	for _, include := range ci.Includes {
+		if !gitlabSASTTemplates[include.Template] && !gitlabSASTComponent.MatchString(include.Component) {
+			continue
+		}
+		sastWorkflows = append(sastWorkflows, checker.SASTWorkflow{
+			Type: checker.GitLabSASTWorkflow,
+			File: checker.File{
+				Path:   include.Path,
+				Offset: include.Line,
+				Type:   finding.FileTypeSource,
+			},
+		})
+	}
+	return sastWorkflows, nil
+}
+
+// getPipelinesSASTWorkflows returns the orbs and commands of the pipelines of
+// CI systems parsed by fileparser.ReadPipelines which run SAST tools.
+func getPipelinesSASTWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	pipelines, err := fileparser.ReadPipelines(c.RepoClient)
+	if err != nil {
+		return nil, fmt.Errorf("reading pipelines: %w", err)
+	}
+	var sastWorkflows []checker.SASTWorkflow
+	newWorkflow := func(tool checker.SASTWorkflowType, pathfn string, line uint) checker.SASTWorkflow {
+		return checker.SASTWorkflow{
+			Type: tool,
+			File: checker.File{
+				Path:   pathfn,
+				Offset: line,
+				Type:   finding.FileTypeSource,
+			},
+		}
+	}
+	for i := range pipelines {
+		pipeline := &pipelines[i]
+		for _, plugin := range pipeline.Plugins {
+			if tool, ok := pipelineSASTPlugins[plugin.Name]; ok {
+				sastWorkflows = append(sastWorkflows, newWorkflow(tool, pipeline.Path, plugin.Line))
+			}
+		}
+		for j := range pipeline.Jobs {
+			for _, command := range pipeline.Jobs[j].Commands {
+				for _, sast := range pipelineSASTCommands {
+					loc := sast.pattern.FindStringIndex(command.Command)
+					if loc == nil {
+						continue
+					}
+					// commands may span several lines.
+					line := command.Line + uint(strings.Count(command.Command[:loc[0]], "\n"))
+					sastWorkflows = append(sastWorkflows, newWorkflow(sast.tool, pipeline.Path, line))
+					break
+				}
+			}
+		}
+	}
+	return sastWorkflows, nil
+}
+
 type sonarConfig struct {
 	url  string
 	file checker.File
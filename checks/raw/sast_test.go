@@ -271,6 +271,78 @@ func TestSAST(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "Has Azure Pipelines tasks",
+			files: []string{"azure-pipelines/sast.yml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.CodeQLWorkflow,
+						File: checker.File{
+							Path:   "azure-pipelines/sast.yml",
+							Offset: 12,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type: checker.SonarWorkflow,
+						File: checker.File{
+							Path:   "azure-pipelines/sast.yml",
+							Offset: 13,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "Has CircleCI orb and command",
+			files: []string{".circleci/config.yml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.SonarWorkflow,
+						File: checker.File{
+							Path:   ".circleci/config.yml",
+							Offset: 3,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type: checker.HadolintWorkflow,
+						File: checker.File{
+							Path:   ".circleci/config.yml",
+							Offset: 12,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "Has GitLab SAST template and component",
+			files: []string{".gitlab-ci.yml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.GitLabSASTWorkflow,
+						File: checker.File{
+							Path:   ".gitlab-ci.yml",
+							Offset: 3,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type: checker.GitLabSASTWorkflow,
+						File: checker.File{
+							Path:   ".gitlab-ci.yml",
+							Offset: 4,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -550,3 +550,38 @@ func release(version string) clients.Release {
 		},
 	}
 }
+
+func TestSignedRelease_preconditions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoC := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoC.EXPECT().ListReleases().Return(nil, nil).AnyTimes()
+	mockRepo := mockrepo.NewMockRepo(ctrl)
+	mockRepo.EXPECT().Host().Return("github.com").AnyTimes()
+	mockRepo.EXPECT().Path().Return("test/repo").AnyTimes()
+	mockPkgC := mockrepo.NewMockProjectPackageClient(ctrl)
+	mockPkgC.EXPECT().GetProjectPackageVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&packageclient.ProjectPackageVersions{}, nil).AnyTimes()
+	req := checker.CheckRequest{
+		RepoClient:    mockRepoC,
+		Repo:          mockRepo,
+		ProjectClient: mockPkgC,
+		Dlogger:       &scut.TestDetailLogger{},
+		RawResults:    &checker.RawResults{},
+	}
+
+	// a project without releases keeps the score of its not applicable probes,
+	// with the reason given by the unmet precondition.
+	result := SignedReleases(&req)
+	if result.Score != checker.InconclusiveResultScore {
+		t.Errorf("score with unmet precondition = %d, want %d", result.Score, checker.InconclusiveResultScore)
+	}
+	if len(result.Findings) == 0 {
+		t.Fatal("no findings with unmet precondition")
+	}
+	for i := range result.Findings {
+		if result.Findings[i].Message != "project has no releases" {
+			t.Errorf("unexpected finding %v", result.Findings[i])
+		}
+	}
+}
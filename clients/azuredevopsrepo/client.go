@@ -34,6 +34,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
 
 	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
 )
 
 var (
@@ -60,6 +61,7 @@ type Client struct {
 	servicehooks  *servicehooksHandler
 	workItems     *workItemsHandler
 	zip           *zipHandler
+	token         string
 	commitDepth   int
 }
 
@@ -136,6 +138,10 @@ func (c *Client) IsArchived() (bool, error) {
 	return *c.repo.IsDisabled, nil
 }
 
+func (c *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
 	return c.zip.listFiles(predicate)
 }
@@ -179,9 +185,25 @@ func (c *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 	return c.branches.getDefaultBranch()
 }
 
-// Org repository, AKA the <org>/.github repository, is a GitHub-specific feature.
-func (c *Client) GetOrgRepoClient(context.Context) (clients.RepoClient, error) {
-	return nil, clients.ErrUnsupportedFeature
+// GetOrgRepoClient returns a client for the default repository of the project,
+// which is named after the project and plays the part of GitHub's <org>/.github
+// repository.
+func (c *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
+	orgRepo := &Repo{
+		scheme:       c.repourl.scheme,
+		host:         c.repourl.host,
+		organization: c.repourl.organization,
+		project:      c.repourl.project,
+		name:         c.repourl.project,
+	}
+	client, err := CreateAzureDevOpsClientWithToken(ctx, c.token, orgRepo)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrRepoUnreachable, err.Error())
+	}
+	if err := client.InitRepo(orgRepo, clients.HeadSHA, 0); err != nil {
+		return nil, sce.WithMessage(sce.ErrRepoUnreachable, err.Error())
+	}
+	return client, nil
 }
 
 func (c *Client) ListCommits() ([]clients.Commit, error) {
@@ -290,6 +312,7 @@ func CreateAzureDevOpsClientWithToken(ctx context.Context, token string, repo cl
 	return &Client{
 		ctx:        ctx,
 		azdoClient: gitClient,
+		token:      token,
 		audit: &auditHandler{
 			auditClient: auditClient,
 		},
@@ -0,0 +1,247 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	cloudAPIURL = "https://api.bitbucket.org/2.0"
+	cloudWebURL = "https://" + cloudHost
+	// pageLimit is the largest page size accepted by every list endpoint.
+	pageLimit = 50
+)
+
+var errUnexpectedStatus = errors.New("unexpected status code")
+
+// statusError is returned for responses other than 200 OK.
+type statusError struct {
+	url  string
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("GET %s: %d %s", e.url, e.code, http.StatusText(e.code))
+}
+
+func (e *statusError) Unwrap() error {
+	return errUnexpectedStatus
+}
+
+// hasStatus reports whether err is a response with one of the status codes.
+func hasStatus(err error, codes ...int) bool {
+	var se *statusError
+	if !errors.As(err, &se) {
+		return false
+	}
+	for _, code := range codes {
+		if se.code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// apiClient calls the REST APIs of Bitbucket Cloud or Data Center:
+// https://developer.atlassian.com/cloud/bitbucket/rest/
+// https://developer.atlassian.com/server/bitbucket/rest/
+type apiClient struct {
+	httpClient *http.Client
+	// baseURL is https://api.bitbucket.org/2.0 for Cloud, and the /rest path
+	// of Data Center instances.
+	baseURL string
+	// webURL serves the archives of Cloud repositories.
+	webURL string
+	// cloudAPIURL and cloudWebURL are replaced by tests.
+	cloudAPIURL string
+	cloudWebURL string
+	username    string
+	token       string
+}
+
+func newAPIClient(httpClient *http.Client, username, token string) *apiClient {
+	return &apiClient{
+		httpClient:  httpClient,
+		cloudAPIURL: cloudAPIURL,
+		cloudWebURL: cloudWebURL,
+		username:    username,
+		token:       token,
+	}
+}
+
+// setRepo points the client to the APIs serving repourl.
+func (c *apiClient) setRepo(repourl *Repo) {
+	if !repourl.dataCenter {
+		c.baseURL, c.webURL = c.cloudAPIURL, c.cloudWebURL
+		return
+	}
+	scheme := repourl.scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	c.webURL = fmt.Sprintf("%s://%s%s", scheme, repourl.host, repourl.basePath)
+	c.baseURL = c.webURL + "/rest"
+}
+
+// do sends a GET request for rawURL.
+func (c *apiClient) do(ctx context.Context, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.username != "":
+		// app passwords of Bitbucket Cloud.
+		req.SetBasicAuth(c.username, c.token)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("httpClient.Do: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &statusError{url: req.URL.Path, code: resp.StatusCode}
+	}
+	return resp, nil
+}
+
+func (c *apiClient) getURL(ctx context.Context, rawURL string, v any) error {
+	resp, err := c.do(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", rawURL, err)
+	}
+	return nil
+}
+
+// get decodes the JSON response for path, relative to baseURL, into v.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values, v any) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return c.getURL(ctx, u, v)
+}
+
+// download copies the response for rawURL to w.
+func (c *apiClient) download(ctx context.Context, rawURL string, w io.Writer) error {
+	resp, err := c.do(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("io.Copy: %w", err)
+	}
+	return nil
+}
+
+type cloudPage[T any] struct {
+	Next   string `json:"next"`
+	Values []T    `json:"values"`
+}
+
+// getCloudPages follows the next links of a Cloud list endpoint until max
+// items were read. A max of 0 reads every page.
+func getCloudPages[T any](ctx context.Context, c *apiClient, path string, query url.Values, maxItems int) ([]T, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("pagelen", strconv.Itoa(pageSize(maxItems)))
+	next := c.baseURL + path + "?" + q.Encode()
+
+	var all []T
+	for next != "" {
+		var page cloudPage[T]
+		if err := c.getURL(ctx, next, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Values...)
+		if maxItems > 0 && len(all) >= maxItems {
+			return all[:maxItems], nil
+		}
+		next = page.Next
+	}
+	return all, nil
+}
+
+type serverPage[T any] struct {
+	Values        []T  `json:"values"`
+	NextPageStart int  `json:"nextPageStart"`
+	IsLastPage    bool `json:"isLastPage"`
+}
+
+// getServerPages reads the pages of a Data Center list endpoint until the
+// last one, or max items were read. A max of 0 reads every page.
+func getServerPages[T any](ctx context.Context, c *apiClient, path string, query url.Values, maxItems int) ([]T, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("limit", strconv.Itoa(pageSize(maxItems)))
+
+	var all []T
+	for start := 0; ; {
+		q.Set("start", strconv.Itoa(start))
+		var page serverPage[T]
+		if err := c.get(ctx, path, q, &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Values...)
+		if maxItems > 0 && len(all) >= maxItems {
+			return all[:maxItems], nil
+		}
+		if page.IsLastPage || len(page.Values) == 0 {
+			return all, nil
+		}
+		start = page.NextPageStart
+	}
+}
+
+func pageSize(maxItems int) int {
+	if maxItems > 0 && maxItems < pageLimit {
+		return maxItems
+	}
+	return pageLimit
+}
+
+// repoPath returns the API path of a repository resource.
+func repoPath(repourl *Repo, elem ...string) string {
+	var p string
+	if repourl.dataCenter {
+		p = "/api/1.0/projects/" + url.PathEscape(repourl.owner) + "/repos/" + url.PathEscape(repourl.project)
+	} else {
+		p = "/repositories/" + url.PathEscape(repourl.owner) + "/" + url.PathEscape(repourl.project)
+	}
+	for _, e := range elem {
+		p += "/" + url.PathEscape(e)
+	}
+	return p
+}
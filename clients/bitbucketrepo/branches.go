@@ -0,0 +1,297 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+var errBranchNotFound = errors.New("branch not found")
+
+// cloudRestriction is a branch restriction of Bitbucket Cloud. Restrictions
+// matching branches of the branching model instead of a glob are ignored.
+type cloudRestriction struct {
+	Value           *int32 `json:"value"`
+	Kind            string `json:"kind"`
+	BranchMatchKind string `json:"branch_match_kind"`
+	Pattern         string `json:"pattern"`
+	Users           []any  `json:"users"`
+	Groups          []any  `json:"groups"`
+}
+
+// serverRestriction is a branch permission of Bitbucket Data Center.
+type serverRestriction struct {
+	Type    string `json:"type"`
+	Matcher struct {
+		ID   string `json:"id"`
+		Type struct {
+			ID string `json:"id"`
+		} `json:"type"`
+	} `json:"matcher"`
+	Users      []any `json:"users"`
+	Groups     []any `json:"groups"`
+	AccessKeys []any `json:"accessKeys"`
+}
+
+// serverPullRequestSettings are the merge checks of a Data Center repository,
+// which apply to pull requests targeting any branch.
+type serverPullRequestSettings struct {
+	RequiredApprovers        *int32 `json:"requiredApprovers"`
+	RequiredSuccessfulBuilds *int32 `json:"requiredSuccessfulBuilds"`
+}
+
+type serverBranch struct {
+	DisplayID string `json:"displayId"`
+}
+
+// protection collects the restrictions on a branch from either API.
+type protection struct {
+	requiredApprovals   *int32
+	requirePullRequests bool
+	exempted            bool
+	noForcePushes       bool
+	noDeletes           bool
+	requireBuilds       bool
+	dismissStale        bool
+	codeOwners          bool
+}
+
+func (p *protection) protected() bool {
+	return p.requirePullRequests || p.noForcePushes || p.noDeletes ||
+		p.requiredApprovals != nil || p.requireBuilds
+}
+
+func (p *protection) rule() clients.BranchProtectionRule {
+	allowDeletions := !p.noDeletes
+	allowForcePushes := !p.noForcePushes
+	// no one can bypass the restriction on pushing.
+	enforceAdmins := p.requirePullRequests && !p.exempted
+	return clients.BranchProtectionRule{
+		AllowDeletions:   &allowDeletions,
+		AllowForcePushes: &allowForcePushes,
+		EnforceAdmins:    &enforceAdmins,
+		PullRequestRule: clients.PullRequestRule{
+			Required:                     &p.requirePullRequests,
+			RequiredApprovingReviewCount: p.requiredApprovals,
+			DismissStaleReviews:          &p.dismissStale,
+			RequireCodeOwnerReviews:      &p.codeOwners,
+		},
+		CheckRules: clients.StatusChecksRule{
+			RequiresStatusChecks: &p.requireBuilds,
+		},
+	}
+}
+
+type branchesHandler struct {
+	api           *apiClient
+	ctx           context.Context
+	once          *sync.Once
+	errSetup      error
+	repourl       *Repo
+	defaultBranch *clients.BranchRef
+}
+
+func (handler *branchesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+}
+
+func (handler *branchesHandler) setup() error {
+	handler.once.Do(func() {
+		handler.defaultBranch, handler.errSetup = handler.getBranch(handler.repourl.defaultBranch)
+	})
+	return handler.errSetup
+}
+
+func (handler *branchesHandler) getDefaultBranch() (*clients.BranchRef, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during branchesHandler.setup: %w", err)
+	}
+	return handler.defaultBranch, nil
+}
+
+// getBranch returns the restrictions on a branch, which only repository
+// admins can read.
+func (handler *branchesHandler) getBranch(name string) (*clients.BranchRef, error) {
+	var p *protection
+	var err error
+	if handler.repourl.dataCenter {
+		p, err = handler.getServerProtection(name)
+	} else {
+		p, err = handler.getCloudProtection(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	protected := p.protected()
+	ref := &clients.BranchRef{
+		Name:      &name,
+		Protected: &protected,
+	}
+	if protected {
+		ref.BranchProtectionRule = p.rule()
+	}
+	return ref, nil
+}
+
+func (handler *branchesHandler) getCloudProtection(name string) (*protection, error) {
+	var branch struct{}
+	if err := handler.api.get(handler.ctx, repoPath(handler.repourl, "refs", "branches", name), nil, &branch); err != nil {
+		if hasStatus(err, http.StatusNotFound) {
+			return nil, fmt.Errorf("%w: %s", errBranchNotFound, name)
+		}
+		return nil, fmt.Errorf("request for branch %s failed with error: %w", name, err)
+	}
+	restrictions, err := getCloudPages[cloudRestriction](handler.ctx, handler.api,
+		repoPath(handler.repourl, "branch-restrictions"), nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("request for branch restrictions failed with error: %w", err)
+	}
+
+	var p protection
+	var approvals *int32
+	enforced := false
+	for i := range restrictions {
+		r := &restrictions[i]
+		if r.BranchMatchKind != "glob" || !matchesGlob(r.Pattern, name) {
+			continue
+		}
+		switch r.Kind {
+		case "push":
+			p.requirePullRequests = true
+			p.exempted = p.exempted || len(r.Users) > 0 || len(r.Groups) > 0
+		case "force":
+			p.noForcePushes = true
+		case "delete":
+			p.noDeletes = true
+		case "require_approvals_to_merge":
+			approvals = r.Value
+		case "require_default_reviewer_approvals_to_merge":
+			p.codeOwners = true
+		case "require_passing_builds_to_merge":
+			p.requireBuilds = true
+		case "reset_pullrequest_approvals_on_change", "smart_reset_pullrequest_approvals":
+			p.dismissStale = true
+		case "enforce_merge_checks":
+			enforced = true
+		}
+	}
+	// merge checks only warn unless they are enforced, a Premium feature.
+	if approvals != nil {
+		var count int32
+		if enforced {
+			count = *approvals
+		}
+		p.requiredApprovals = &count
+	}
+	return &p, nil
+}
+
+func (handler *branchesHandler) getServerProtection(name string) (*protection, error) {
+	branches, err := getServerPages[serverBranch](handler.ctx, handler.api,
+		repoPath(handler.repourl, "branches"), url.Values{"filterText": {name}}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("request for branch %s failed with error: %w", name, err)
+	}
+	found := false
+	for _, b := range branches {
+		found = found || b.DisplayID == name
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errBranchNotFound, name)
+	}
+
+	path := "/branch-permissions/2.0/projects/" + url.PathEscape(handler.repourl.owner) +
+		"/repos/" + url.PathEscape(handler.repourl.project) + "/restrictions"
+	restrictions, err := getServerPages[serverRestriction](handler.ctx, handler.api, path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("request for branch permissions failed with error: %w", err)
+	}
+
+	var p protection
+	for i := range restrictions {
+		r := &restrictions[i]
+		if !serverRestrictionMatches(r, name) {
+			continue
+		}
+		switch r.Type {
+		case "read-only", "pull-request-only":
+			p.requirePullRequests = true
+			p.exempted = p.exempted || len(r.Users) > 0 || len(r.Groups) > 0 || len(r.AccessKeys) > 0
+		case "fast-forward-only":
+			p.noForcePushes = true
+		case "no-deletes":
+			p.noDeletes = true
+		}
+	}
+	if !p.protected() {
+		return &p, nil
+	}
+
+	var settings serverPullRequestSettings
+	err = handler.api.get(handler.ctx, repoPath(handler.repourl, "settings", "pull-requests"), nil, &settings)
+	switch {
+	case err == nil:
+		p.requiredApprovals = settings.RequiredApprovers
+		p.requireBuilds = settings.RequiredSuccessfulBuilds != nil && *settings.RequiredSuccessfulBuilds > 0
+	case !hasStatus(err, http.StatusUnauthorized, http.StatusForbidden):
+		return nil, fmt.Errorf("request for pull request settings failed with error: %w", err)
+	}
+	return &p, nil
+}
+
+// serverRestrictionMatches reports whether a restriction applies to a branch.
+// Restrictions on branch types of the branching model are ignored.
+func serverRestrictionMatches(r *serverRestriction, branch string) bool {
+	switch r.Matcher.Type.ID {
+	case "BRANCH":
+		return r.Matcher.ID == "refs/heads/"+branch
+	case "PATTERN":
+		return matchesGlob(strings.TrimPrefix(r.Matcher.ID, "refs/heads/"), branch)
+	default:
+		return false
+	}
+}
+
+// matchesGlob reports whether a branch matches a pattern, in which * matches
+// any characters and ? a single one.
+func matchesGlob(pattern, branch string) bool {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	return err == nil && re.MatchString(branch)
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func TestGetBranch(t *testing.T) {
+	t.Parallel()
+	main, dev := "main", "dev"
+	yes, no := true, false
+	var noApprovals, oneApproval, twoApprovals int32 = 0, 1, 2
+
+	tests := []struct {
+		name       string
+		branch     string
+		routes     map[string]string
+		want       *clients.BranchRef
+		dataCenter bool
+	}{
+		{
+			name:   "cloud branch restrictions",
+			branch: "main",
+			routes: cloudRoutes,
+			want: &clients.BranchRef{
+				Name:      &main,
+				Protected: &yes,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					AllowDeletions:   &no,
+					AllowForcePushes: &no,
+					EnforceAdmins:    &yes,
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     &yes,
+						RequiredApprovingReviewCount: &twoApprovals,
+						DismissStaleReviews:          &yes,
+						RequireCodeOwnerReviews:      &no,
+					},
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: &yes,
+					},
+				},
+			},
+		},
+		{
+			name:   "cloud merge checks which are not enforced",
+			branch: "main",
+			routes: map[string]string{
+				cloudRepoPath:                          "cloud/repo.json",
+				cloudRepoPath + "/refs/branches/main":  "cloud/branch-main.json",
+				cloudRepoPath + "/branch-restrictions": "cloud/branch-restrictions-unenforced.json",
+			},
+			want: &clients.BranchRef{
+				Name:      &main,
+				Protected: &yes,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					AllowDeletions:   &yes,
+					AllowForcePushes: &yes,
+					EnforceAdmins:    &no,
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     &yes,
+						RequiredApprovingReviewCount: &noApprovals,
+						DismissStaleReviews:          &no,
+						RequireCodeOwnerReviews:      &yes,
+					},
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: &no,
+					},
+				},
+			},
+		},
+		{
+			name:   "cloud branch matching a pattern",
+			branch: "dev",
+			routes: cloudRoutes,
+			want: &clients.BranchRef{
+				Name:      &dev,
+				Protected: &yes,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					AllowDeletions:   &yes,
+					AllowForcePushes: &no,
+					EnforceAdmins:    &no,
+					PullRequestRule: clients.PullRequestRule{
+						Required:                &no,
+						DismissStaleReviews:     &no,
+						RequireCodeOwnerReviews: &no,
+					},
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: &no,
+					},
+				},
+			},
+		},
+		{
+			name:       "data center branch permissions",
+			branch:     "main",
+			routes:     serverRoutes,
+			dataCenter: true,
+			want: &clients.BranchRef{
+				Name:      &main,
+				Protected: &yes,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					AllowDeletions:   &yes,
+					AllowForcePushes: &no,
+					EnforceAdmins:    &no,
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     &yes,
+						RequiredApprovingReviewCount: &oneApproval,
+						DismissStaleReviews:          &no,
+						RequireCodeOwnerReviews:      &no,
+					},
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: &yes,
+					},
+				},
+			},
+		},
+		{
+			name:       "data center without branch permissions",
+			branch:     "main",
+			routes:     withoutRoute(serverRoutes, "/rest/branch-permissions/2.0/projects/OSSF/repos/scorecard/restrictions"),
+			dataCenter: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newTestClient(t, tt.routes, tt.dataCenter).GetBranch(tt.branch)
+			if (err != nil) != (tt.want == nil) {
+				t.Fatalf("GetBranch() error = %v, want %v", err, tt.want)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetBranch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetBranch_missing(t *testing.T) {
+	t.Parallel()
+	if _, err := newTestClient(t, cloudRoutes, false).GetBranch("missing"); err == nil {
+		t.Error("GetBranch() of a missing Cloud branch succeeded")
+	}
+	if _, err := newTestClient(t, serverRoutes, true).GetBranch("missing"); err == nil {
+		t.Error("GetBranch() of a missing Data Center branch succeeded")
+	}
+}
+
+func TestMatchesGlob(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern, branch string
+		want            bool
+	}{
+		{pattern: "main", branch: "main", want: true},
+		{pattern: "main", branch: "main-old", want: false},
+		{pattern: "release/*", branch: "release/1.0", want: true},
+		{pattern: "v?.x", branch: "v1.x", want: true},
+		{pattern: "v?.x", branch: "v10.x", want: false},
+		{pattern: "feature.*", branch: "feature-x", want: false},
+	}
+	for _, tt := range tests {
+		if got := matchesGlob(tt.pattern, tt.branch); got != tt.want {
+			t.Errorf("matchesGlob(%q, %q) = %t, want %t", tt.pattern, tt.branch, got, tt.want)
+		}
+	}
+}
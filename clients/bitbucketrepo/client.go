@@ -0,0 +1,273 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bitbucketrepo implements clients.RepoClient for Bitbucket Cloud and
+// Bitbucket Data Center.
+package bitbucketrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+var (
+	_                clients.RepoClient = &Client{}
+	errInputRepoType                    = errors.New("input repo should be of type bitbucketrepo.Repo")
+)
+
+// cloudRepository is a repository of the Cloud API.
+type cloudRepository struct {
+	CreatedOn  time.Time `json:"created_on"`
+	MainBranch *struct {
+		Name string `json:"name"`
+	} `json:"mainbranch"`
+	Language string `json:"language"`
+}
+
+// serverRepository is a repository of the Data Center API, the default branch
+// of which is read separately.
+type serverRepository struct {
+	Archived bool `json:"archived"`
+}
+
+type Client struct {
+	repourl     *Repo
+	createdAt   time.Time
+	api         *apiClient
+	branches    *branchesHandler
+	commits     *commitsHandler
+	statuses    *statusesHandler
+	webhook     *webhookHandler
+	tarball     *tarballHandler
+	ctx         context.Context
+	language    string
+	commitDepth int
+	archived    bool
+}
+
+// InitRepo fetches the repository and sets up the handlers for it.
+func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
+	bitbucketRepo, ok := inputRepo.(*Repo)
+	if !ok {
+		return fmt.Errorf("%w: %v", errInputRepoType, inputRepo)
+	}
+	// cleanup previous state, if any.
+	if client.tarball.tempDir != "" {
+		if err := client.tarball.cleanup(); err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+	}
+	client.api.setRepo(bitbucketRepo)
+
+	// Sanity check.
+	var defaultBranch string
+	if bitbucketRepo.dataCenter {
+		var repo serverRepository
+		if err := client.api.get(client.ctx, repoPath(bitbucketRepo), nil, &repo); err != nil {
+			return sce.WithMessage(sce.ErrRepoUnreachable, bitbucketRepo.Path()+"\t"+err.Error())
+		}
+		var branch serverBranch
+		if err := client.api.get(client.ctx, repoPath(bitbucketRepo, "default-branch"), nil, &branch); err != nil {
+			return sce.WithMessage(sce.ErrRepoUnreachable, bitbucketRepo.Path()+"\t"+err.Error())
+		}
+		client.archived, client.createdAt, client.language = repo.Archived, time.Time{}, ""
+		defaultBranch = branch.DisplayID
+	} else {
+		var repo cloudRepository
+		if err := client.api.get(client.ctx, repoPath(bitbucketRepo), nil, &repo); err != nil {
+			return sce.WithMessage(sce.ErrRepoUnreachable, bitbucketRepo.Path()+"\t"+err.Error())
+		}
+		client.archived, client.createdAt, client.language = false, repo.CreatedOn, repo.Language
+		if repo.MainBranch != nil {
+			defaultBranch = repo.MainBranch.Name
+		}
+	}
+
+	if commitDepth <= 0 {
+		client.commitDepth = 30 // default
+	} else {
+		client.commitDepth = commitDepth
+	}
+	client.repourl = &Repo{
+		scheme:        bitbucketRepo.scheme,
+		host:          bitbucketRepo.host,
+		basePath:      bitbucketRepo.basePath,
+		owner:         bitbucketRepo.owner,
+		project:       bitbucketRepo.project,
+		defaultBranch: defaultBranch,
+		commitSHA:     commitSHA,
+		dataCenter:    bitbucketRepo.dataCenter,
+	}
+
+	client.branches.init(client.ctx, client.repourl)
+	client.commits.init(client.ctx, client.repourl, client.commitDepth)
+	client.statuses.init(client.ctx, client.repourl)
+	client.webhook.init(client.ctx, client.repourl)
+	client.tarball.init(client.ctx, client.repourl)
+	return nil
+}
+
+func (client *Client) URI() string {
+	return client.repourl.URI()
+}
+
+func (client *Client) LocalPath() (string, error) {
+	return "", nil
+}
+
+func (client *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return client.tarball.listFiles(predicate)
+}
+
+func (client *Client) GetFileReader(filename string) (io.ReadCloser, error) {
+	return client.tarball.getFile(filename)
+}
+
+func (client *Client) ListCommits() ([]clients.Commit, error) {
+	return client.commits.listCommits()
+}
+
+// ListIssues implements RepoClient.ListIssues. Issues of Bitbucket Cloud are
+// deprecated in favour of Jira, and Data Center never had them.
+func (client *Client) ListIssues() ([]clients.Issue, error) {
+	return nil, fmt.Errorf("ListIssues (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+// ListReleases implements RepoClient.ListReleases. Bitbucket has no releases,
+// only tags and uploaded downloads.
+func (client *Client) ListReleases() ([]clients.Release, error) {
+	return nil, fmt.Errorf("ListReleases (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListContributors() ([]clients.User, error) {
+	return nil, fmt.Errorf("ListContributors (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) IsArchived() (bool, error) {
+	return client.archived, nil
+}
+
+func (client *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
+	return client.branches.getDefaultBranch()
+}
+
+func (client *Client) GetDefaultBranchName() (string, error) {
+	return client.repourl.defaultBranch, nil
+}
+
+func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
+	return client.branches.getBranch(branch)
+}
+
+// GetCreatedAt implements RepoClient.GetCreatedAt. The Data Center API has no
+// creation time for repositories.
+func (client *Client) GetCreatedAt() (time.Time, error) {
+	if client.repourl.dataCenter {
+		return time.Time{}, fmt.Errorf("GetCreatedAt (Bitbucket Data Center): %w", clients.ErrUnsupportedFeature)
+	}
+	return client.createdAt, nil
+}
+
+func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetOrgRepoClient (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
+	return client.webhook.listWebhooks()
+}
+
+// ListSuccessfulWorkflowRuns implements RepoClient.ListSuccessfulWorkflowRuns.
+// Bitbucket Pipelines has no workflow files other than bitbucket-pipelines.yml.
+func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	return nil, fmt.Errorf("ListSuccessfulWorkflowRuns (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+// ListCheckRunsForRef implements RepoClient.ListCheckRunsForRef. Bitbucket
+// reports every build as a commit status, so there are never any check runs.
+func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	return []clients.CheckRun{}, nil
+}
+
+func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
+	return client.statuses.listStatuses(ref)
+}
+
+// ListProgrammingLanguages implements RepoClient.ListProgrammingLanguages.
+// Cloud repositories declare a single language, without a number of lines.
+func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
+	if client.language == "" {
+		return []clients.Language{}, nil
+	}
+	return []clients.Language{{Name: clients.LanguageName(strings.ToLower(client.language))}}, nil
+}
+
+func (client *Client) ListLicenses() ([]clients.License, error) {
+	return nil, fmt.Errorf("ListLicenses (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	return clients.SearchResponse{}, fmt.Errorf("Search (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+	return nil, fmt.Errorf("SearchCommits (Bitbucket): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) Close() error {
+	return client.tarball.cleanup()
+}
+
+// CreateBitbucketClient returns a client authenticated with the token in
+// BITBUCKET_AUTH_TOKEN if it is set. Together with BITBUCKET_USERNAME, the
+// token is used as an app password of Bitbucket Cloud.
+func CreateBitbucketClient(ctx context.Context) (clients.RepoClient, error) {
+	return newBitbucketClient(ctx, http.DefaultClient), nil
+}
+
+// CreateBitbucketClientWithCache returns a client which caches responses on
+// disk in cacheDir, revalidating them with conditional requests.
+func CreateBitbucketClientWithCache(ctx context.Context, cacheDir string) (clients.RepoClient, error) {
+	rt, err := httpcache.NewTransport(http.DefaultTransport, cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not create bitbucket client with error: %w", err)
+	}
+	return newBitbucketClient(ctx, &http.Client{Transport: rt}), nil
+}
+
+func newBitbucketClient(ctx context.Context, httpClient *http.Client) *Client {
+	api := newAPIClient(httpClient, os.Getenv("BITBUCKET_USERNAME"), os.Getenv("BITBUCKET_AUTH_TOKEN"))
+	return &Client{
+		ctx:      ctx,
+		api:      api,
+		branches: &branchesHandler{api: api},
+		commits:  &commitsHandler{api: api},
+		statuses: &statusesHandler{api: api},
+		webhook:  &webhookHandler{api: api},
+		tarball:  &tarballHandler{api: api},
+	}
+}
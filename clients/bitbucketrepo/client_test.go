@@ -0,0 +1,426 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const (
+	cloudRepoPath  = "/2.0/repositories/ossf-tests/scorecard"
+	serverRepoPath = "/rest/api/1.0/projects/OSSF/repos/scorecard"
+)
+
+// cloudRoutes maps the paths of the Cloud APIs for ossf-tests/scorecard to the
+// testdata files served for them.
+var cloudRoutes = map[string]string{
+	cloudRepoPath:                                   "cloud/repo.json",
+	cloudRepoPath + "/refs/branches/main":           "cloud/branch-main.json",
+	cloudRepoPath + "/refs/branches/dev":            "cloud/branch-main.json",
+	cloudRepoPath + "/branch-restrictions":          "cloud/branch-restrictions.json",
+	cloudRepoPath + "/commits/main":                 "cloud/commits.json",
+	cloudRepoPath + "/pullrequests":                 "cloud/pullrequests.json",
+	cloudRepoPath + "/commit/b2c3d4e5f6a7/statuses": "cloud/statuses.json",
+	cloudRepoPath + "/hooks":                        "cloud/hooks.json",
+	"/ossf-tests/scorecard/get/main.tar.gz":         "archive.tar.gz",
+}
+
+// serverRoutes maps the paths of the Data Center APIs for OSSF/scorecard to
+// the testdata files served for them.
+var serverRoutes = map[string]string{
+	serverRepoPath:                     "server/repo.json",
+	serverRepoPath + "/default-branch": "server/default-branch.json",
+	serverRepoPath + "/branches":       "server/branches.json",
+	"/rest/branch-permissions/2.0/projects/OSSF/repos/scorecard/restrictions": "server/restrictions.json",
+	serverRepoPath + "/settings/pull-requests":                                "server/pull-request-settings.json",
+	serverRepoPath + "/commits":                                               "server/commits.json",
+	serverRepoPath + "/pull-requests":                                         "server/pull-requests.json",
+	"/rest/build-status/1.0/commits/e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4": "server/statuses.json",
+	serverRepoPath + "/webhooks":                                              "server/webhooks.json",
+	serverRepoPath + "/archive":                                               "archive.tar.gz",
+}
+
+// newTestServer serves routes, and responds with 404 Not Found to other paths.
+func newTestServer(t *testing.T, routes map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeFile(w, r, filepath.Join("testdata", file))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// newTestClient returns a client for ossf-tests/scorecard on Cloud, or for
+// OSSF/scorecard on a Data Center instance if dataCenter is set.
+func newTestClient(t *testing.T, routes map[string]string, dataCenter bool) *Client {
+	t.Helper()
+	client, repo := newUninitializedTestClient(t, routes, dataCenter)
+	if err := client.InitRepo(repo, clients.HeadSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	})
+	return client
+}
+
+func newUninitializedTestClient(t *testing.T, routes map[string]string, dataCenter bool) (*Client, *Repo) {
+	t.Helper()
+	srv := newTestServer(t, routes)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	client := newBitbucketClient(t.Context(), srv.Client())
+	client.api.cloudAPIURL = srv.URL + "/2.0"
+	client.api.cloudWebURL = srv.URL
+	if dataCenter {
+		return client, &Repo{scheme: u.Scheme, host: u.Host, owner: "OSSF", project: "scorecard", dataCenter: true}
+	}
+	return client, &Repo{scheme: "https", host: cloudHost, owner: "ossf-tests", project: "scorecard"}
+}
+
+// withoutRoute returns routes without path, as if it responded with 404.
+func withoutRoute(routes map[string]string, path string) map[string]string {
+	ret := map[string]string{}
+	for k, v := range routes {
+		if k != path {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+func TestInitRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		createdAt      time.Time
+		name           string
+		routes         map[string]string
+		languages      []clients.Language
+		dataCenter     bool
+		archived       bool
+		wantErrCreated bool
+	}{
+		{
+			name:      "cloud",
+			routes:    cloudRoutes,
+			createdAt: time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC),
+			languages: []clients.Language{{Name: clients.Go}},
+		},
+		{
+			name:           "data center",
+			routes:         serverRoutes,
+			dataCenter:     true,
+			archived:       true,
+			languages:      []clients.Language{},
+			wantErrCreated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client := newTestClient(t, tt.routes, tt.dataCenter)
+			branch, err := client.GetDefaultBranchName()
+			if err != nil || branch != "main" {
+				t.Errorf("GetDefaultBranchName() = %q, %v, want main", branch, err)
+			}
+			archived, err := client.IsArchived()
+			if err != nil || archived != tt.archived {
+				t.Errorf("IsArchived() = %t, %v, want %t", archived, err, tt.archived)
+			}
+			createdAt, err := client.GetCreatedAt()
+			if (err != nil) != tt.wantErrCreated || !createdAt.Equal(tt.createdAt) {
+				t.Errorf("GetCreatedAt() = %v, %v, want %v", createdAt, err, tt.createdAt)
+			}
+			languages, err := client.ListProgrammingLanguages()
+			if err != nil {
+				t.Fatalf("ListProgrammingLanguages: %v", err)
+			}
+			if diff := cmp.Diff(tt.languages, languages); diff != "" {
+				t.Errorf("ListProgrammingLanguages() mismatch (-want +got):\n%s", diff)
+			}
+			if _, err := client.ListReleases(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+				t.Errorf("ListReleases() error = %v, want %v", err, clients.ErrUnsupportedFeature)
+			}
+			if runs, err := client.ListCheckRunsForRef("main"); err != nil || len(runs) != 0 {
+				t.Errorf("ListCheckRunsForRef() = %v, %v, want none", runs, err)
+			}
+		})
+	}
+}
+
+func TestInitRepo_notFound(t *testing.T) {
+	t.Parallel()
+	for _, dataCenter := range []bool{false, true} {
+		routes := withoutRoute(cloudRoutes, cloudRepoPath)
+		if dataCenter {
+			routes = withoutRoute(serverRoutes, serverRepoPath+"/default-branch")
+		}
+		client, repo := newUninitializedTestClient(t, routes, dataCenter)
+		if err := client.InitRepo(repo, clients.HeadSHA, 0); err == nil {
+			t.Errorf("InitRepo() of a missing repo succeeded, dataCenter: %t", dataCenter)
+		}
+	}
+}
+
+func TestListCommits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		routes     map[string]string
+		want       []clients.Commit
+		dataCenter bool
+	}{
+		{
+			name:   "cloud",
+			routes: cloudRoutes,
+			want: []clients.Commit{
+				{
+					SHA:           "b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1",
+					Message:       "Merged in feature (pull request #7)\n",
+					CommittedDate: time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC),
+					Committer:     clients.User{Login: "jane"},
+					AssociatedMergeRequest: clients.PullRequest{
+						Number:   7,
+						MergedAt: time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC),
+						HeadSHA:  "c3d4e5f6a7b8",
+						Author:   clients.User{Login: "john"},
+						MergedBy: clients.User{Login: "jane"},
+						Reviews: []clients.Review{
+							{Author: &clients.User{Login: "jane"}, State: "APPROVED"},
+							{Author: &clients.User{Login: "jack"}, State: "CHANGES_REQUESTED"},
+						},
+					},
+				},
+				{
+					SHA:           "c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2",
+					Message:       "Add feature\n",
+					CommittedDate: time.Date(2024, time.March, 3, 5, 6, 7, 0, time.UTC),
+					Committer:     clients.User{Login: "john"},
+					AssociatedMergeRequest: clients.PullRequest{
+						Number:   7,
+						MergedAt: time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC),
+						HeadSHA:  "c3d4e5f6a7b8",
+						Author:   clients.User{Login: "john"},
+						MergedBy: clients.User{Login: "jane"},
+						Reviews: []clients.Review{
+							{Author: &clients.User{Login: "jane"}, State: "APPROVED"},
+							{Author: &clients.User{Login: "jack"}, State: "CHANGES_REQUESTED"},
+						},
+					},
+				},
+				{
+					SHA:           "d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3",
+					Message:       "Initial commit\n",
+					CommittedDate: time.Date(2024, time.March, 2, 5, 6, 7, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name:       "data center",
+			routes:     serverRoutes,
+			dataCenter: true,
+			want: []clients.Commit{
+				{
+					SHA:           "e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4",
+					Message:       "Pull request #3: Add feature",
+					CommittedDate: time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC),
+					Committer:     clients.User{Login: "jane"},
+					AssociatedMergeRequest: clients.PullRequest{
+						Number:   3,
+						MergedAt: time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC),
+						HeadSHA:  "a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6",
+						Author:   clients.User{Login: "john"},
+						Reviews: []clients.Review{
+							{Author: &clients.User{Login: "jane"}, State: "APPROVED"},
+						},
+					},
+				},
+				{
+					SHA:           "f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5",
+					Message:       "Initial commit",
+					CommittedDate: time.Date(2024, time.March, 3, 5, 6, 7, 0, time.UTC),
+					Committer:     clients.User{Login: "John Doe"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newTestClient(t, tt.routes, tt.dataCenter).ListCommits()
+			if err != nil {
+				t.Fatalf("ListCommits: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListCommits() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListStatuses(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		ref        string
+		routes     map[string]string
+		want       []clients.Status
+		dataCenter bool
+	}{
+		{
+			name:   "cloud",
+			ref:    "b2c3d4e5f6a7",
+			routes: cloudRoutes,
+			want: []clients.Status{
+				{
+					State:     "success",
+					Context:   "build-1",
+					URL:       "https://bitbucket.org/ossf-tests/scorecard/addon/pipelines/home#!/results/42",
+					TargetURL: "https://bitbucket.org/ossf-tests/scorecard/addon/pipelines/home#!/results/42",
+				},
+				{
+					State:     "failure",
+					Context:   "lint",
+					URL:       "https://ci.example.com/builds/17",
+					TargetURL: "https://ci.example.com/builds/17",
+				},
+			},
+		},
+		{
+			name:       "data center",
+			ref:        "e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4",
+			routes:     serverRoutes,
+			dataCenter: true,
+			want: []clients.Status{
+				{
+					State:     "pending",
+					Context:   "BAM-PLAN-1",
+					URL:       "https://bamboo.example.com/browse/BAM-PLAN-1",
+					TargetURL: "https://bamboo.example.com/browse/BAM-PLAN-1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newTestClient(t, tt.routes, tt.dataCenter).ListStatuses(tt.ref)
+			if err != nil {
+				t.Fatalf("ListStatuses: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListStatuses() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		routes     map[string]string
+		want       []clients.Webhook
+		dataCenter bool
+		wantErr    bool
+	}{
+		{
+			name:   "cloud",
+			routes: cloudRoutes,
+			want: []clients.Webhook{
+				{Path: "https://hooks.example.com/signed", UsesAuthSecret: true},
+				{Path: "https://hooks.example.com/unsigned"},
+			},
+		},
+		{
+			name:       "data center",
+			routes:     serverRoutes,
+			dataCenter: true,
+			want: []clients.Webhook{
+				{ID: 10, Path: "https://hooks.example.com/signed", UsesAuthSecret: true},
+				{ID: 11, Path: "https://hooks.example.com/unsigned"},
+			},
+		},
+		{
+			name:    "failure fetching webhooks",
+			routes:  withoutRoute(cloudRoutes, cloudRepoPath+"/hooks"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newTestClient(t, tt.routes, tt.dataCenter).ListWebhooks()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListWebhooks() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListWebhooks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFiles(t *testing.T) {
+	t.Parallel()
+	for _, dataCenter := range []bool{false, true} {
+		routes := cloudRoutes
+		if dataCenter {
+			routes = serverRoutes
+		}
+		client := newTestClient(t, routes, dataCenter)
+		files, err := client.ListFiles(func(string) (bool, error) { return true, nil })
+		if err != nil {
+			t.Fatalf("ListFiles: %v", err)
+		}
+		want := []string{"README.md", "bitbucket-pipelines.yml"}
+		if diff := cmp.Diff(want, files); diff != "" {
+			t.Errorf("ListFiles() mismatch (-want +got):\n%s", diff)
+		}
+
+		r, err := client.GetFileReader("README.md")
+		if err != nil {
+			t.Fatalf("GetFileReader: %v", err)
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatalf("ReadAll: %v", err)
+		}
+		if diff := cmp.Diff("# scorecard\n", string(content)); diff != "" {
+			t.Errorf("GetFileReader() mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// pullRequestsLimit is the number of recently merged pull requests, which are
+// associated with the commits they merged.
+const pullRequestsLimit = 50
+
+type cloudUser struct {
+	Nickname string `json:"nickname"`
+}
+
+type cloudCommit struct {
+	Date   time.Time `json:"date"`
+	Author struct {
+		User *cloudUser `json:"user"`
+	} `json:"author"`
+	Hash    string `json:"hash"`
+	Message string `json:"message"`
+}
+
+// cloudPullRequest refers to commits by their abbreviated hashes.
+type cloudPullRequest struct {
+	UpdatedOn   time.Time  `json:"updated_on"`
+	ClosedBy    *cloudUser `json:"closed_by"`
+	MergeCommit *struct {
+		Hash string `json:"hash"`
+	} `json:"merge_commit"`
+	Author cloudUser `json:"author"`
+	Source struct {
+		Commit struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+	} `json:"source"`
+	Participants []struct {
+		User     cloudUser `json:"user"`
+		State    string    `json:"state"`
+		Approved bool      `json:"approved"`
+	} `json:"participants"`
+	ID int `json:"id"`
+}
+
+type serverUser struct {
+	Name string `json:"name"`
+}
+
+type serverCommit struct {
+	Author             serverUser `json:"author"`
+	ID                 string     `json:"id"`
+	Message            string     `json:"message"`
+	CommitterTimestamp int64      `json:"committerTimestamp"`
+}
+
+type serverPullRequest struct {
+	Author struct {
+		User serverUser `json:"user"`
+	} `json:"author"`
+	FromRef struct {
+		LatestCommit string `json:"latestCommit"`
+	} `json:"fromRef"`
+	Properties struct {
+		MergeCommit *struct {
+			ID string `json:"id"`
+		} `json:"mergeCommit"`
+	} `json:"properties"`
+	Reviewers []struct {
+		User   serverUser `json:"user"`
+		Status string     `json:"status"`
+	} `json:"reviewers"`
+	ID         int   `json:"id"`
+	ClosedDate int64 `json:"closedDate"`
+}
+
+// mergedPullRequest is a pull request with the hashes of the commits it
+// merged into the target branch.
+type mergedPullRequest struct {
+	hashes []string
+	pr     clients.PullRequest
+}
+
+type commitsHandler struct {
+	api         *apiClient
+	ctx         context.Context
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	commits     []clients.Commit
+	commitDepth int
+}
+
+func (handler *commitsHandler) init(ctx context.Context, repourl *Repo, commitDepth int) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.commits = nil
+	handler.commitDepth = commitDepth
+}
+
+func (handler *commitsHandler) setup() error {
+	handler.once.Do(func() {
+		ref := handler.repourl.defaultBranch
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			ref = handler.repourl.commitSHA
+		}
+		var prs []mergedPullRequest
+		if handler.repourl.dataCenter {
+			handler.commits, handler.errSetup = handler.listServerCommits(ref)
+			if handler.errSetup == nil {
+				prs, handler.errSetup = handler.listServerPullRequests()
+			}
+		} else {
+			handler.commits, handler.errSetup = handler.listCloudCommits(ref)
+			if handler.errSetup == nil {
+				prs, handler.errSetup = handler.listCloudPullRequests()
+			}
+		}
+		if handler.errSetup != nil {
+			return
+		}
+		for i := range handler.commits {
+			if pr, ok := findPullRequest(prs, handler.commits[i].SHA); ok {
+				handler.commits[i].AssociatedMergeRequest = pr
+			}
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *commitsHandler) listCloudCommits(ref string) ([]clients.Commit, error) {
+	commits, err := getCloudPages[cloudCommit](handler.ctx, handler.api,
+		repoPath(handler.repourl, "commits", ref), nil, handler.commitDepth)
+	if err != nil {
+		return nil, fmt.Errorf("request for commits failed with %w", err)
+	}
+	ret := make([]clients.Commit, 0, len(commits))
+	for i := range commits {
+		c := clients.Commit{
+			SHA:           commits[i].Hash,
+			Message:       commits[i].Message,
+			CommittedDate: commits[i].Date,
+		}
+		if u := commits[i].Author.User; u != nil {
+			c.Committer = clients.User{Login: u.Nickname}
+		}
+		ret = append(ret, c)
+	}
+	return ret, nil
+}
+
+func (handler *commitsHandler) listCloudPullRequests() ([]mergedPullRequest, error) {
+	query := url.Values{
+		"state":  {"MERGED"},
+		"sort":   {"-updated_on"},
+		"fields": {"+values.participants"},
+	}
+	prs, err := getCloudPages[cloudPullRequest](handler.ctx, handler.api,
+		repoPath(handler.repourl, "pullrequests"), query, pullRequestsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("request for pull requests failed with %w", err)
+	}
+	ret := make([]mergedPullRequest, 0, len(prs))
+	for i := range prs {
+		p := &prs[i]
+		m := mergedPullRequest{
+			hashes: []string{p.Source.Commit.Hash},
+			pr: clients.PullRequest{
+				Number:   p.ID,
+				MergedAt: p.UpdatedOn,
+				HeadSHA:  p.Source.Commit.Hash,
+				Author:   clients.User{Login: p.Author.Nickname},
+			},
+		}
+		if p.MergeCommit != nil {
+			m.hashes = append(m.hashes, p.MergeCommit.Hash)
+		}
+		if p.ClosedBy != nil {
+			m.pr.MergedBy = clients.User{Login: p.ClosedBy.Nickname}
+		}
+		for _, participant := range p.Participants {
+			var state string
+			switch {
+			case participant.Approved:
+				state = "APPROVED"
+			case participant.State == "changes_requested":
+				state = "CHANGES_REQUESTED"
+			default:
+				continue
+			}
+			m.pr.Reviews = append(m.pr.Reviews, clients.Review{
+				Author: &clients.User{Login: participant.User.Nickname},
+				State:  state,
+			})
+		}
+		ret = append(ret, m)
+	}
+	return ret, nil
+}
+
+func (handler *commitsHandler) listServerCommits(ref string) ([]clients.Commit, error) {
+	commits, err := getServerPages[serverCommit](handler.ctx, handler.api,
+		repoPath(handler.repourl, "commits"), url.Values{"until": {ref}}, handler.commitDepth)
+	if err != nil {
+		return nil, fmt.Errorf("request for commits failed with %w", err)
+	}
+	ret := make([]clients.Commit, 0, len(commits))
+	for i := range commits {
+		ret = append(ret, clients.Commit{
+			SHA:           commits[i].ID,
+			Message:       commits[i].Message,
+			CommittedDate: time.UnixMilli(commits[i].CommitterTimestamp).UTC(),
+			Committer:     clients.User{Login: commits[i].Author.Name},
+		})
+	}
+	return ret, nil
+}
+
+func (handler *commitsHandler) listServerPullRequests() ([]mergedPullRequest, error) {
+	query := url.Values{"state": {"MERGED"}, "order": {"NEWEST"}}
+	prs, err := getServerPages[serverPullRequest](handler.ctx, handler.api,
+		repoPath(handler.repourl, "pull-requests"), query, pullRequestsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("request for pull requests failed with %w", err)
+	}
+	ret := make([]mergedPullRequest, 0, len(prs))
+	for i := range prs {
+		p := &prs[i]
+		m := mergedPullRequest{
+			hashes: []string{p.FromRef.LatestCommit},
+			pr: clients.PullRequest{
+				Number:   p.ID,
+				MergedAt: time.UnixMilli(p.ClosedDate).UTC(),
+				HeadSHA:  p.FromRef.LatestCommit,
+				Author:   clients.User{Login: p.Author.User.Name},
+			},
+		}
+		if p.Properties.MergeCommit != nil {
+			m.hashes = append(m.hashes, p.Properties.MergeCommit.ID)
+		}
+		for _, r := range p.Reviewers {
+			var state string
+			switch r.Status {
+			case "APPROVED":
+				state = "APPROVED"
+			case "NEEDS_WORK":
+				state = "CHANGES_REQUESTED"
+			default:
+				continue
+			}
+			m.pr.Reviews = append(m.pr.Reviews, clients.Review{
+				Author: &clients.User{Login: r.User.Name},
+				State:  state,
+			})
+		}
+		ret = append(ret, m)
+	}
+	return ret, nil
+}
+
+// findPullRequest returns the pull request which merged a commit, either as
+// its merge commit or by fast-forwarding to it.
+func findPullRequest(prs []mergedPullRequest, sha string) (clients.PullRequest, bool) {
+	for i := range prs {
+		for _, h := range prs[i].hashes {
+			if h != "" && strings.HasPrefix(sha, h) {
+				return prs[i].pr, true
+			}
+		}
+	}
+	return clients.PullRequest{}, false
+}
+
+func (handler *commitsHandler) listCommits() ([]clients.Commit, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during commitsHandler.setup: %w", err)
+	}
+	return handler.commits, nil
+}
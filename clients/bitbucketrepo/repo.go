@@ -0,0 +1,206 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// cloudHost is the host of Bitbucket Cloud, other hosts run Data Center.
+const cloudHost = "bitbucket.org"
+
+var errInvalidBitbucketRepoURL = errors.New("repo is not a bitbucket repo")
+
+// Repo is a repository of a Bitbucket Cloud workspace, or of a Bitbucket
+// Data Center project. Personal Data Center repositories belong to the
+// project "~<user>".
+type Repo struct {
+	scheme        string
+	host          string
+	basePath      string
+	owner         string
+	project       string
+	defaultBranch string
+	commitSHA     string
+	metadata      []string
+	dataCenter    bool
+}
+
+// HasBitbucketHost reports whether input is a Bitbucket Cloud URL, a URL of
+// the Data Center instance configured with BITBUCKET_HOST, or a URL with the
+// path of a Data Center repository.
+func HasBitbucketHost(input string) bool {
+	u, err := url.Parse(withDefaultScheme(input))
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, cloudHost) || isDataCenterHost(u.Host) {
+		return true
+	}
+	_, _, _, ok := parseDataCenterPath(u.Path)
+	return ok
+}
+
+func isDataCenterHost(host string) bool {
+	h := os.Getenv("BITBUCKET_HOST")
+	if h == "" {
+		return false
+	}
+	u, err := url.Parse(withDefaultScheme(h))
+	return err == nil && strings.EqualFold(u.Host, host)
+}
+
+// parseDataCenterPath splits the path of a Data Center repository into the
+// context path of the instance, the project key and the repository slug.
+// Accepted paths are:
+//   - <basePath>/projects/<key>/repos/<slug>[/browse...]
+//   - <basePath>/users/<user>/repos/<slug>[/browse...]
+//   - <basePath>/scm/<key>/<slug>.git
+func parseDataCenterPath(p string) (basePath, project, slug string, ok bool) {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	for i, s := range segments {
+		basePath = strings.Join(segments[:i], "/")
+		if basePath != "" {
+			basePath = "/" + basePath
+		}
+		rest := segments[i+1:]
+		switch {
+		case (s == "projects" || s == "users") && len(rest) >= 3 && rest[1] == "repos":
+			project = rest[0]
+			if s == "users" {
+				project = "~" + project
+			}
+			return basePath, project, rest[2], true
+		case s == "scm" && len(rest) == 2:
+			return basePath, rest[0], strings.TrimSuffix(rest[1], ".git"), true
+		}
+	}
+	return "", "", "", false
+}
+
+// Parses input string into repoURL struct.
+func (r *Repo) parse(input string) error {
+	u, err := url.Parse(withDefaultScheme(input))
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("url.Parse: %v", err))
+	}
+	r.scheme, r.host = u.Scheme, u.Host
+
+	if strings.EqualFold(u.Host, cloudHost) {
+		// https://bitbucket.org/<workspace>/<repo>[/src/...]
+		const minSegments = 2
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(segments) < minSegments || segments[0] == "" {
+			return sce.WithMessage(sce.ErrInvalidURL, fmt.Sprintf("%v. Expected full repository url", input))
+		}
+		r.owner, r.project = segments[0], strings.TrimSuffix(segments[1], ".git")
+		return nil
+	}
+
+	r.dataCenter = true
+	if basePath, project, slug, ok := parseDataCenterPath(u.Path); ok {
+		r.basePath, r.owner, r.project = basePath, project, slug
+		return nil
+	}
+	// <BITBUCKET_HOST>/<key>/<slug>
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	const splitLen = 2
+	if isDataCenterHost(u.Host) && len(segments) == splitLen {
+		r.owner, r.project = segments[0], strings.TrimSuffix(segments[1], ".git")
+		return nil
+	}
+	return sce.WithMessage(sce.ErrInvalidURL,
+		fmt.Sprintf("%v. Expected a url like https://<host>/projects/<key>/repos/<slug>", input))
+}
+
+// Allow skipping scheme for ease-of-use, default to https.
+func withDefaultScheme(uri string) string {
+	if strings.Contains(uri, "://") {
+		return uri
+	}
+	return "https://" + uri
+}
+
+// URI implements Repo.URI().
+func (r *Repo) URI() string {
+	if !r.dataCenter {
+		return fmt.Sprintf("%s/%s/%s", r.host, r.owner, r.project)
+	}
+	if user, ok := strings.CutPrefix(r.owner, "~"); ok {
+		return fmt.Sprintf("%s%s/users/%s/repos/%s", r.host, r.basePath, user, r.project)
+	}
+	return fmt.Sprintf("%s%s/projects/%s/repos/%s", r.host, r.basePath, r.owner, r.project)
+}
+
+func (r *Repo) Host() string {
+	return r.host
+}
+
+// String implements Repo.String.
+func (r *Repo) String() string {
+	return fmt.Sprintf("%s-%s_%s", r.host, r.owner, r.project)
+}
+
+// IsValid implements Repo.IsValid.
+func (r *Repo) IsValid() error {
+	if strings.TrimSpace(r.owner) == "" || strings.TrimSpace(r.project) == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected full repository url: "+r.URI())
+	}
+	for _, h := range []string{"github.com", "gitlab.com", "dev.azure.com"} {
+		if strings.EqualFold(r.host, h) {
+			return fmt.Errorf("%w: %s", errInvalidBitbucketRepoURL, r.host)
+		}
+	}
+	return nil
+}
+
+func (r *Repo) AppendMetadata(metadata ...string) {
+	r.metadata = append(r.metadata, metadata...)
+}
+
+// Metadata implements Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return r.metadata
+}
+
+// Type implements Repo.Type.
+func (r *Repo) Type() clients.RepoType {
+	return clients.RepoTypeBitbucket
+}
+
+// Path() implements RepoClient.Path.
+func (r *Repo) Path() string {
+	return fmt.Sprintf("%s/%s", r.owner, r.project)
+}
+
+// MakeBitbucketRepo takes input of forms in parse and returns and implementation
+// of clients.Repo interface.
+func MakeBitbucketRepo(input string) (clients.Repo, error) {
+	var repo Repo
+	if err := repo.parse(input); err != nil {
+		return nil, fmt.Errorf("error during parse: %w", err)
+	}
+	if err := repo.IsValid(); err != nil {
+		return nil, fmt.Errorf("error in IsValid: %w", err)
+	}
+	return &repo, nil
+}
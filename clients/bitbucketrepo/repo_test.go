@@ -0,0 +1,137 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepo_parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		input    string
+		expected Repo
+		wantErr  bool
+	}{
+		{
+			name:     "cloud without scheme",
+			input:    "bitbucket.org/atlassian/python-bitbucket",
+			expected: Repo{scheme: "https", host: "bitbucket.org", owner: "atlassian", project: "python-bitbucket"},
+		},
+		{
+			name:     "cloud source view",
+			input:    "https://bitbucket.org/atlassian/python-bitbucket/src/master/",
+			expected: Repo{scheme: "https", host: "bitbucket.org", owner: "atlassian", project: "python-bitbucket"},
+		},
+		{
+			name:    "cloud workspace",
+			input:   "https://bitbucket.org/atlassian",
+			wantErr: true,
+		},
+		{
+			name:  "data center project repository",
+			input: "https://git.example.com/bitbucket/projects/OSSF/repos/scorecard/browse",
+			expected: Repo{
+				scheme: "https", host: "git.example.com", basePath: "/bitbucket",
+				owner: "OSSF", project: "scorecard", dataCenter: true,
+			},
+		},
+		{
+			name:  "data center personal repository",
+			input: "git.example.com/users/jane/repos/dotfiles",
+			expected: Repo{
+				scheme: "https", host: "git.example.com", owner: "~jane", project: "dotfiles", dataCenter: true,
+			},
+		},
+		{
+			name:  "data center clone url",
+			input: "http://git.example.com:7990/scm/ossf/scorecard.git",
+			expected: Repo{
+				scheme: "http", host: "git.example.com:7990", owner: "ossf", project: "scorecard", dataCenter: true,
+			},
+		},
+		{
+			name:    "data center without repository path",
+			input:   "https://git.example.com/OSSF/scorecard",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var r Repo
+			err := r.parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parse() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.expected, r, cmp.AllowUnexported(Repo{})); diff != "" {
+				t.Errorf("parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRepo_URI(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{
+		"bitbucket.org/atlassian/python-bitbucket",
+		"git.example.com/bitbucket/projects/OSSF/repos/scorecard",
+		"git.example.com/users/jane/repos/dotfiles",
+	} {
+		repo, err := MakeBitbucketRepo(input)
+		if err != nil {
+			t.Fatalf("MakeBitbucketRepo(%q): %v", input, err)
+		}
+		if got := repo.URI(); got != input {
+			t.Errorf("URI() = %q, want %q", got, input)
+		}
+	}
+}
+
+//nolint:paralleltest // Since t.Setenv is used.
+func TestHasBitbucketHost(t *testing.T) {
+	t.Setenv("BITBUCKET_HOST", "https://git.example.com")
+	tests := map[string]bool{
+		"bitbucket.org/atlassian/python-bitbucket":                true,
+		"git.example.com/OSSF/scorecard":                          true,
+		"https://stash.example.org/projects/OSSF/repos/scorecard": true,
+		"https://stash.example.org/scm/ossf/scorecard.git":        true,
+		"https://gitlab.example.com/owner/repo":                   false,
+		"https://github.com/ossf/scorecard":                       false,
+	}
+	for input, want := range tests {
+		if got := HasBitbucketHost(input); got != want {
+			t.Errorf("HasBitbucketHost(%q) = %t, want %t", input, got, want)
+		}
+	}
+}
+
+//nolint:paralleltest // Since t.Setenv is used.
+func TestMakeBitbucketRepo_configuredHost(t *testing.T) {
+	t.Setenv("BITBUCKET_HOST", "git.example.com")
+	repo, err := MakeBitbucketRepo("git.example.com/OSSF/scorecard")
+	if err != nil {
+		t.Fatalf("MakeBitbucketRepo: %v", err)
+	}
+	if got, want := repo.URI(), "git.example.com/projects/OSSF/repos/scorecard"; got != want {
+		t.Errorf("URI() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// buildStatus is a build status of a commit, reported by Bitbucket Pipelines
+// or an external CI system. The fields are named alike in both APIs.
+type buildStatus struct {
+	State string `json:"state"`
+	Key   string `json:"key"`
+	URL   string `json:"url"`
+}
+
+type statusesHandler struct {
+	api     *apiClient
+	ctx     context.Context
+	repourl *Repo
+}
+
+func (handler *statusesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+}
+
+func (handler *statusesHandler) listStatuses(ref string) ([]clients.Status, error) {
+	var statuses []buildStatus
+	var err error
+	if handler.repourl.dataCenter {
+		statuses, err = getServerPages[buildStatus](handler.ctx, handler.api,
+			"/build-status/1.0/commits/"+url.PathEscape(ref), nil, 0)
+	} else {
+		statuses, err = getCloudPages[buildStatus](handler.ctx, handler.api,
+			repoPath(handler.repourl, "commit", ref, "statuses"), nil, 0)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("request for statuses of %s failed with %w", ref, err)
+	}
+	ret := make([]clients.Status, 0, len(statuses))
+	for _, s := range statuses {
+		ret = append(ret, clients.Status{
+			State:     statusState(s.State),
+			Context:   s.Key,
+			URL:       s.URL,
+			TargetURL: s.URL,
+		})
+	}
+	return ret, nil
+}
+
+// statusState maps build states to the states of GitHub commit statuses.
+func statusState(state string) string {
+	switch state {
+	case "SUCCESSFUL":
+		return "success"
+	case "FAILED":
+		return "failure"
+	case "INPROGRESS":
+		return "pending"
+	case "STOPPED", "CANCELLED":
+		return "error"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitbucketrepo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type cloudHook struct {
+	URL       string `json:"url"`
+	SecretSet bool   `json:"secret_set"`
+}
+
+type serverHook struct {
+	Configuration map[string]string `json:"configuration"`
+	URL           string            `json:"url"`
+	ID            int64             `json:"id"`
+}
+
+type webhookHandler struct {
+	api      *apiClient
+	ctx      context.Context
+	once     *sync.Once
+	errSetup error
+	repourl  *Repo
+	webhooks []clients.Webhook
+}
+
+func (handler *webhookHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.webhooks = nil
+}
+
+func (handler *webhookHandler) setup() error {
+	handler.once.Do(func() {
+		if handler.repourl.dataCenter {
+			hooks, err := getServerPages[serverHook](handler.ctx, handler.api,
+				repoPath(handler.repourl, "webhooks"), nil, 0)
+			if err != nil {
+				handler.errSetup = fmt.Errorf("request for webhooks failed with %w", err)
+				return
+			}
+			for _, h := range hooks {
+				handler.webhooks = append(handler.webhooks, clients.Webhook{
+					ID:             h.ID,
+					Path:           h.URL,
+					UsesAuthSecret: h.Configuration["secret"] != "",
+				})
+			}
+			return
+		}
+
+		hooks, err := getCloudPages[cloudHook](handler.ctx, handler.api,
+			repoPath(handler.repourl, "hooks"), nil, 0)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for webhooks failed with %w", err)
+			return
+		}
+		// Cloud identifies webhooks by UUID, which leaves the ID unset.
+		for _, h := range hooks {
+			handler.webhooks = append(handler.webhooks, clients.Webhook{
+				Path:           h.URL,
+				UsesAuthSecret: h.SecretSet,
+			})
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during webhookHandler.setup: %w", err)
+	}
+	return handler.webhooks, nil
+}
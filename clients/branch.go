@@ -19,6 +19,78 @@ type BranchRef struct {
 	Name                 *string
 	Protected            *bool
 	BranchProtectionRule BranchProtectionRule
+	// RuleSets are the rulesets which apply to the branch, including those
+	// which are only evaluated. Only GitHub reports them, and their active
+	// rules are also merged into BranchProtectionRule.
+	RuleSets []RuleSet
+}
+
+// RuleSetEnforcement is the enforcement status of a ruleset.
+type RuleSetEnforcement string
+
+const (
+	// RuleSetEnforcementActive rulesets are enforced.
+	RuleSetEnforcementActive RuleSetEnforcement = "active"
+	// RuleSetEnforcementEvaluate rulesets only report what they would block.
+	RuleSetEnforcementEvaluate RuleSetEnforcement = "evaluate"
+)
+
+// RuleSetSource is the kind of owner which defines a ruleset.
+type RuleSetSource string
+
+const (
+	RuleSetSourceRepository   RuleSetSource = "repository"
+	RuleSetSourceOrganization RuleSetSource = "organization"
+	RuleSetSourceEnterprise   RuleSetSource = "enterprise"
+)
+
+// BypassActorType is the kind of actor which can bypass a ruleset.
+type BypassActorType string
+
+const (
+	BypassActorOrganizationAdmin BypassActorType = "organization_admin"
+	BypassActorEnterpriseOwner   BypassActorType = "enterprise_owner"
+	BypassActorRepositoryRole    BypassActorType = "repository_role"
+	BypassActorTeam              BypassActorType = "team"
+	BypassActorIntegration       BypassActorType = "integration"
+	BypassActorDeployKey         BypassActorType = "deploy_key"
+)
+
+// RuleSet captures the settings of a repository, organization or enterprise
+// ruleset which don't map to a BranchProtectionRule.
+type RuleSet struct {
+	Name        string
+	Enforcement RuleSetEnforcement
+	Source      RuleSetSource
+	// SourceName is the repository, organization or enterprise defining the ruleset.
+	SourceName          string
+	BypassActors        []BypassActor
+	RequiredWorkflows   []RequiredWorkflow
+	RequiredDeployments []string
+	CodeScanningTools   []CodeScanningTool
+}
+
+// BypassActor is an actor which can bypass the rules of a ruleset.
+type BypassActor struct {
+	Type BypassActorType
+	// Name is the role, team or app name, empty for other types.
+	Name string
+	// PullRequestOnly actors can only bypass the rules by merging pull requests.
+	PullRequestOnly bool
+}
+
+// RequiredWorkflow is a workflow which must pass before merging.
+type RequiredWorkflow struct {
+	Path         string
+	Ref          string
+	RepositoryID int64
+}
+
+// CodeScanningTool is a code scanning tool whose alerts block merging.
+type CodeScanningTool struct {
+	Tool                    string
+	AlertsThreshold         string
+	SecurityAlertsThreshold string
 }
 
 // BranchProtectionRule captures the settings enabled on a branch for security.
@@ -26,3 +26,6 @@ type CheckRun struct {
 type CheckRunApp struct {
 	Slug string
 }
+
+// GitLabCISlug is the app of the check runs for GitLab pipelines.
+const GitLabCISlug = "gitlab-ci"
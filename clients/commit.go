@@ -18,7 +18,10 @@ import "time"
 
 // Commit represents a Git commit.
 type Commit struct {
-	CommittedDate          time.Time
+	CommittedDate time.Time
+	// Signature is nil for unsigned commits, and for repository hosts which
+	// don't report commit signatures.
+	Signature              *Signature
 	Message                string
 	SHA                    string
 	AssociatedMergeRequest PullRequest
@@ -65,6 +65,10 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 	c.commits = nil
 
 	// init
+	c.repo = repo
+	if commitDepth <= 0 {
+		commitDepth = 30 // default
+	}
 	c.commitDepth = commitDepth
 	tempDir, err := os.MkdirTemp("", repoDir)
 	if err != nil {
@@ -82,15 +86,15 @@ func (c *Client) InitRepo(repo clients.Repo, commitSHA string, commitDepth int)
 			return fmt.Errorf("git.PlainOpen: %w", err)
 		}
 	} else {
-		if !strings.HasPrefix(uri, "https://") && !strings.HasPrefix(uri, "ssh://") {
+		if !strings.Contains(uri, "://") {
 			uri = "https://" + uri
 		}
 		if !strings.HasSuffix(uri, ".git") {
 			uri += ".git"
 		}
+		// no progress is reported, stdout is reserved for results.
 		c.gitRepo, err = git.PlainClone(tempDir, false /*isBare*/, &git.CloneOptions{
-			URL:      uri,
-			Progress: os.Stdout,
+			URL: uri,
 		})
 	}
 	if err != nil {
@@ -142,6 +146,10 @@ func (c *Client) ListCommits() ([]clients.Commit, error) {
 				return
 			}
 
+			var signature *clients.Signature
+			if commit.PGPSignature != "" {
+				signature = clients.ParseSignature(commit.PGPSignature)
+			}
 			c.commits = append(c.commits, clients.Commit{
 				SHA:           commit.Hash.String(),
 				Message:       commit.Message,
@@ -149,6 +157,7 @@ func (c *Client) ListCommits() ([]clients.Commit, error) {
 				Committer: clients.User{
 					Login: commit.Committer.Email,
 				},
+				Signature: signature,
 			})
 		}
 	})
@@ -255,6 +264,10 @@ func (c *Client) IsArchived() (bool, error) {
 	return false, clients.ErrUnsupportedFeature
 }
 
+func (c *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) URI() string {
 	return c.repo.URI()
 }
@@ -0,0 +1,134 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// gitPrefix forces a URI to be scanned as a plain git repo, e.g.
+// git+https://gitea.example.com/owner/repo.
+const gitPrefix = "git+"
+
+var (
+	errUnsupportedScheme = errors.New("unsupported scheme")
+	errForgeHost         = errors.New("host has a dedicated repo type")
+
+	// forgeHosts are scanned through their APIs, which support more checks.
+	forgeHosts = []string{"github.com", "gitlab.com", "dev.azure.com"}
+)
+
+// Repo is a repository on any host serving git over https, ssh or the
+// file system, like Gitea, Forgejo, Bitbucket Server or cgit mirrors.
+type Repo struct {
+	url      *url.URL
+	metadata []string
+}
+
+// HasGitScheme returns whether uri can only be a plain git repo: it is
+// prefixed with git+, or uses the ssh or file scheme.
+func HasGitScheme(uri string) bool {
+	return strings.HasPrefix(uri, gitPrefix) ||
+		strings.HasPrefix(uri, "ssh://") ||
+		strings.HasPrefix(uri, "file://")
+}
+
+// MakeGitRepo returns an implementation of clients.Repo interface for a git
+// remote URL. URLs without a scheme default to https.
+func MakeGitRepo(uri string) (clients.Repo, error) {
+	explicit := strings.HasPrefix(uri, gitPrefix)
+	uri = strings.TrimPrefix(uri, gitPrefix)
+	if !strings.Contains(uri, "://") {
+		uri = "https://" + uri
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrInvalidURL, fmt.Sprintf("url.Parse: %v", err))
+	}
+	switch u.Scheme {
+	case "https", "http", "ssh", "file":
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedScheme, u.Scheme)
+	}
+	if !explicit {
+		for _, h := range forgeHosts {
+			if strings.EqualFold(u.Hostname(), h) {
+				return nil, fmt.Errorf("%w: %s", errForgeHost, h)
+			}
+		}
+	}
+	u.RawQuery, u.Fragment = "", ""
+	repo := &Repo{url: u}
+	if err := repo.IsValid(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// URI implements Repo.URI. https URLs omit their scheme, like the URIs of
+// other repo types.
+func (r *Repo) URI() string {
+	if r.url.Scheme == "https" {
+		return r.url.Host + r.url.Path
+	}
+	return r.url.String()
+}
+
+// Host implements Repo.Host.
+func (r *Repo) Host() string {
+	return r.url.Host
+}
+
+// Path implements Repo.Path.
+func (r *Repo) Path() string {
+	return strings.TrimSuffix(strings.Trim(r.url.Path, "/"), ".git")
+}
+
+// String implements Repo.String.
+func (r *Repo) String() string {
+	return r.URI()
+}
+
+// IsValid implements Repo.IsValid.
+func (r *Repo) IsValid() error {
+	if r.url.Scheme != "file" && r.url.Host == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected a host: "+r.url.String())
+	}
+	if r.Path() == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected a repository path: "+r.url.String())
+	}
+	return nil
+}
+
+// Metadata implements Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return r.metadata
+}
+
+// AppendMetadata implements Repo.AppendMetadata.
+func (r *Repo) AppendMetadata(metadata ...string) {
+	r.metadata = append(r.metadata, metadata...)
+}
+
+// Type implements Repo.Type.
+func (r *Repo) Type() clients.RepoType {
+	return clients.RepoTypeGit
+}
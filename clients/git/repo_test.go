@@ -0,0 +1,107 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func TestMakeGitRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		input    string
+		wantURI  string
+		wantHost string
+		wantPath string
+		wantErr  bool
+	}{
+		{
+			name:     "https without scheme",
+			input:    "gitea.example.com/owner/repo",
+			wantURI:  "gitea.example.com/owner/repo",
+			wantHost: "gitea.example.com",
+			wantPath: "owner/repo",
+		},
+		{
+			name:     "https with .git suffix",
+			input:    "https://git.example.com/scm/project/repo.git",
+			wantURI:  "git.example.com/scm/project/repo.git",
+			wantHost: "git.example.com",
+			wantPath: "scm/project/repo",
+		},
+		{
+			name:     "ssh",
+			input:    "ssh://git@forgejo.example.com:2222/owner/repo.git",
+			wantURI:  "ssh://git@forgejo.example.com:2222/owner/repo.git",
+			wantHost: "forgejo.example.com:2222",
+			wantPath: "owner/repo",
+		},
+		{
+			name:     "file",
+			input:    "file:///srv/git/repo",
+			wantURI:  "file:///srv/git/repo",
+			wantPath: "srv/git/repo",
+		},
+		{
+			name:     "forced github.com",
+			input:    "git+https://github.com/ossf/scorecard",
+			wantURI:  "github.com/ossf/scorecard",
+			wantHost: "github.com",
+			wantPath: "ossf/scorecard",
+		},
+		{
+			name:    "github.com",
+			input:   "https://github.com/ossf/scorecard",
+			wantErr: true,
+		},
+		{
+			name:    "no path",
+			input:   "https://gitea.example.com/",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			input:   "ftp://example.com/repo",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo, err := MakeGitRepo(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MakeGitRepo(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := repo.URI(); got != tt.wantURI {
+				t.Errorf("URI() = %q, want %q", got, tt.wantURI)
+			}
+			if got := repo.Host(); got != tt.wantHost {
+				t.Errorf("Host() = %q, want %q", got, tt.wantHost)
+			}
+			if got := repo.Path(); got != tt.wantPath {
+				t.Errorf("Path() = %q, want %q", got, tt.wantPath)
+			}
+			if got := repo.Type(); got != clients.RepoTypeGit {
+				t.Errorf("Type() = %q, want %q", got, clients.RepoTypeGit)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// pageLimit is the default maximum page size of Gitea instances.
+const pageLimit = 50
+
+var errUnexpectedStatus = errors.New("unexpected status code")
+
+// statusError is returned for responses other than 200 OK.
+type statusError struct {
+	path string
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("GET %s: %d %s", e.path, e.code, http.StatusText(e.code))
+}
+
+func (e *statusError) Unwrap() error {
+	return errUnexpectedStatus
+}
+
+// hasStatus reports whether err is a response with one of the status codes.
+func hasStatus(err error, codes ...int) bool {
+	var se *statusError
+	if !errors.As(err, &se) {
+		return false
+	}
+	for _, code := range codes {
+		if se.code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// apiClient calls the REST API of a Gitea or Forgejo instance:
+// https://docs.gitea.com/api/1.22/
+type apiClient struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+func newAPIClient(httpClient *http.Client, scheme, host, token string) *apiClient {
+	return &apiClient{
+		httpClient: httpClient,
+		baseURL:    apiBaseURL(scheme, host),
+		token:      token,
+	}
+}
+
+func apiBaseURL(scheme, host string) string {
+	if scheme == "" {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/api/v1", scheme, host)
+}
+
+// do sends a GET request for path, relative to /api/v1.
+func (c *apiClient) do(ctx context.Context, path string, query url.Values) (*http.Response, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("httpClient.Do: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &statusError{path: path, code: resp.StatusCode}
+	}
+	return resp, nil
+}
+
+// get decodes the JSON response for path into v.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values, v any) error {
+	resp, err := c.do(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return nil
+}
+
+// download copies the response for path to w.
+func (c *apiClient) download(ctx context.Context, path string, w io.Writer) error {
+	resp, err := c.do(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("io.Copy: %w", err)
+	}
+	return nil
+}
+
+// getAll follows the pages of a list endpoint until it returns fewer than
+// pageLimit items, or max items were read. A max of 0 reads every page.
+func getAll[T any](ctx context.Context, c *apiClient, path string, query url.Values, maxItems int) ([]T, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	limit := pageLimit
+	if maxItems > 0 && maxItems < limit {
+		limit = maxItems
+	}
+	q.Set("limit", strconv.Itoa(limit))
+
+	var all []T
+	for page := 1; ; page++ {
+		q.Set("page", strconv.Itoa(page))
+		var items []T
+		if err := c.get(ctx, path, q, &items); err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < limit {
+			return all, nil
+		}
+		if maxItems > 0 && len(all) >= maxItems {
+			return all[:maxItems], nil
+		}
+	}
+}
+
+// repoPath returns the API path of a repository resource.
+func repoPath(repourl *Repo, elem ...string) string {
+	p := "/repos/" + url.PathEscape(repourl.owner) + "/" + url.PathEscape(repourl.project)
+	for _, e := range elem {
+		p += "/" + url.PathEscape(e)
+	}
+	return p
+}
+
+type user struct {
+	Login string `json:"login"`
+	ID    int64  `json:"id"`
+}
+
+type repository struct {
+	CreatedAt     time.Time `json:"created_at"`
+	Owner         *user     `json:"owner"`
+	DefaultBranch string    `json:"default_branch"`
+	FullName      string    `json:"full_name"`
+	Archived      bool      `json:"archived"`
+	Private       bool      `json:"private"`
+}
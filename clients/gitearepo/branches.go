@@ -0,0 +1,139 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type branch struct {
+	Name                          string   `json:"name"`
+	EffectiveBranchProtectionName string   `json:"effective_branch_protection_name"`
+	StatusCheckContexts           []string `json:"status_check_contexts"`
+	RequiredApprovals             int32    `json:"required_approvals"`
+	Protected                     bool     `json:"protected"`
+	EnableStatusCheck             bool     `json:"enable_status_check"`
+}
+
+type branchProtection struct {
+	// EnableForcePush and BlockAdminMergeOverride are missing on older
+	// instances, which never allow force pushes and always let admins merge.
+	EnableForcePush         *bool    `json:"enable_force_push"`
+	BlockAdminMergeOverride *bool    `json:"block_admin_merge_override"`
+	StatusCheckContexts     []string `json:"status_check_contexts"`
+	RequiredApprovals       int32    `json:"required_approvals"`
+	EnablePush              bool     `json:"enable_push"`
+	EnableStatusCheck       bool     `json:"enable_status_check"`
+	DismissStaleApprovals   bool     `json:"dismiss_stale_approvals"`
+	BlockOnOutdatedBranch   bool     `json:"block_on_outdated_branch"`
+}
+
+type branchesHandler struct {
+	api           *apiClient
+	ctx           context.Context
+	once          *sync.Once
+	errSetup      error
+	repourl       *Repo
+	defaultBranch *clients.BranchRef
+}
+
+func (handler *branchesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+}
+
+func (handler *branchesHandler) setup() error {
+	handler.once.Do(func() {
+		handler.defaultBranch, handler.errSetup = handler.getBranch(handler.repourl.defaultBranch)
+	})
+	return handler.errSetup
+}
+
+func (handler *branchesHandler) getDefaultBranch() (*clients.BranchRef, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during branchesHandler.setup: %w", err)
+	}
+	return handler.defaultBranch, nil
+}
+
+// getBranch returns the protection of a branch. Every user can read a summary
+// of the protection rule, the full rule requires admin access to the repo.
+func (handler *branchesHandler) getBranch(name string) (*clients.BranchRef, error) {
+	var b branch
+	if err := handler.api.get(handler.ctx, repoPath(handler.repourl, "branches", name), nil, &b); err != nil {
+		return nil, fmt.Errorf("request for branch %s failed with error: %w", name, err)
+	}
+	ref := &clients.BranchRef{
+		Name:      &b.Name,
+		Protected: &b.Protected,
+	}
+	if !b.Protected {
+		return ref, nil
+	}
+
+	var p branchProtection
+	err := handler.api.get(handler.ctx,
+		repoPath(handler.repourl, "branch_protections", b.EffectiveBranchProtectionName), nil, &p)
+	switch {
+	case err == nil:
+		ref.BranchProtectionRule = makeBranchProtectionRule(&p)
+	case hasStatus(err, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound):
+		ref.BranchProtectionRule = clients.BranchProtectionRule{
+			PullRequestRule: clients.PullRequestRule{
+				RequiredApprovingReviewCount: &b.RequiredApprovals,
+			},
+			CheckRules: clients.StatusChecksRule{
+				RequiresStatusChecks: &b.EnableStatusCheck,
+				Contexts:             b.StatusCheckContexts,
+			},
+		}
+	default:
+		return nil, fmt.Errorf("request for branch protection of %s failed with error: %w", name, err)
+	}
+	return ref, nil
+}
+
+func makeBranchProtectionRule(p *branchProtection) clients.BranchProtectionRule {
+	// protected branches can't be deleted, and are only changed through pull
+	// requests when pushing is disabled.
+	allowDeletions := false
+	requirePullRequests := !p.EnablePush
+	requireCodeOwnerReviews := false
+	allowForcePushes := p.EnableForcePush != nil && *p.EnableForcePush
+	enforceAdmins := p.BlockAdminMergeOverride != nil && *p.BlockAdminMergeOverride
+	return clients.BranchProtectionRule{
+		AllowDeletions:   &allowDeletions,
+		AllowForcePushes: &allowForcePushes,
+		EnforceAdmins:    &enforceAdmins,
+		PullRequestRule: clients.PullRequestRule{
+			Required:                     &requirePullRequests,
+			RequiredApprovingReviewCount: &p.RequiredApprovals,
+			DismissStaleReviews:          &p.DismissStaleApprovals,
+			RequireCodeOwnerReviews:      &requireCodeOwnerReviews,
+		},
+		CheckRules: clients.StatusChecksRule{
+			UpToDateBeforeMerge:  &p.BlockOnOutdatedBranch,
+			RequiresStatusChecks: &p.EnableStatusCheck,
+			Contexts:             p.StatusCheckContexts,
+		},
+	}
+}
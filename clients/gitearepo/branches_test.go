@@ -0,0 +1,111 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func TestGetBranch(t *testing.T) {
+	t.Parallel()
+	main, dev := "main", "dev"
+	protected, unprotected := true, false
+	allowDeletions, allowForcePushes, enforceAdmins := false, false, true
+	required, codeOwners, dismissStale, upToDate := true, false, true, true
+	var oneApproval, twoApprovals int32 = 1, 2
+
+	tests := []struct {
+		name   string
+		branch string
+		routes map[string]string
+		want   *clients.BranchRef
+	}{
+		{
+			name:   "protection rule readable by admins",
+			branch: "main",
+			routes: testRoutes,
+			want: &clients.BranchRef{
+				Name:      &main,
+				Protected: &protected,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					AllowDeletions:   &allowDeletions,
+					AllowForcePushes: &allowForcePushes,
+					EnforceAdmins:    &enforceAdmins,
+					PullRequestRule: clients.PullRequestRule{
+						Required:                     &required,
+						RequiredApprovingReviewCount: &twoApprovals,
+						DismissStaleReviews:          &dismissStale,
+						RequireCodeOwnerReviews:      &codeOwners,
+					},
+					CheckRules: clients.StatusChecksRule{
+						UpToDateBeforeMerge:  &upToDate,
+						RequiresStatusChecks: &protected,
+						Contexts:             []string{"ci", "lint"},
+					},
+				},
+			},
+		},
+		{
+			name:   "summary of the protection rule",
+			branch: "main",
+			routes: withoutRoute(testRepoPath + "/branch_protections/main"),
+			want: &clients.BranchRef{
+				Name:      &main,
+				Protected: &protected,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					PullRequestRule: clients.PullRequestRule{
+						RequiredApprovingReviewCount: &oneApproval,
+					},
+					CheckRules: clients.StatusChecksRule{
+						RequiresStatusChecks: &protected,
+						Contexts:             []string{"ci"},
+					},
+				},
+			},
+		},
+		{
+			name:   "unprotected branch",
+			branch: "dev",
+			routes: testRoutes,
+			want: &clients.BranchRef{
+				Name:      &dev,
+				Protected: &unprotected,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newTestClient(t, tt.routes).GetBranch(tt.branch)
+			if err != nil {
+				t.Fatalf("GetBranch: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetBranch() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetBranch_missing(t *testing.T) {
+	t.Parallel()
+	if _, err := newTestClient(t, testRoutes).GetBranch("missing"); err == nil {
+		t.Error("GetBranch() of a missing branch succeeded")
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gitearepo implements clients.RepoClient for Gitea and Forgejo.
+package gitearepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+var (
+	_                clients.RepoClient = &Client{}
+	errInputRepoType                    = errors.New("input repo should be of type gitearepo.Repo")
+)
+
+type Client struct {
+	repourl     *Repo
+	repo        *repository
+	api         *apiClient
+	branches    *branchesHandler
+	releases    *releasesHandler
+	workflows   *workflowsHandler
+	commits     *commitsHandler
+	issues      *issuesHandler
+	statuses    *statusesHandler
+	webhook     *webhookHandler
+	languages   *languagesHandler
+	tarball     *tarballHandler
+	ctx         context.Context
+	commitDepth int
+}
+
+// InitRepo fetches the repository and sets up the handlers for it.
+func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
+	giteaRepo, ok := inputRepo.(*Repo)
+	if !ok {
+		return fmt.Errorf("%w: %v", errInputRepoType, inputRepo)
+	}
+	// cleanup previous state, if any.
+	if client.tarball.tempDir != "" {
+		if err := client.tarball.cleanup(); err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+	}
+
+	// instances may be served over http, e.g. in a local network.
+	client.api.baseURL = apiBaseURL(giteaRepo.scheme, giteaRepo.host)
+
+	// Sanity check.
+	var repo repository
+	if err := client.api.get(client.ctx, repoPath(giteaRepo), nil, &repo); err != nil {
+		return sce.WithMessage(sce.ErrRepoUnreachable, giteaRepo.Path()+"\t"+err.Error())
+	}
+
+	if commitDepth <= 0 {
+		client.commitDepth = 30 // default
+	} else {
+		client.commitDepth = commitDepth
+	}
+	client.repo = &repo
+	client.repourl = &Repo{
+		scheme:        giteaRepo.scheme,
+		host:          giteaRepo.host,
+		owner:         giteaRepo.owner,
+		project:       giteaRepo.project,
+		defaultBranch: repo.DefaultBranch,
+		commitSHA:     commitSHA,
+	}
+	var owner string
+	if repo.Owner != nil {
+		owner = repo.Owner.Login
+	}
+
+	client.branches.init(client.ctx, client.repourl)
+	client.releases.init(client.ctx, client.repourl)
+	client.workflows.init(client.ctx, client.repourl)
+	client.commits.init(client.ctx, client.repourl, client.commitDepth)
+	client.issues.init(client.ctx, client.repourl, owner)
+	client.statuses.init(client.ctx, client.repourl)
+	client.webhook.init(client.ctx, client.repourl)
+	client.languages.init(client.ctx, client.repourl)
+	client.tarball.init(client.ctx, client.repourl)
+	return nil
+}
+
+func (client *Client) URI() string {
+	return client.repourl.URI()
+}
+
+func (client *Client) LocalPath() (string, error) {
+	return "", nil
+}
+
+func (client *Client) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
+	return client.tarball.listFiles(predicate)
+}
+
+func (client *Client) GetFileReader(filename string) (io.ReadCloser, error) {
+	return client.tarball.getFile(filename)
+}
+
+func (client *Client) ListCommits() ([]clients.Commit, error) {
+	return client.commits.listCommits()
+}
+
+func (client *Client) ListIssues() ([]clients.Issue, error) {
+	return client.issues.listIssues()
+}
+
+func (client *Client) ListReleases() ([]clients.Release, error) {
+	return client.releases.getReleases()
+}
+
+// ListContributors implements RepoClient.ListContributors. The API of Gitea
+// has no list of contributors, nor their companies.
+func (client *Client) ListContributors() ([]clients.User, error) {
+	return nil, fmt.Errorf("ListContributors (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) IsArchived() (bool, error) {
+	return client.repo.Archived, nil
+}
+
+func (client *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
+	return client.branches.getDefaultBranch()
+}
+
+func (client *Client) GetDefaultBranchName() (string, error) {
+	return client.repourl.defaultBranch, nil
+}
+
+func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
+	return client.branches.getBranch(branch)
+}
+
+func (client *Client) GetCreatedAt() (time.Time, error) {
+	return client.repo.CreatedAt, nil
+}
+
+func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetOrgRepoClient (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
+	return client.webhook.listWebhooks()
+}
+
+func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	return client.workflows.listSuccessfulWorkflowRuns(filename)
+}
+
+func (client *Client) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
+	return nil, fmt.Errorf("ListCheckRunsForRef (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
+	return client.statuses.listStatuses(ref)
+}
+
+func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
+	return client.languages.listProgrammingLanguages()
+}
+
+// ListLicenses implements RepoClient.ListLicenses.
+func (client *Client) ListLicenses() ([]clients.License, error) {
+	return nil, fmt.Errorf("ListLicenses (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
+	return clients.SearchResponse{}, fmt.Errorf("Search (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
+	return nil, fmt.Errorf("SearchCommits (Gitea): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) Close() error {
+	return client.tarball.cleanup()
+}
+
+// CreateGiteaClient returns a client for host, authenticated with the token
+// in GITEA_AUTH_TOKEN if it is set.
+func CreateGiteaClient(ctx context.Context, host string) (clients.RepoClient, error) {
+	token := os.Getenv("GITEA_AUTH_TOKEN")
+	return CreateGiteaClientWithToken(ctx, token, host)
+}
+
+func CreateGiteaClientWithToken(ctx context.Context, token, host string) (clients.RepoClient, error) {
+	return newGiteaClient(ctx, token, host, http.DefaultClient), nil
+}
+
+// CreateGiteaClientWithCache returns a client which caches responses on disk
+// in cacheDir, revalidating them with conditional requests.
+func CreateGiteaClientWithCache(ctx context.Context, host, cacheDir string) (clients.RepoClient, error) {
+	rt, err := httpcache.NewTransport(http.DefaultTransport, cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not create gitea client with error: %w", err)
+	}
+	token := os.Getenv("GITEA_AUTH_TOKEN")
+	return newGiteaClient(ctx, token, host, &http.Client{Transport: rt}), nil
+}
+
+func newGiteaClient(ctx context.Context, token, host string, httpClient *http.Client) *Client {
+	api := newAPIClient(httpClient, "https", host, token)
+	return &Client{
+		ctx:       ctx,
+		api:       api,
+		branches:  &branchesHandler{api: api},
+		releases:  &releasesHandler{api: api},
+		workflows: &workflowsHandler{api: api},
+		commits:   &commitsHandler{api: api},
+		issues:    &issuesHandler{api: api},
+		statuses:  &statusesHandler{api: api},
+		webhook:   &webhookHandler{api: api},
+		languages: &languagesHandler{api: api},
+		tarball:   &tarballHandler{api: api},
+	}
+}
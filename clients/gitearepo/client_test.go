@@ -0,0 +1,281 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+const testRepoPath = "/api/v1/repos/ossf-tests/scorecard"
+
+// testRoutes maps the API paths of ossf-tests/scorecard to the testdata files
+// served for them.
+var testRoutes = map[string]string{
+	"/api/v1/version":                               "version.json",
+	testRepoPath:                                    "repo.json",
+	testRepoPath + "/branches/main":                 "branch-main.json",
+	testRepoPath + "/branches/dev":                  "branch-dev.json",
+	testRepoPath + "/branch_protections/main":       "branch-protection-main.json",
+	testRepoPath + "/releases":                      "releases.json",
+	testRepoPath + "/hooks":                         "hooks.json",
+	testRepoPath + "/commits":                       "commits.json",
+	testRepoPath + "/issues":                        "issues.json",
+	testRepoPath + "/issues/comments":               "issue-comments.json",
+	testRepoPath + "/collaborators/jane/permission": "permission-jane.json",
+	testRepoPath + "/actions/tasks":                 "tasks.json",
+	testRepoPath + "/languages":                     "languages.json",
+	testRepoPath + "/archive/main.tar.gz":           "archive.tar.gz",
+}
+
+// newTestServer serves routes, and responds with 404 Not Found to other paths.
+func newTestServer(t *testing.T, routes map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeFile(w, r, filepath.Join("testdata", file))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestClient(t *testing.T, routes map[string]string) *Client {
+	t.Helper()
+	srv := newTestServer(t, routes)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	client := newGiteaClient(t.Context(), "", u.Host, srv.Client())
+	repo := &Repo{scheme: u.Scheme, host: u.Host, owner: "ossf-tests", project: "scorecard"}
+	if err := client.InitRepo(repo, clients.HeadSHA, 0); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	})
+	return client
+}
+
+// withoutRoute returns testRoutes without path, as if it responded with 404.
+func withoutRoute(path string) map[string]string {
+	routes := map[string]string{}
+	for k, v := range testRoutes {
+		if k != path {
+			routes[k] = v
+		}
+	}
+	return routes
+}
+
+func TestInitRepo(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+
+	if got, want := client.repourl.Path(), "ossf-tests/scorecard"; got != want {
+		t.Errorf("Path() = %q, want %q", got, want)
+	}
+	branch, err := client.GetDefaultBranchName()
+	if err != nil || branch != "main" {
+		t.Errorf("GetDefaultBranchName() = %q, %v, want main", branch, err)
+	}
+	archived, err := client.IsArchived()
+	if err != nil || archived {
+		t.Errorf("IsArchived() = %t, %v, want false", archived, err)
+	}
+	createdAt, err := client.GetCreatedAt()
+	if want := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC); err != nil || !createdAt.Equal(want) {
+		t.Errorf("GetCreatedAt() = %v, %v, want %v", createdAt, err, want)
+	}
+	if _, err := client.ListContributors(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("ListContributors() error = %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+}
+
+func TestInitRepo_notFound(t *testing.T) {
+	t.Parallel()
+	srv := newTestServer(t, withoutRoute(testRepoPath))
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	client := newGiteaClient(t.Context(), "", u.Host, srv.Client())
+	repo := &Repo{scheme: u.Scheme, host: u.Host, owner: "ossf-tests", project: "scorecard"}
+	if err := client.InitRepo(repo, clients.HeadSHA, 0); err == nil {
+		t.Error("InitRepo() of a missing repo succeeded")
+	}
+}
+
+func TestListReleases(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+	got, err := client.ListReleases()
+	if err != nil {
+		t.Fatalf("ListReleases: %v", err)
+	}
+	const download = "https://gitea.example.com/ossf-tests/scorecard/releases/download/v1.0.0/"
+	want := []clients.Release{
+		{
+			TagName:         "v1.0.0",
+			URL:             "https://gitea.example.com/ossf-tests/scorecard/releases/tag/v1.0.0",
+			TargetCommitish: "main",
+			Assets: []clients.ReleaseAsset{
+				{Name: "scorecard.tar.gz", URL: download + "scorecard.tar.gz"},
+				{Name: "scorecard.tar.gz.sig", URL: download + "scorecard.tar.gz.sig"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		routes  map[string]string
+		want    []clients.Webhook
+		wantErr bool
+	}{
+		{
+			name:   "webhooks",
+			routes: testRoutes,
+			want: []clients.Webhook{
+				{ID: 1, Path: "https://ci.example.com/hook", UsesAuthSecret: true},
+				{ID: 2, Path: "https://hooks.slack.com/services/T0"},
+			},
+		},
+		{
+			name:    "failure fetching webhooks",
+			routes:  withoutRoute(testRepoPath + "/hooks"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newTestClient(t, tt.routes).ListWebhooks()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListWebhooks() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListWebhooks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListCommits(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+	got, err := client.ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	want := []clients.Commit{
+		{
+			SHA:           "3b2a1f0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a",
+			Message:       "Fix the build\n",
+			CommittedDate: time.Date(2024, time.March, 4, 5, 6, 7, 0, time.UTC),
+			Committer:     clients.User{Login: "jane", ID: 2},
+			Signature: &clients.Signature{
+				Type:     clients.SignatureTypeSSH,
+				Signer:   "jane",
+				State:    "jane / SHA256:AEbDjTrCOfJQ3NSynVgngqXPFiotdnIzbE/NHM64v80",
+				Verified: true,
+			},
+		},
+		{
+			SHA:           "2a1f0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b",
+			Message:       "Import\n",
+			CommittedDate: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListCommits() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListSuccessfulWorkflowRuns(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+	got, err := client.ListSuccessfulWorkflowRuns("release.yml")
+	if err != nil {
+		t.Fatalf("ListSuccessfulWorkflowRuns: %v", err)
+	}
+	sha := "3b2a1f0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a"
+	want := []clients.WorkflowRun{
+		{HeadSHA: &sha, URL: "https://gitea.example.com/ossf-tests/scorecard/actions/runs/3"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListSuccessfulWorkflowRuns() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListProgrammingLanguages(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+	got, err := client.ListProgrammingLanguages()
+	if err != nil {
+		t.Fatalf("ListProgrammingLanguages: %v", err)
+	}
+	want := []clients.Language{{Name: "Go", NumLines: 12345}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListProgrammingLanguages() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+	files, err := client.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	want := []string{"README.md", ".gitea/workflows/release.yml"}
+	if diff := cmp.Diff(want, files); diff != "" {
+		t.Errorf("ListFiles() mismatch (-want +got):\n%s", diff)
+	}
+
+	r, err := client.GetFileReader(".gitea/workflows/release.yml")
+	if err != nil {
+		t.Fatalf("GetFileReader: %v", err)
+	}
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if diff := cmp.Diff("on: push\njobs: {}\n", string(content)); diff != "" {
+		t.Errorf("GetFileReader() mismatch (-want +got):\n%s", diff)
+	}
+}
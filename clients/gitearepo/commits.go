@@ -0,0 +1,126 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type commit struct {
+	Author    *user  `json:"author"`
+	Committer *user  `json:"committer"`
+	SHA       string `json:"sha"`
+	Commit    struct {
+		Committer struct {
+			Date time.Time `json:"date"`
+		} `json:"committer"`
+		Message string `json:"message"`
+		// Verification has an empty signature for unsigned commits.
+		Verification struct {
+			Signer *struct {
+				Username string `json:"username"`
+			} `json:"signer"`
+			Reason    string `json:"reason"`
+			Signature string `json:"signature"`
+			Verified  bool   `json:"verified"`
+		} `json:"verification"`
+	} `json:"commit"`
+}
+
+type commitsHandler struct {
+	api         *apiClient
+	ctx         context.Context
+	once        *sync.Once
+	errSetup    error
+	repourl     *Repo
+	commits     []clients.Commit
+	commitDepth int
+}
+
+func (handler *commitsHandler) init(ctx context.Context, repourl *Repo, commitDepth int) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.commits = nil
+	handler.commitDepth = commitDepth
+}
+
+func (handler *commitsHandler) setup() error {
+	handler.once.Do(func() {
+		ref := handler.repourl.defaultBranch
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			ref = handler.repourl.commitSHA
+		}
+		query := url.Values{
+			"sha":          {ref},
+			"stat":         {"false"},
+			"verification": {"false"},
+			"files":        {"false"},
+		}
+		commits, err := getAll[commit](handler.ctx, handler.api,
+			repoPath(handler.repourl, "commits"), query, handler.commitDepth)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for commits failed with %w", err)
+			return
+		}
+		for i := range commits {
+			handler.commits = append(handler.commits, makeCommit(&commits[i]))
+		}
+	})
+	return handler.errSetup
+}
+
+// makeCommit attributes a commit to the account of its author, like GitHub.
+func makeCommit(c *commit) clients.Commit {
+	ret := clients.Commit{
+		SHA:           c.SHA,
+		Message:       c.Commit.Message,
+		CommittedDate: c.Commit.Committer.Date,
+	}
+	account := c.Author
+	if account == nil {
+		account = c.Committer
+	}
+	if account != nil {
+		ret.Committer = clients.User{
+			Login: account.Login,
+			ID:    account.ID,
+		}
+	}
+	if v := &c.Commit.Verification; v.Signature != "" {
+		ret.Signature = clients.ParseSignature(v.Signature)
+		if v.Signer != nil && v.Signer.Username != "" {
+			ret.Signature.Signer = v.Signer.Username
+		}
+		ret.Signature.State = v.Reason
+		ret.Signature.Verified = v.Verified
+	}
+	return ret
+}
+
+func (handler *commitsHandler) listCommits() ([]clients.Commit, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during commitsHandler.setup: %w", err)
+	}
+	return handler.commits, nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// lookBackDays is the period in which issue activity is listed, matching the
+// Maintained check.
+const lookBackDays = 90
+
+type issue struct {
+	CreatedAt time.Time `json:"created_at"`
+	User      *user     `json:"user"`
+	HTMLURL   string    `json:"html_url"`
+}
+
+type issueComment struct {
+	CreatedAt time.Time `json:"created_at"`
+	User      *user     `json:"user"`
+	IssueURL  string    `json:"issue_url"`
+}
+
+type permission struct {
+	Permission string `json:"permission"`
+}
+
+type issuesHandler struct {
+	api          *apiClient
+	ctx          context.Context
+	once         *sync.Once
+	errSetup     error
+	repourl      *Repo
+	owner        string
+	associations map[string]*clients.RepoAssociation
+	issues       []clients.Issue
+}
+
+func (handler *issuesHandler) init(ctx context.Context, repourl *Repo, owner string) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.owner = owner
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.associations = map[string]*clients.RepoAssociation{}
+	handler.issues = nil
+}
+
+func (handler *issuesHandler) setup() error {
+	handler.once.Do(func() {
+		since := time.Now().AddDate(0, 0, -lookBackDays).Format(time.RFC3339)
+		query := url.Values{"state": {"all"}, "type": {"issues"}, "since": {since}}
+		issues, err := getAll[issue](handler.ctx, handler.api, repoPath(handler.repourl, "issues"), query, 0)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for issues failed with %w", err)
+			return
+		}
+		comments, err := getAll[issueComment](handler.ctx, handler.api,
+			repoPath(handler.repourl, "issues", "comments"), url.Values{"since": {since}}, 0)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for issue comments failed with %w", err)
+			return
+		}
+
+		commentsByIssue := map[string][]clients.IssueComment{}
+		for i := range comments {
+			c := &comments[i]
+			author, association, err := handler.author(c.User)
+			if err != nil {
+				handler.errSetup = err
+				return
+			}
+			commentsByIssue[c.IssueURL] = append(commentsByIssue[c.IssueURL], clients.IssueComment{
+				CreatedAt:         &c.CreatedAt,
+				Author:            author,
+				AuthorAssociation: association,
+			})
+		}
+		for i := range issues {
+			is := &issues[i]
+			author, association, err := handler.author(is.User)
+			if err != nil {
+				handler.errSetup = err
+				return
+			}
+			handler.issues = append(handler.issues, clients.Issue{
+				URI:               &is.HTMLURL,
+				CreatedAt:         &is.CreatedAt,
+				Author:            author,
+				AuthorAssociation: association,
+				Comments:          commentsByIssue[is.HTMLURL],
+			})
+		}
+	})
+	return handler.errSetup
+}
+
+// author returns the user and its association with the repo. Permissions of
+// other users are only visible to collaborators, so the association of users
+// other than the owner is unknown without a token.
+func (handler *issuesHandler) author(u *user) (*clients.User, *clients.RepoAssociation, error) {
+	if u == nil {
+		return nil, nil, nil
+	}
+	author := &clients.User{Login: u.Login, ID: u.ID}
+	if association, ok := handler.associations[u.Login]; ok {
+		return author, association, nil
+	}
+
+	var association *clients.RepoAssociation
+	if strings.EqualFold(u.Login, handler.owner) {
+		owner := clients.RepoAssociationOwner
+		association = &owner
+	} else {
+		var p permission
+		err := handler.api.get(handler.ctx,
+			repoPath(handler.repourl, "collaborators", u.Login, "permission"), nil, &p)
+		switch {
+		case err == nil:
+			a := permissionToRepoAssociation(p.Permission)
+			association = &a
+		case !hasStatus(err, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound):
+			return nil, nil, fmt.Errorf("request for permission of %s failed with %w", u.Login, err)
+		}
+	}
+	handler.associations[u.Login] = association
+	return author, association, nil
+}
+
+func permissionToRepoAssociation(p string) clients.RepoAssociation {
+	switch p {
+	case "owner":
+		return clients.RepoAssociationOwner
+	case "admin":
+		return clients.RepoAssociationMaintainer
+	case "write":
+		return clients.RepoAssociationCollaborator
+	default:
+		return clients.RepoAssociationNone
+	}
+}
+
+func (handler *issuesHandler) listIssues() ([]clients.Issue, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during issuesHandler.setup: %w", err)
+	}
+	return handler.issues, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func TestListIssues(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, testRoutes)
+	got, err := client.ListIssues()
+	if err != nil {
+		t.Fatalf("ListIssues: %v", err)
+	}
+
+	uri := "https://gitea.example.com/ossf-tests/scorecard/issues/1"
+	date := func(day int) *time.Time {
+		d := time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	collaborator, owner := clients.RepoAssociationCollaborator, clients.RepoAssociationOwner
+	want := []clients.Issue{
+		{
+			URI:       &uri,
+			CreatedAt: date(2),
+			// the permissions of users who aren't collaborators can't be read.
+			Author: &clients.User{Login: "reporter", ID: 4},
+			Comments: []clients.IssueComment{
+				{
+					CreatedAt:         date(3),
+					Author:            &clients.User{Login: "jane", ID: 2},
+					AuthorAssociation: &collaborator,
+				},
+				{
+					CreatedAt:         date(4),
+					Author:            &clients.User{Login: "ossf-tests", ID: 1},
+					AuthorAssociation: &owner,
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListIssues() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPermissionToRepoAssociation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		permission string
+		want       clients.RepoAssociation
+	}{
+		{permission: "owner", want: clients.RepoAssociationOwner},
+		{permission: "admin", want: clients.RepoAssociationMaintainer},
+		{permission: "write", want: clients.RepoAssociationCollaborator},
+		{permission: "read", want: clients.RepoAssociationNone},
+		{permission: "none", want: clients.RepoAssociationNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.permission, func(t *testing.T) {
+			t.Parallel()
+			if got := permissionToRepoAssociation(tt.permission); got != tt.want {
+				t.Errorf("permissionToRepoAssociation(%q) = %v, want %v", tt.permission, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type languagesHandler struct {
+	api       *apiClient
+	ctx       context.Context
+	once      *sync.Once
+	errSetup  error
+	repourl   *Repo
+	languages []clients.Language
+}
+
+func (handler *languagesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.languages = nil
+}
+
+func (handler *languagesHandler) setup() error {
+	handler.once.Do(func() {
+		// the API returns the number of bytes per language.
+		var languages map[string]int
+		if err := handler.api.get(handler.ctx, repoPath(handler.repourl, "languages"), nil, &languages); err != nil {
+			handler.errSetup = fmt.Errorf("request for repo languages failed with %w", err)
+			return
+		}
+		for k, v := range languages {
+			handler.languages = append(handler.languages, clients.Language{
+				Name:     clients.LanguageName(k),
+				NumLines: v,
+			})
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *languagesHandler) listProgrammingLanguages() ([]clients.Language, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during languagesHandler.setup: %w", err)
+	}
+	return handler.languages, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// releasesLimit is the number of recent releases which are listed.
+const releasesLimit = 30
+
+type release struct {
+	TagName         string         `json:"tag_name"`
+	TargetCommitish string         `json:"target_commitish"`
+	HTMLURL         string         `json:"html_url"`
+	Assets          []releaseAsset `json:"assets"`
+}
+
+type releaseAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+type releasesHandler struct {
+	api      *apiClient
+	ctx      context.Context
+	once     *sync.Once
+	errSetup error
+	repourl  *Repo
+	releases []clients.Release
+}
+
+func (handler *releasesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.releases = nil
+}
+
+func (handler *releasesHandler) setup() error {
+	handler.once.Do(func() {
+		query := url.Values{"draft": {"false"}}
+		releases, err := getAll[release](handler.ctx, handler.api,
+			repoPath(handler.repourl, "releases"), query, releasesLimit)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for releases failed with %w", err)
+			return
+		}
+		for i := range releases {
+			r := clients.Release{
+				TagName:         releases[i].TagName,
+				URL:             releases[i].HTMLURL,
+				TargetCommitish: releases[i].TargetCommitish,
+			}
+			for _, a := range releases[i].Assets {
+				r.Assets = append(r.Assets, clients.ReleaseAsset{
+					Name: a.Name,
+					URL:  a.BrowserDownloadURL,
+				})
+			}
+			handler.releases = append(handler.releases, r)
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during releasesHandler.setup: %w", err)
+	}
+	return handler.releases, nil
+}
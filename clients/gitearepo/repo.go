@@ -0,0 +1,170 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// knownHosts are public Gitea/Forgejo instances, which are not probed.
+var knownHosts = []string{"codeberg.org", "gitea.com"}
+
+var errInvalidGiteaRepoURL = errors.New("repo is not a gitea repo")
+
+type Repo struct {
+	scheme        string
+	host          string
+	owner         string
+	project       string
+	defaultBranch string
+	commitSHA     string
+	metadata      []string
+}
+
+// HasGiteaHost reports whether input is hosted on a known Gitea or Forgejo
+// instance, or on the instance configured with GITEA_HOST.
+func HasGiteaHost(input string) bool {
+	u, err := url.Parse(withDefaultScheme(input))
+	if err != nil {
+		return false
+	}
+	return isKnownHost(u.Host)
+}
+
+func isKnownHost(host string) bool {
+	if h := os.Getenv("GITEA_HOST"); h != "" {
+		if u, err := url.Parse(withDefaultScheme(h)); err == nil && strings.EqualFold(u.Host, host) {
+			return true
+		}
+	}
+	for _, h := range knownHosts {
+		if strings.EqualFold(host, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses input string into repoURL struct.
+// Accepted input string formats are "<host>/<owner>/<repo>" and
+// "https://<host>/<owner>/<repo>".
+func (r *Repo) parse(input string) error {
+	u, err := url.Parse(withDefaultScheme(input))
+	if err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("url.Parse: %v", err))
+	}
+	const splitLen = 2
+	split := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Host == "" || len(split) != splitLen {
+		return sce.WithMessage(sce.ErrInvalidURL, fmt.Sprintf("%v. Expected full repository url", input))
+	}
+	r.scheme, r.host, r.owner, r.project = u.Scheme, u.Host, split[0], strings.TrimSuffix(split[1], ".git")
+	return nil
+}
+
+// Allow skipping scheme for ease-of-use, default to https.
+func withDefaultScheme(uri string) string {
+	if strings.Contains(uri, "://") {
+		return uri
+	}
+	return "https://" + uri
+}
+
+// URI implements Repo.URI().
+func (r *Repo) URI() string {
+	return fmt.Sprintf("%s/%s/%s", r.host, r.owner, r.project)
+}
+
+func (r *Repo) Host() string {
+	return r.host
+}
+
+// String implements Repo.String.
+func (r *Repo) String() string {
+	return fmt.Sprintf("%s-%s_%s", r.host, r.owner, r.project)
+}
+
+// IsValid implements Repo.IsValid. Hosts other than known instances must
+// serve the Gitea version endpoint, which Forgejo serves as well.
+func (r *Repo) IsValid() error {
+	if strings.TrimSpace(r.owner) == "" || strings.TrimSpace(r.project) == "" {
+		return sce.WithMessage(sce.ErrInvalidURL, "expected full repository url: "+r.URI())
+	}
+
+	if isKnownHost(r.host) {
+		return nil
+	}
+
+	for _, h := range []string{"github.com", "gitlab.com", "dev.azure.com"} {
+		if strings.EqualFold(r.host, h) {
+			return fmt.Errorf("%w: %s", errInvalidGiteaRepoURL, r.host)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	api := newAPIClient(http.DefaultClient, r.scheme, r.host, "")
+	var version struct {
+		Version string `json:"version"`
+	}
+	if err := api.get(ctx, "/version", nil, &version); err != nil || version.Version == "" {
+		return sce.WithMessage(sce.ErrRepoUnreachable,
+			fmt.Sprintf("couldn't reach gitea instance at %s: %v", r.host, err),
+		)
+	}
+	return nil
+}
+
+func (r *Repo) AppendMetadata(metadata ...string) {
+	r.metadata = append(r.metadata, metadata...)
+}
+
+// Metadata implements Repo.Metadata.
+func (r *Repo) Metadata() []string {
+	return r.metadata
+}
+
+// Type implements Repo.Type.
+func (r *Repo) Type() clients.RepoType {
+	return clients.RepoTypeGitea
+}
+
+// Path() implements RepoClient.Path.
+func (r *Repo) Path() string {
+	return fmt.Sprintf("%s/%s", r.owner, r.project)
+}
+
+// MakeGiteaRepo takes input of forms in parse and returns and implementation
+// of clients.Repo interface.
+func MakeGiteaRepo(input string) (clients.Repo, error) {
+	var repo Repo
+	if err := repo.parse(input); err != nil {
+		return nil, fmt.Errorf("error during parse: %w", err)
+	}
+	if err := repo.IsValid(); err != nil {
+		return nil, fmt.Errorf("error in IsValid: %w", err)
+	}
+	return &repo, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRepo_parse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		input    string
+		expected Repo
+		wantErr  bool
+	}{
+		{
+			name:     "without scheme",
+			input:    "codeberg.org/forgejo/forgejo",
+			expected: Repo{scheme: "https", host: "codeberg.org", owner: "forgejo", project: "forgejo"},
+		},
+		{
+			name:     "http with port and .git suffix",
+			input:    "http://gitea.local:3000/owner/repo.git",
+			expected: Repo{scheme: "http", host: "gitea.local:3000", owner: "owner", project: "repo"},
+		},
+		{
+			name:    "missing host",
+			input:   "owner/repo",
+			wantErr: true,
+		},
+		{
+			name:    "nested path",
+			input:   "https://gitea.com/owner/repo/src/branch/main",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var r Repo
+			err := r.parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parse() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.expected, r, cmp.AllowUnexported(Repo{})); diff != "" {
+				t.Errorf("parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // Since t.Setenv is used.
+func TestHasGiteaHost(t *testing.T) {
+	t.Setenv("GITEA_HOST", "https://git.example.com")
+	tests := map[string]bool{
+		"codeberg.org/forgejo/forgejo":          true,
+		"https://gitea.com/gitea/tea":           true,
+		"git.example.com/owner/repo":            true,
+		"https://gitlab.example.com/owner/repo": false,
+	}
+	for input, want := range tests {
+		if got := HasGiteaHost(input); got != want {
+			t.Errorf("HasGiteaHost(%q) = %t, want %t", input, got, want)
+		}
+	}
+}
+
+func TestMakeGiteaRepo(t *testing.T) {
+	t.Parallel()
+	srv := newTestServer(t, testRoutes)
+	repo, err := MakeGiteaRepo(srv.URL + "/ossf-tests/scorecard")
+	if err != nil {
+		t.Fatalf("MakeGiteaRepo: %v", err)
+	}
+	if got, want := repo.Path(), "ossf-tests/scorecard"; got != want {
+		t.Errorf("Path() = %q, want %q", got, want)
+	}
+
+	// other forges don't serve the version endpoint.
+	other := newTestServer(t, nil)
+	if _, err := MakeGiteaRepo(other.URL + "/ossf-tests/scorecard"); err == nil {
+		t.Error("MakeGiteaRepo() accepted a host without the Gitea API")
+	}
+	if _, err := MakeGiteaRepo("github.com/ossf/scorecard"); err == nil {
+		t.Error("MakeGiteaRepo() accepted a GitHub repo")
+	}
+}
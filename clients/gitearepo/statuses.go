@@ -0,0 +1,58 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+type commitStatus struct {
+	Status    string `json:"status"`
+	Context   string `json:"context"`
+	URL       string `json:"url"`
+	TargetURL string `json:"target_url"`
+}
+
+type statusesHandler struct {
+	api     *apiClient
+	ctx     context.Context
+	repourl *Repo
+}
+
+func (handler *statusesHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+}
+
+func (handler *statusesHandler) listStatuses(ref string) ([]clients.Status, error) {
+	statuses, err := getAll[commitStatus](handler.ctx, handler.api,
+		repoPath(handler.repourl, "commits", ref, "statuses"), nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("request for statuses of %s failed with %w", ref, err)
+	}
+	ret := make([]clients.Status, 0, len(statuses))
+	for _, s := range statuses {
+		ret = append(ret, clients.Status{
+			State:     s.Status,
+			Context:   s.Context,
+			URL:       s.URL,
+			TargetURL: s.TargetURL,
+		})
+	}
+	return ret, nil
+}
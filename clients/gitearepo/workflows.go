@@ -0,0 +1,69 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitearepo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+// actionTask is a job run of Gitea Actions, whose workflows use the syntax
+// of GitHub workflows.
+type actionTask struct {
+	HeadSHA    string `json:"head_sha"`
+	Status     string `json:"status"`
+	WorkflowID string `json:"workflow_id"`
+	URL        string `json:"url"`
+}
+
+type actionTasks struct {
+	WorkflowRuns []actionTask `json:"workflow_runs"`
+}
+
+type workflowsHandler struct {
+	api     *apiClient
+	ctx     context.Context
+	repourl *Repo
+}
+
+func (handler *workflowsHandler) init(ctx context.Context, repourl *Repo) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+}
+
+// listSuccessfulWorkflowRuns returns the successful runs among the most recent
+// runs of a workflow file, e.g. release.yml.
+func (handler *workflowsHandler) listSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
+	var tasks actionTasks
+	query := url.Values{"limit": {strconv.Itoa(pageLimit)}}
+	if err := handler.api.get(handler.ctx, repoPath(handler.repourl, "actions", "tasks"), query, &tasks); err != nil {
+		return nil, fmt.Errorf("request for workflow runs failed with %w", err)
+	}
+	var runs []clients.WorkflowRun
+	for _, task := range tasks.WorkflowRuns {
+		if task.WorkflowID != filename || task.Status != "success" {
+			continue
+		}
+		runs = append(runs, clients.WorkflowRun{
+			HeadSHA: &task.HeadSHA,
+			URL:     task.URL,
+		})
+	}
+	return runs, nil
+}
@@ -179,9 +179,31 @@ type repoRule struct {
 	Type       string
 	Parameters repoRulesParameters
 }
+type workflowFileReference struct {
+	Ref          *string
+	Path         string
+	RepositoryID int64 `graphql:"repositoryId"`
+}
+type workflowsParameters struct {
+	Workflows []workflowFileReference
+}
+type requiredDeploymentsParameters struct {
+	RequiredDeploymentEnvironments []string
+}
+type codeScanningTool struct {
+	Tool                    string
+	AlertsThreshold         string
+	SecurityAlertsThreshold string
+}
+type codeScanningParameters struct {
+	CodeScanningTools []codeScanningTool
+}
 type repoRulesParameters struct {
-	PullRequestParameters pullRequestRuleParameters     `graphql:"... on PullRequestParameters"`
-	StatusCheckParameters requiredStatusCheckParameters `graphql:"... on RequiredStatusChecksParameters"`
+	PullRequestParameters         pullRequestRuleParameters     `graphql:"... on PullRequestParameters"`
+	StatusCheckParameters         requiredStatusCheckParameters `graphql:"... on RequiredStatusChecksParameters"`
+	WorkflowsParameters           workflowsParameters           `graphql:"... on WorkflowsParameters"`
+	RequiredDeploymentsParameters requiredDeploymentsParameters `graphql:"... on RequiredDeploymentsParameters"`
+	CodeScanningParameters        codeScanningParameters        `graphql:"... on CodeScanningParameters"`
 }
 type ruleSetConditionRefs struct {
 	Include []string
@@ -193,12 +215,36 @@ type ruleSetCondition struct {
 type ruleSetBypass struct {
 	BypassMode         *string
 	OrganizationAdmin  *bool
+	EnterpriseOwner    *bool
+	DeployKey          *bool
 	RepositoryRoleName *string
+	Actor              struct {
+		Typename string `graphql:"__typename"`
+		App      struct {
+			Name string
+		} `graphql:"... on App"`
+		Team struct {
+			CombinedSlug string
+		} `graphql:"... on Team"`
+	}
+}
+type ruleSetSource struct {
+	Typename     string `graphql:"__typename"`
+	Organization struct {
+		Login string
+	} `graphql:"... on Organization"`
+	Enterprise struct {
+		Slug string
+	} `graphql:"... on Enterprise"`
+	Repository struct {
+		NameWithOwner string
+	} `graphql:"... on Repository"`
 }
 type repoRuleSet struct {
 	Name         *string
 	Enforcement  *string
 	Target       *string
+	Source       ruleSetSource
 	Conditions   ruleSetCondition
 	BypassActors struct {
 		Nodes []*ruleSetBypass
@@ -214,7 +260,7 @@ type ruleSetData struct {
 		}
 		Rulesets struct {
 			Nodes []*repoRuleSet
-		} `graphql:"rulesets(first: 100)"`
+		} `graphql:"rulesets(first: 100, includeParents: true)"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
@@ -266,7 +312,7 @@ func (handler *branchesHandler) setup() error {
 			return
 		}
 		handler.defaultBranchName = getDefaultBranchNameFrom(rulesData)
-		handler.ruleSets = getActiveRuleSetsFrom(rulesData)
+		handler.ruleSets = getEnabledRuleSetsFrom(rulesData.Repository.Rulesets.Nodes)
 
 		// Attempt to fetch branch protection rules, which require admin permission.
 		// Ignore permissions errors if we know the repository is using rulesets, so non-admins can still get a score.
@@ -278,18 +324,14 @@ func (handler *branchesHandler) setup() error {
 				return
 			}
 			// only report permission errors if no ruleset data
-			if len(handler.ruleSets) == 0 {
+			if len(getActiveRuleSetsFrom(handler.ruleSets)) == 0 {
 				handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, classicBranchErrMsg)
 				return
 			}
 		}
 
-		rules, err := rulesMatchingBranch(handler.ruleSets, handler.defaultBranchName, true)
-		if err != nil {
-			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("rulesMatchingBranch: %v", err))
-			return
-		}
-		handler.defaultBranchRef = getBranchRefFrom(handler.data.Repository.DefaultBranchRef, rules)
+		handler.defaultBranchRef, handler.errSetup = handler.branchRefFrom(
+			handler.data.Repository.DefaultBranchRef, handler.defaultBranchName)
 	})
 	return handler.errSetup
 }
@@ -316,15 +358,25 @@ func (handler *branchesHandler) query(branchName string) (*clients.BranchRef, er
 			return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 		}
 		// only report permission errors if no ruleset data
-		if len(handler.ruleSets) == 0 {
+		if len(getActiveRuleSetsFrom(handler.ruleSets)) == 0 {
 			return nil, sce.WithMessage(sce.ErrScorecardInternal, classicBranchErrMsg)
 		}
 	}
+	return handler.branchRefFrom(queryData.Repository.Ref, branchName)
+}
+
+// branchRefFrom merges the active rulesets matching a branch into its protection rules,
+// and reports all the enabled ones.
+func (handler *branchesHandler) branchRefFrom(data *branch, branchName string) (*clients.BranchRef, error) {
 	rules, err := rulesMatchingBranch(handler.ruleSets, branchName, branchName == handler.defaultBranchName)
 	if err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("rulesMatchingBranch: %v", err))
 	}
-	return getBranchRefFrom(queryData.Repository.Ref, rules), nil
+	branchRef := getBranchRefFrom(data, getActiveRuleSetsFrom(rules))
+	if branchRef != nil {
+		branchRef.RuleSets = ruleSetsFrom(rules)
+	}
+	return branchRef, nil
 }
 
 func (handler *branchesHandler) getDefaultBranch() (*clients.BranchRef, error) {
@@ -414,10 +466,23 @@ func getDefaultBranchNameFrom(data *ruleSetData) string {
 	return *data.Repository.DefaultBranchRef.Name
 }
 
-func getActiveRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
+func getActiveRuleSetsFrom(ruleSets []*repoRuleSet) []*repoRuleSet {
+	ret := make([]*repoRuleSet, 0)
+	for _, rule := range ruleSets {
+		if rule.Enforcement == nil || *rule.Enforcement != enforcementActive {
+			continue
+		}
+		ret = append(ret, rule)
+	}
+	return ret
+}
+
+// getEnabledRuleSetsFrom returns the active rulesets and the ones which are only evaluated.
+func getEnabledRuleSetsFrom(ruleSets []*repoRuleSet) []*repoRuleSet {
 	ret := make([]*repoRuleSet, 0)
-	for _, rule := range data.Repository.Rulesets.Nodes {
-		if rule.Enforcement == nil || *rule.Enforcement != "ACTIVE" {
+	for _, rule := range ruleSets {
+		if rule.Enforcement == nil ||
+			(*rule.Enforcement != enforcementActive && *rule.Enforcement != enforcementEvaluate) {
 			continue
 		}
 		ret = append(ret, rule)
@@ -425,6 +490,82 @@ func getActiveRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
 	return ret
 }
 
+func ruleSetsFrom(rules []*repoRuleSet) []clients.RuleSet {
+	var ret []clients.RuleSet
+	for _, r := range rules {
+		ruleSet := clients.RuleSet{
+			Name:        valueOrZero(r.Name),
+			Enforcement: clients.RuleSetEnforcementActive,
+		}
+		if valueOrZero(r.Enforcement) == enforcementEvaluate {
+			ruleSet.Enforcement = clients.RuleSetEnforcementEvaluate
+		}
+		switch r.Source.Typename {
+		case "Repository":
+			ruleSet.Source = clients.RuleSetSourceRepository
+			ruleSet.SourceName = r.Source.Repository.NameWithOwner
+		case "Organization":
+			ruleSet.Source = clients.RuleSetSourceOrganization
+			ruleSet.SourceName = r.Source.Organization.Login
+		case "Enterprise":
+			ruleSet.Source = clients.RuleSetSourceEnterprise
+			ruleSet.SourceName = r.Source.Enterprise.Slug
+		}
+		for _, actor := range r.BypassActors.Nodes {
+			ruleSet.BypassActors = append(ruleSet.BypassActors, bypassActorFrom(actor))
+		}
+		for _, rule := range r.Rules.Nodes {
+			switch rule.Type {
+			case ruleWorkflows:
+				for _, w := range rule.Parameters.WorkflowsParameters.Workflows {
+					ruleSet.RequiredWorkflows = append(ruleSet.RequiredWorkflows, clients.RequiredWorkflow{
+						Path:         w.Path,
+						Ref:          valueOrZero(w.Ref),
+						RepositoryID: w.RepositoryID,
+					})
+				}
+			case ruleDeployments:
+				ruleSet.RequiredDeployments = append(ruleSet.RequiredDeployments,
+					rule.Parameters.RequiredDeploymentsParameters.RequiredDeploymentEnvironments...)
+			case ruleCodeScanning:
+				for _, t := range rule.Parameters.CodeScanningParameters.CodeScanningTools {
+					ruleSet.CodeScanningTools = append(ruleSet.CodeScanningTools, clients.CodeScanningTool{
+						Tool:                    t.Tool,
+						AlertsThreshold:         t.AlertsThreshold,
+						SecurityAlertsThreshold: t.SecurityAlertsThreshold,
+					})
+				}
+			}
+		}
+		ret = append(ret, ruleSet)
+	}
+	return ret
+}
+
+func bypassActorFrom(actor *ruleSetBypass) clients.BypassActor {
+	ret := clients.BypassActor{
+		PullRequestOnly: valueOrZero(actor.BypassMode) == "PULL_REQUEST",
+	}
+	switch {
+	case valueOrZero(actor.OrganizationAdmin):
+		ret.Type = clients.BypassActorOrganizationAdmin
+	case valueOrZero(actor.EnterpriseOwner):
+		ret.Type = clients.BypassActorEnterpriseOwner
+	case valueOrZero(actor.DeployKey):
+		ret.Type = clients.BypassActorDeployKey
+	case actor.RepositoryRoleName != nil:
+		ret.Type = clients.BypassActorRepositoryRole
+		ret.Name = *actor.RepositoryRoleName
+	case actor.Actor.Typename == "Team":
+		ret.Type = clients.BypassActorTeam
+		ret.Name = actor.Actor.Team.CombinedSlug
+	case actor.Actor.Typename == "App":
+		ret.Type = clients.BypassActorIntegration
+		ret.Name = actor.Actor.App.Name
+	}
+	return ret
+}
+
 func getBranchRefFrom(data *branch, rules []*repoRuleSet) *clients.BranchRef {
 	if data == nil {
 		return nil
@@ -485,15 +626,32 @@ const (
 	ruleLinear                 = "REQUIRED_LINEAR_HISTORY"
 	rulePullRequest            = "PULL_REQUEST"
 	ruleStatusCheck            = "REQUIRED_STATUS_CHECKS"
+	ruleUpdate                 = "UPDATE"
+	ruleWorkflows              = "WORKFLOWS"
+	ruleDeployments            = "REQUIRED_DEPLOYMENTS"
+	ruleCodeScanning           = "CODE_SCANNING"
+	enforcementActive          = "ACTIVE"
+	enforcementEvaluate        = "EVALUATE"
+	ruleTargetBranch           = "BRANCH"
+	ruleTargetTag              = "TAG"
 )
 
 func rulesMatchingBranch(rules []*repoRuleSet, name string, defaultRef bool) ([]*repoRuleSet, error) {
-	refName := refPrefix + name
+	return rulesMatchingRef(rules, ruleTargetBranch, refPrefix+name, defaultRef)
+}
+
+// rulesMatchingRef returns the rulesets with the given target, i.e., BRANCH or TAG,
+// which apply to a fully qualified ref name.
+func rulesMatchingRef(rules []*repoRuleSet, target, refName string, defaultRef bool) ([]*repoRuleSet, error) {
 	ret := make([]*repoRuleSet, 0)
 nextRule:
 	for _, rule := range rules {
-		// Skip rulesets that don't target branches
-		if rule.Target != nil && *rule.Target != "BRANCH" {
+		// Skip rulesets that don't target the kind of ref, rulesets without a target apply to branches.
+		ruleTarget := ruleTargetBranch
+		if rule.Target != nil {
+			ruleTarget = *rule.Target
+		}
+		if ruleTarget != target {
 			continue
 		}
 
@@ -670,3 +670,92 @@ func Test_translationFromGithubAPIBranchProtectionData(t *testing.T) {
 		})
 	}
 }
+
+func Test_ruleSetsFrom(t *testing.T) {
+	t.Parallel()
+	evaluate := "EVALUATE"
+	orgRuleSet := ruleSet(withRules(
+		&repoRule{
+			Type: ruleWorkflows,
+			Parameters: repoRulesParameters{
+				WorkflowsParameters: workflowsParameters{
+					Workflows: []workflowFileReference{
+						{Path: ".github/workflows/ci.yml", Ref: asPtr("refs/heads/main"), RepositoryID: 42},
+					},
+				},
+			},
+		},
+		&repoRule{
+			Type: ruleDeployments,
+			Parameters: repoRulesParameters{
+				RequiredDeploymentsParameters: requiredDeploymentsParameters{
+					RequiredDeploymentEnvironments: []string{"staging"},
+				},
+			},
+		},
+		&repoRule{
+			Type: ruleCodeScanning,
+			Parameters: repoRulesParameters{
+				CodeScanningParameters: codeScanningParameters{
+					CodeScanningTools: []codeScanningTool{
+						{Tool: "CodeQL", AlertsThreshold: "ERRORS", SecurityAlertsThreshold: "HIGH_OR_HIGHER"},
+					},
+				},
+			},
+		},
+	))
+	orgRuleSet.Name = asPtr("org baseline")
+	orgRuleSet.Enforcement = &evaluate
+	orgRuleSet.Source.Typename = "Organization"
+	orgRuleSet.Source.Organization.Login = "ossf"
+	orgRuleSet.BypassActors.Nodes = []*ruleSetBypass{
+		{OrganizationAdmin: asPtr(true), BypassMode: asPtr("ALWAYS")},
+		{RepositoryRoleName: asPtr("maintain"), BypassMode: asPtr("PULL_REQUEST")},
+		{DeployKey: asPtr(true), BypassMode: asPtr("ALWAYS")},
+	}
+	teamBypass := &ruleSetBypass{BypassMode: asPtr("ALWAYS")}
+	teamBypass.Actor.Typename = "Team"
+	teamBypass.Actor.Team.CombinedSlug = "ossf/release-managers"
+	appBypass := &ruleSetBypass{BypassMode: asPtr("ALWAYS")}
+	appBypass.Actor.Typename = "App"
+	appBypass.Actor.App.Name = "release-bot"
+	orgRuleSet.BypassActors.Nodes = append(orgRuleSet.BypassActors.Nodes, teamBypass, appBypass)
+
+	repoLevelRuleSet := ruleSet(withRules(&repoRule{Type: ruleDeletion}))
+	repoLevelRuleSet.Name = asPtr("main")
+	repoLevelRuleSet.Source.Typename = "Repository"
+	repoLevelRuleSet.Source.Repository.NameWithOwner = "ossf/scorecard"
+
+	want := []clients.RuleSet{
+		{
+			Name:        "org baseline",
+			Enforcement: clients.RuleSetEnforcementEvaluate,
+			Source:      clients.RuleSetSourceOrganization,
+			SourceName:  "ossf",
+			BypassActors: []clients.BypassActor{
+				{Type: clients.BypassActorOrganizationAdmin},
+				{Type: clients.BypassActorRepositoryRole, Name: "maintain", PullRequestOnly: true},
+				{Type: clients.BypassActorDeployKey},
+				{Type: clients.BypassActorTeam, Name: "ossf/release-managers"},
+				{Type: clients.BypassActorIntegration, Name: "release-bot"},
+			},
+			RequiredWorkflows: []clients.RequiredWorkflow{
+				{Path: ".github/workflows/ci.yml", Ref: "refs/heads/main", RepositoryID: 42},
+			},
+			RequiredDeployments: []string{"staging"},
+			CodeScanningTools: []clients.CodeScanningTool{
+				{Tool: "CodeQL", AlertsThreshold: "ERRORS", SecurityAlertsThreshold: "HIGH_OR_HIGHER"},
+			},
+		},
+		{
+			Name:        "main",
+			Enforcement: clients.RuleSetEnforcementActive,
+			Source:      clients.RuleSetSourceRepository,
+			SourceName:  "ossf/scorecard",
+		},
+	}
+	got := ruleSetsFrom([]*repoRuleSet{orgRuleSet, repoLevelRuleSet})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ruleSetsFrom() mismatch (-want +got):\n%s", diff)
+	}
+}
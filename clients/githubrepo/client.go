@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
@@ -29,6 +28,7 @@ import (
 	"github.com/shurcooL/githubv4"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/githubrepo/hosts"
 	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
 	sce "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/internal/gitfile"
@@ -47,6 +47,7 @@ type Option func(*repoClientConfig) error
 type Client struct {
 	repourl       *Repo
 	repo          *github.Repository
+	httpClient    *http.Client
 	repoClient    *github.Client
 	graphClient   *graphqlHandler
 	contributors  *contributorsHandler
@@ -62,6 +63,7 @@ type Client struct {
 	licenses      *licensesHandler
 	git           *gitfile.Handler
 	ctx           context.Context
+	host          string
 	tarball       tarballHandler
 	commitDepth   int
 	gitMode       bool
@@ -83,12 +85,22 @@ func WithRoundTripper(rt http.RoundTripper) Option {
 	}
 }
 
-type repoClientConfig struct {
-	rt      http.RoundTripper
-	gitMode bool
+// WithCacheDir configures the repo client to cache responses on disk in dir.
+// Cached responses are revalidated with conditional requests.
+// It has no effect with [WithRoundTripper], wrap the round tripper with
+// httpcache.NewTransport instead.
+func WithCacheDir(dir string) Option {
+	return func(c *repoClientConfig) error {
+		c.cacheDir = dir
+		return nil
+	}
 }
 
-const defaultGhHost = "github.com"
+type repoClientConfig struct {
+	rt       http.RoundTripper
+	cacheDir string
+	gitMode  bool
+}
 
 // InitRepo sets up the GitHub repo in local storage for improving performance and GitHub token usage efficiency.
 func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitDepth int) error {
@@ -97,6 +109,17 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 		return fmt.Errorf("%w: %v", errInputRepoType, inputRepo)
 	}
 
+	// The repo may be hosted on another GitHub host than the default one.
+	if ghRepo.host != "" && !strings.EqualFold(ghRepo.host, client.host) {
+		host, ok := hosts.Lookup(ghRepo.host)
+		if !ok {
+			return sce.WithMessage(sce.ErrUnsupportedHost, ghRepo.host)
+		}
+		if err := client.useHost(host); err != nil {
+			return err
+		}
+	}
+
 	// Sanity check.
 	repo, _, err := client.repoClient.Repositories.Get(client.ctx, ghRepo.owner, ghRepo.repo)
 	if err != nil {
@@ -108,6 +131,7 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	client.commitDepth = commitDepth
 	client.repo = repo
 	client.repourl = &Repo{
+		host:          client.host,
 		owner:         repo.Owner.GetLogin(),
 		repo:          repo.GetName(),
 		defaultBranch: repo.GetDefaultBranch(),
@@ -162,11 +186,7 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 
 // URI implements RepoClient.URI.
 func (client *Client) URI() string {
-	host, isHost := os.LookupEnv("GH_HOST")
-	if !isHost {
-		host = defaultGhHost
-	}
-	return fmt.Sprintf("%s/%s/%s", host, client.repourl.owner, client.repourl.repo)
+	return fmt.Sprintf("%s/%s/%s", client.repourl.host, client.repourl.owner, client.repourl.repo)
 }
 
 // LocalPath implements RepoClient.LocalPath.
@@ -258,13 +278,18 @@ func (client *Client) GetBranch(branch string) (*clients.BranchRef, error) {
 	return client.branches.getBranch(branch)
 }
 
+// IsPrivate implements RepoClient.IsPrivate, for private and internal repositories.
+func (client *Client) IsPrivate() (bool, error) {
+	return client.repo.GetPrivate(), nil
+}
+
 // GetCreatedAt is a getter for repo.CreatedAt.
 func (client *Client) GetCreatedAt() (time.Time, error) {
 	return client.repo.CreatedAt.Time, nil
 }
 
 func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
-	dotGithubRepo, err := MakeGithubRepo(fmt.Sprintf("%s/.github", client.repourl.owner))
+	dotGithubRepo, err := MakeGithubRepo(fmt.Sprintf("%s/%s/.github", client.repourl.host, client.repourl.owner))
 	if err != nil {
 		return nil, fmt.Errorf("error during MakeGithubRepo: %w", err)
 	}
@@ -355,80 +380,83 @@ func NewRepoClient(ctx context.Context, opts ...Option) (clients.RepoClient, err
 
 	if config.rt == nil {
 		logger := log.NewLogger(log.DefaultLevel)
-		config.rt = roundtripper.NewTransport(ctx, logger)
+		config.rt = roundtripper.NewTransport(ctx, logger, roundtripper.WithCacheDir(config.cacheDir))
 	}
 
 	httpClient := &http.Client{
 		Transport: config.rt,
 	}
 
-	var client *github.Client
-	var graphClient *githubv4.Client
-	githubHost, isGhHost := os.LookupEnv("GH_HOST")
-
-	if isGhHost && githubHost != defaultGhHost {
-		githubRestURL := fmt.Sprintf("https://%s/api/v3", strings.TrimSpace(githubHost))
-		githubGraphqlURL := fmt.Sprintf("https://%s/api/graphql", strings.TrimSpace(githubHost))
+	client := &Client{
+		ctx:        ctx,
+		httpClient: httpClient,
+		gitMode:    config.gitMode,
+		git:        &gitfile.Handler{},
+	}
+	if err := client.useHost(hosts.Default()); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
 
+// useHost sets up the handlers to use the APIs of a GitHub host.
+func (client *Client) useHost(host hosts.Host) error {
+	repoClient := github.NewClient(client.httpClient)
+	graphClient := githubv4.NewClient(client.httpClient)
+	if host.IsEnterprise() {
 		var err error
-		client, err = github.NewClient(httpClient).WithEnterpriseURLs(githubRestURL, githubRestURL)
+		repoClient, err = repoClient.WithEnterpriseURLs(host.APIURL, host.UploadURL)
 		if err != nil {
-			panic(fmt.Errorf("error during CreateGithubRepoClientWithTransport:EnterpriseClient: %w", err))
+			return fmt.Errorf("github enterprise client for %s: %w", host.Name, err)
 		}
-
-		graphClient = githubv4.NewEnterpriseClient(githubGraphqlURL, httpClient)
-	} else {
-		client = github.NewClient(httpClient)
-		graphClient = githubv4.NewClient(httpClient)
+		graphClient = githubv4.NewEnterpriseClient(host.GraphQLURL, client.httpClient)
 	}
 
-	return &Client{
-		ctx:        ctx,
-		repoClient: client,
-		graphClient: &graphqlHandler{
-			client: graphClient,
-		},
-		contributors: &contributorsHandler{
-			ghClient: client,
-		},
-		branches: &branchesHandler{
-			ghClient:    client,
-			graphClient: graphClient,
-		},
-		releases: &releasesHandler{
-			client: client,
-		},
-		workflows: &workflowsHandler{
-			client: client,
-		},
-		checkruns: &checkrunsHandler{
-			client:      client,
-			graphClient: graphClient,
-		},
-		statuses: &statusesHandler{
-			client: client,
-		},
-		search: &searchHandler{
-			ghClient: client,
-		},
-		searchCommits: &searchCommitsHandler{
-			ghClient: client,
-		},
-		webhook: &webhookHandler{
-			ghClient: client,
-		},
-		languages: &languagesHandler{
-			ghclient: client,
-		},
-		licenses: &licensesHandler{
-			ghclient: client,
-		},
-		tarball: tarballHandler{
-			httpClient: httpClient,
-		},
-		gitMode: config.gitMode,
-		git:     &gitfile.Handler{},
-	}, nil
+	client.host = host.Name
+	client.repoClient = repoClient
+	client.graphClient = &graphqlHandler{
+		client: graphClient,
+	}
+	client.contributors = &contributorsHandler{
+		ghClient: repoClient,
+	}
+	client.branches = &branchesHandler{
+		ghClient:    repoClient,
+		graphClient: graphClient,
+	}
+	client.releases = &releasesHandler{
+		client:      repoClient,
+		graphClient: graphClient,
+	}
+	client.workflows = &workflowsHandler{
+		client: repoClient,
+	}
+	client.checkruns = &checkrunsHandler{
+		client:      repoClient,
+		graphClient: graphClient,
+	}
+	client.statuses = &statusesHandler{
+		client: repoClient,
+	}
+	client.search = &searchHandler{
+		ghClient: repoClient,
+	}
+	client.searchCommits = &searchCommitsHandler{
+		ghClient: repoClient,
+	}
+	client.webhook = &webhookHandler{
+		ghClient: repoClient,
+	}
+	client.languages = &languagesHandler{
+		ghclient: repoClient,
+	}
+	client.licenses = &licensesHandler{
+		ghclient: repoClient,
+	}
+	client.tarball = tarballHandler{
+		httpClient: client.httpClient,
+	}
+	return nil
 }
 
 // CreateGithubRepoClient returns a Client which implements RepoClient interface.
@@ -444,7 +472,7 @@ func CreateGithubRepoClient(ctx context.Context, logger *log.Logger) clients.Rep
 // Deprecated: Searching the github.com/google/oss-fuzz repo for projects is flawed. Use a constructor
 // from clients/ossfuzz instead. https://github.com/ossf/scorecard/issues/2670
 func CreateOssFuzzRepoClient(ctx context.Context, logger *log.Logger) (clients.RepoClient, error) {
-	ossFuzzRepo, err := MakeGithubRepo("google/oss-fuzz")
+	ossFuzzRepo, err := MakeGithubRepo(hosts.GitHub + "/google/oss-fuzz")
 	if err != nil {
 		return nil, fmt.Errorf("error during MakeGithubRepo: %w", err)
 	}
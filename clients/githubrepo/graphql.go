@@ -62,7 +62,14 @@ type graphqlData struct {
 								Login *string
 							}
 						}
+						// Signature is empty for unsigned commits.
 						Signature struct {
+							Typename  string `graphql:"__typename"`
+							Signature string
+							State     string
+							Signer    struct {
+								Login string
+							}
 							IsValid           bool
 							WasSignedByGitHub bool
 						}
@@ -303,11 +310,39 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 				Login: committer,
 			},
 			AssociatedMergeRequest: associatedPR,
+			Signature: signatureFrom(commit.Signature.Typename, commit.Signature.Signature,
+				commit.Signature.State, commit.Signature.Signer.Login, commit.Signature.IsValid),
 		})
 	}
 	return ret, nil
 }
 
+// signatureFrom converts a GitSignature of the GraphQL API, whose typename is
+// empty for unsigned commits.
+func signatureFrom(typename, armored, state, signer string, valid bool) *clients.Signature {
+	if typename == "" {
+		return nil
+	}
+	sig := clients.ParseSignature(armored)
+	switch typename {
+	case "GpgSignature":
+		sig.Type = clients.SignatureTypeGPG
+	case "SshSignature":
+		sig.Type = clients.SignatureTypeSSH
+	case "SmimeSignature":
+		// gitsign signatures are S/MIME signatures to GitHub.
+		if sig.Type != clients.SignatureTypeSigstore {
+			sig.Type = clients.SignatureTypeSMIME
+		}
+	}
+	if signer != "" {
+		sig.Signer = signer
+	}
+	sig.State = state
+	sig.Verified = valid && state == "VALID"
+	return sig
+}
+
 func issuesFrom(data *graphqlData) []clients.Issue {
 	var ret []clients.Issue
 	for _, issue := range data.Repository.Issues.Nodes {
@@ -18,7 +18,10 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/shurcooL/githubv4"
+
+	"github.com/ossf/scorecard/v5/clients"
 )
 
 type badGatewayRoundTripper struct {
@@ -52,3 +55,59 @@ func Test_getCommits_retry(t *testing.T) {
 		t.Errorf("wanted %d retries, got %d", want, *rt.requestCounter)
 	}
 }
+
+func Test_signatureFrom(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want     *clients.Signature
+		name     string
+		typename string
+		state    string
+		signer   string
+		valid    bool
+	}{
+		{
+			name: "unsigned",
+		},
+		{
+			name:     "verified gpg",
+			typename: "GpgSignature",
+			state:    "VALID",
+			signer:   "alice",
+			valid:    true,
+			want: &clients.Signature{
+				Type:     clients.SignatureTypeGPG,
+				Signer:   "alice",
+				State:    "VALID",
+				Verified: true,
+			},
+		},
+		{
+			name:     "ssh key unknown to GitHub",
+			typename: "SshSignature",
+			state:    "UNKNOWN_KEY",
+			want: &clients.Signature{
+				Type:  clients.SignatureTypeSSH,
+				State: "UNKNOWN_KEY",
+			},
+		},
+		{
+			name:     "unknown signature",
+			typename: "UnknownSignature",
+			state:    "UNKNOWN_SIG_TYPE",
+			want: &clients.Signature{
+				Type:  clients.SignatureTypeUnknown,
+				State: "UNKNOWN_SIG_TYPE",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := signatureFrom(tt.typename, "", tt.state, tt.signer, tt.valid)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("signatureFrom() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hosts resolves the API endpoints of GitHub instances, github.com
+// and GitHub Enterprise Server (GHES) hosts.
+package hosts
+
+import (
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+)
+
+// GitHub is the host of github.com.
+const GitHub = "github.com"
+
+const (
+	// envHost is the host of repos given as "owner/repo", which is a GHES host
+	// unless it is github.com.
+	envHost = "GH_HOST"
+	// envEnterpriseHosts lists more GHES hosts separated by commas, each as
+	// "host[;api=URL][;graphql=URL][;uploads=URL]".
+	envEnterpriseHosts = "GH_ENTERPRISE_HOSTS"
+)
+
+// Host is a GitHub instance and the base URLs of its APIs.
+type Host struct {
+	// Name is the host of the web UI and of repo URLs, e.g. github.corp.com.
+	Name       string
+	APIURL     string
+	GraphQLURL string
+	UploadURL  string
+}
+
+// IsEnterprise reports whether h is a GHES host.
+func (h *Host) IsEnterprise() bool {
+	return h.Name != GitHub
+}
+
+func gitHub() Host {
+	return Host{
+		Name:       GitHub,
+		APIURL:     "https://api.github.com/",
+		GraphQLURL: "https://api.github.com/graphql",
+		UploadURL:  "https://uploads.github.com/",
+	}
+}
+
+// enterprise returns a GHES host with the default endpoints of GHES 3.x.
+func enterprise(name string) Host {
+	return Host{
+		Name:       name,
+		APIURL:     "https://" + name + "/api/v3/",
+		GraphQLURL: "https://" + name + "/api/graphql",
+		UploadURL:  "https://" + name + "/api/uploads/",
+	}
+}
+
+// Default returns the host of repos given without one, which is GH_HOST if
+// set, otherwise github.com.
+func Default() Host {
+	if h, ok := Lookup(strings.TrimSpace(os.Getenv(envHost))); ok {
+		return h
+	}
+	return gitHub()
+}
+
+// Lookup returns github.com or the configured GHES host with the given name.
+func Lookup(name string) (Host, bool) {
+	if strings.EqualFold(name, GitHub) {
+		return gitHub(), true
+	}
+	for _, h := range Enterprise() {
+		if strings.EqualFold(h.Name, name) {
+			return h, true
+		}
+	}
+	return Host{}, false
+}
+
+// Match returns the GHES host serving requests to host, which is the host of
+// one of its API URLs.
+func Match(host string) (Host, bool) {
+	for _, h := range Enterprise() {
+		for _, u := range []string{h.APIURL, h.GraphQLURL, h.UploadURL} {
+			if parsed, err := url.Parse(u); err == nil && strings.EqualFold(parsed.Host, host) {
+				return h, true
+			}
+		}
+	}
+	return Host{}, false
+}
+
+// Enterprise returns the configured GHES hosts. Entries of
+// GH_ENTERPRISE_HOSTS with an invalid URL are skipped.
+func Enterprise() []Host {
+	var ret []Host
+	for _, entry := range strings.Split(os.Getenv(envEnterpriseHosts), ",") {
+		if h, ok := parseEntry(entry); ok {
+			ret = append(ret, h)
+		}
+	}
+	// GH_HOST uses the default endpoints unless it is listed as well.
+	name := strings.TrimSpace(os.Getenv(envHost))
+	if name != "" && !strings.EqualFold(name, GitHub) &&
+		!slices.ContainsFunc(ret, func(h Host) bool { return strings.EqualFold(h.Name, name) }) {
+		ret = append(ret, enterprise(name))
+	}
+	return ret
+}
+
+// parseEntry parses a GH_ENTERPRISE_HOSTS entry, e.g.
+// "github.corp.com;api=https://api.github.corp.com/".
+func parseEntry(entry string) (Host, bool) {
+	fields := strings.Split(strings.TrimSpace(entry), ";")
+	name := strings.TrimSpace(fields[0])
+	if name == "" || strings.EqualFold(name, GitHub) || strings.Contains(name, "/") {
+		return Host{}, false
+	}
+	h := enterprise(name)
+	for _, f := range fields[1:] {
+		key, value, _ := strings.Cut(strings.TrimSpace(f), "=")
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return Host{}, false
+		}
+		switch key {
+		case "api":
+			h.APIURL = value
+		case "graphql":
+			h.GraphQLURL = value
+		case "uploads":
+			h.UploadURL = value
+		default:
+			return Host{}, false
+		}
+	}
+	return h, true
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hosts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEnterprise(t *testing.T) {
+	tests := []struct {
+		name            string
+		ghHost          string
+		enterpriseHosts string
+		want            []Host
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:   "GH_HOST is github.com",
+			ghHost: "github.com",
+		},
+		{
+			name:   "GH_HOST",
+			ghHost: "github.corp.com",
+			want: []Host{
+				{
+					Name:       "github.corp.com",
+					APIURL:     "https://github.corp.com/api/v3/",
+					GraphQLURL: "https://github.corp.com/api/graphql",
+					UploadURL:  "https://github.corp.com/api/uploads/",
+				},
+			},
+		},
+		{
+			name:            "GH_HOST with its endpoints",
+			ghHost:          "github.corp.com",
+			enterpriseHosts: "github.corp.com;api=https://api.corp.com/;graphql=https://api.corp.com/graphql",
+			want: []Host{
+				{
+					Name:       "github.corp.com",
+					APIURL:     "https://api.corp.com/",
+					GraphQLURL: "https://api.corp.com/graphql",
+					UploadURL:  "https://github.corp.com/api/uploads/",
+				},
+			},
+		},
+		{
+			name:            "several hosts",
+			ghHost:          "github.corp.com",
+			enterpriseHosts: "ghe.example.com;uploads=https://uploads.example.com/, github.com,ghe.invalid;api=api/v3,,",
+			want: []Host{
+				{
+					Name:       "ghe.example.com",
+					APIURL:     "https://ghe.example.com/api/v3/",
+					GraphQLURL: "https://ghe.example.com/api/graphql",
+					UploadURL:  "https://uploads.example.com/",
+				},
+				{
+					Name:       "github.corp.com",
+					APIURL:     "https://github.corp.com/api/v3/",
+					GraphQLURL: "https://github.corp.com/api/graphql",
+					UploadURL:  "https://github.corp.com/api/uploads/",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envHost, tt.ghHost)
+			t.Setenv(envEnterpriseHosts, tt.enterpriseHosts)
+			got := Enterprise()
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Setenv(envHost, "github.corp.com")
+	t.Setenv(envEnterpriseHosts, "ghe.example.com;api=https://api.example.com/")
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "github.corp.com", want: "github.corp.com"},
+		{host: "codeload.github.corp.com"},
+		{host: "GHE.example.com", want: "ghe.example.com"},
+		{host: "api.example.com", want: "ghe.example.com"},
+		{host: "uploads.api.example.com"},
+		{host: "example.com"},
+		{host: "api.github.com"},
+		{host: "github.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			h, ok := Match(tt.host)
+			if ok != (tt.want != "") || h.Name != tt.want {
+				t.Errorf("Match(%q) = %q, %v, want %q", tt.host, h.Name, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	t.Setenv(envHost, "")
+	if got := Default(); got.Name != GitHub || got.IsEnterprise() {
+		t.Errorf("Default() = %v, want github.com", got)
+	}
+	t.Setenv(envHost, "github.corp.com")
+	if got := Default(); got.Name != "github.corp.com" || !got.IsEnterprise() {
+		t.Errorf("Default() = %v, want github.corp.com", got)
+	}
+}
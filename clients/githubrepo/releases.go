@@ -21,23 +21,62 @@ import (
 	"sync"
 
 	"github.com/google/go-github/v82/github"
+	"github.com/shurcooL/githubv4"
 
 	"github.com/ossf/scorecard/v5/clients"
 	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/log"
 )
 
+const tagRefPrefix = "refs/tags/"
+
+type tagRef struct {
+	Name   string
+	Target struct {
+		Typename string `graphql:"__typename"`
+		Tag      struct {
+			// Signature is empty for unsigned tags.
+			Signature struct {
+				Typename  string `graphql:"__typename"`
+				Signature string
+				State     string
+				Signer    struct {
+					Login string
+				}
+				IsValid bool
+			}
+		} `graphql:"... on Tag"`
+	}
+}
+
+// tagsData holds the most recent tags, which are matched to the tags of releases,
+// and the rulesets which may protect them.
+type tagsData struct {
+	Repository struct {
+		Refs struct {
+			Nodes []tagRef
+		} `graphql:"refs(refPrefix: $tagRefPrefix, first: 100, orderBy: {field: TAG_COMMIT_DATE, direction: DESC})"`
+		Rulesets struct {
+			Nodes []*repoRuleSet
+		} `graphql:"rulesets(first: 100, includeParents: true)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
 type releasesHandler struct {
-	client   *github.Client
-	once     *sync.Once
-	ctx      context.Context
-	errSetup error
-	repourl  *Repo
-	releases []clients.Release
+	client      *github.Client
+	graphClient *githubv4.Client
+	once        *sync.Once
+	ctx         context.Context
+	errSetup    error
+	repourl     *Repo
+	logger      *log.Logger
+	releases    []clients.Release
 }
 
 func (handler *releasesHandler) init(ctx context.Context, repourl *Repo) {
 	handler.ctx = ctx
 	handler.repourl = repourl
+	handler.logger = log.NewLogger(log.DefaultLevel)
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
 	handler.releases = nil
@@ -53,12 +92,71 @@ func (handler *releasesHandler) setup() error {
 			handler.ctx, handler.repourl.owner, handler.repourl.repo, &github.ListOptions{})
 		if err != nil {
 			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
+			return
 		}
 		handler.releases = releasesFrom(releases)
+		if len(handler.releases) == 0 {
+			return
+		}
+		// tags are optional, releases are listed without them if they can't be queried.
+		if err := handler.addTags(releases); err != nil {
+			handler.logger.Info(fmt.Sprintf("release tags of %s/%s: %v", handler.repourl.owner, handler.repourl.repo, err))
+		}
 	})
 	return handler.errSetup
 }
 
+// addTags sets the tag of each release whose tag is among the most recent ones,
+// or of none on errors.
+func (handler *releasesHandler) addTags(releases []*github.RepositoryRelease) error {
+	data := new(tagsData)
+	vars := map[string]interface{}{
+		"owner":        githubv4.String(handler.repourl.owner),
+		"name":         githubv4.String(handler.repourl.repo),
+		"tagRefPrefix": githubv4.String(tagRefPrefix),
+	}
+	if err := handler.graphClient.Query(handler.ctx, data, vars); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
+	}
+	tags := make(map[string]tagRef, len(data.Repository.Refs.Nodes))
+	for _, t := range data.Repository.Refs.Nodes {
+		tags[t.Name] = t
+	}
+	ruleSets := getActiveRuleSetsFrom(data.Repository.Rulesets.Nodes)
+
+	releaseTags := make([]*clients.Tag, len(handler.releases))
+	for i := range handler.releases {
+		ref, ok := tags[handler.releases[i].TagName]
+		if !ok {
+			continue
+		}
+		protected, err := tagProtected(ruleSets, ref.Name)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("rulesMatchingRef: %v", err))
+		}
+		// the tag of an immutable release can't be moved or deleted.
+		protected = protected || releases[i].GetImmutable()
+		releaseTags[i] = tagFrom(&ref, protected)
+	}
+	for i, tag := range releaseTags {
+		handler.releases[i].Tag = tag
+	}
+	return nil
+}
+
+// tagFrom converts a tag ref, whose target is a Tag for annotated tags.
+func tagFrom(ref *tagRef, protected bool) *clients.Tag {
+	tag := &clients.Tag{
+		Annotated: ref.Target.Typename == "Tag",
+		Protected: &protected,
+	}
+	if tag.Annotated {
+		sig := &ref.Target.Tag.Signature
+		tag.Signature = signatureFrom(sig.Typename, sig.Signature, sig.State, sig.Signer.Login, sig.IsValid)
+	}
+	return tag
+}
+
 func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
 	if err := handler.setup(); err != nil {
 		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
@@ -84,3 +182,23 @@ func releasesFrom(data []*github.RepositoryRelease) []clients.Release {
 	}
 	return releases
 }
+
+// tagProtected reports whether active tag rulesets prevent both deleting and moving a tag.
+func tagProtected(rules []*repoRuleSet, name string) (bool, error) {
+	matching, err := rulesMatchingRef(rules, ruleTargetTag, tagRefPrefix+name, false)
+	if err != nil {
+		return false, err
+	}
+	var noDeletion, noUpdate bool
+	for _, ruleSet := range matching {
+		for _, rule := range ruleSet.Rules.Nodes {
+			switch rule.Type {
+			case ruleDeletion:
+				noDeletion = true
+			case ruleUpdate, ruleForcePush:
+				noUpdate = true
+			}
+		}
+	}
+	return noDeletion && noUpdate, nil
+}
@@ -38,8 +38,9 @@ var _ = Describe("E2E TEST: githubrepo.releasesHandler", func() {
 		}
 		client := github.NewClient(httpClient)
 		releaseHandler = &releasesHandler{
-			client: client,
-			ctx:    ctx,
+			client:      client,
+			graphClient: graphClient,
+			ctx:         ctx,
 		}
 	})
 	Context("getReleases()", func() {
@@ -0,0 +1,175 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v82/github"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func tagRuleSet(target string, include []string, ruleTypes ...string) *repoRuleSet {
+	ruleSet := &repoRuleSet{
+		Target: &target,
+		Conditions: ruleSetCondition{
+			RefName: ruleSetConditionRefs{Include: include},
+		},
+	}
+	for _, t := range ruleTypes {
+		ruleSet.Rules.Nodes = append(ruleSet.Rules.Nodes, &repoRule{Type: t})
+	}
+	return ruleSet
+}
+
+func Test_tagProtected(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		tag      string
+		rules    []*repoRuleSet
+		expected bool
+	}{
+		{
+			name: "no rulesets",
+			tag:  "v1.0.0",
+		},
+		{
+			name: "deletion and update restricted",
+			tag:  "v1.0.0",
+			rules: []*repoRuleSet{
+				tagRuleSet(ruleTargetTag, []string{"refs/tags/v*"}, ruleDeletion, ruleUpdate),
+			},
+			expected: true,
+		},
+		{
+			name: "deletion and force pushes restricted by separate rulesets",
+			tag:  "v1.0.0",
+			rules: []*repoRuleSet{
+				tagRuleSet(ruleTargetTag, []string{ruleConditionAllBranches}, ruleDeletion),
+				tagRuleSet(ruleTargetTag, []string{"refs/tags/v1.*"}, ruleForcePush),
+			},
+			expected: true,
+		},
+		{
+			name: "only deletion restricted",
+			tag:  "v1.0.0",
+			rules: []*repoRuleSet{
+				tagRuleSet(ruleTargetTag, []string{"refs/tags/v*"}, ruleDeletion),
+			},
+		},
+		{
+			name: "ruleset for other tags",
+			tag:  "release-1",
+			rules: []*repoRuleSet{
+				tagRuleSet(ruleTargetTag, []string{"refs/tags/v*"}, ruleDeletion, ruleUpdate),
+			},
+		},
+		{
+			name: "branch ruleset",
+			tag:  "v1.0.0",
+			rules: []*repoRuleSet{
+				tagRuleSet(ruleTargetBranch, []string{ruleConditionAllBranches}, ruleDeletion, ruleUpdate),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tagProtected(tt.rules, tt.tag)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("tagProtected() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_tagFrom(t *testing.T) {
+	t.Parallel()
+	lightweight := tagRef{Name: "v1.0.0"}
+	lightweight.Target.Typename = "Commit"
+	unsigned := tagRef{Name: "v1.0.0"}
+	unsigned.Target.Typename = "Tag"
+	signed := unsigned
+	signed.Target.Tag.Signature.Typename = "GpgSignature"
+	signed.Target.Tag.Signature.State = "VALID"
+	signed.Target.Tag.Signature.Signer.Login = "octocat"
+	signed.Target.Tag.Signature.IsValid = true
+	unknownKey := signed
+	unknownKey.Target.Tag.Signature.State = "UNKNOWN_KEY"
+	unknownKey.Target.Tag.Signature.IsValid = false
+	tests := []struct {
+		expected  *clients.Tag
+		name      string
+		ref       tagRef
+		protected bool
+	}{
+		{
+			name:      "lightweight",
+			ref:       lightweight,
+			protected: true,
+			expected:  &clients.Tag{Protected: github.Ptr(true)},
+		},
+		{
+			name: "unsigned",
+			ref:  unsigned,
+			expected: &clients.Tag{
+				Annotated: true,
+				Protected: github.Ptr(false),
+			},
+		},
+		{
+			name: "verified",
+			ref:  signed,
+			expected: &clients.Tag{
+				Annotated: true,
+				Protected: github.Ptr(false),
+				Signature: &clients.Signature{
+					Type:     clients.SignatureTypeGPG,
+					Signer:   "octocat",
+					State:    "VALID",
+					Verified: true,
+				},
+			},
+		},
+		{
+			name: "unknown key",
+			ref:  unknownKey,
+			expected: &clients.Tag{
+				Annotated: true,
+				Protected: github.Ptr(false),
+				Signature: &clients.Signature{
+					Type:   clients.SignatureTypeGPG,
+					Signer: "octocat",
+					State:  "UNKNOWN_KEY",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tagFrom(&tt.ref, tt.protected)
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,10 +17,10 @@ package githubrepo
 import (
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/githubrepo/hosts"
 	sce "github.com/ossf/scorecard/v5/errors"
 )
 
@@ -30,7 +30,8 @@ type Repo struct {
 }
 
 // Parses input string into repoURL struct.
-// Accepts "owner/repo" or "github.com/owner/repo".
+// Accepts "owner/repo", "github.com/owner/repo" or the URL of a repo on a
+// GitHub Enterprise Server host.
 func (r *Repo) parse(input string) error {
 	var t string
 
@@ -41,13 +42,9 @@ func (r *Repo) parse(input string) error {
 
 	switch l := len(c); {
 	// This will takes care for repo/owner format.
-	// By default it will use github.com
+	// By default it will use github.com, or GH_HOST if set.
 	case l == two:
-		githubHost, isGhHost := os.LookupEnv("GH_HOST")
-		if !isGhHost {
-			githubHost = "github.com"
-		}
-		t = githubHost + "/" + c[0] + "/" + c[1]
+		t = hosts.Default().Name + "/" + c[0] + "/" + c[1]
 	case l >= three:
 		t = input
 	}
@@ -88,11 +85,7 @@ func (r *Repo) String() string {
 
 // IsValid implements Repo.IsValid.
 func (r *Repo) IsValid() error {
-	githubHost := os.Getenv("GH_HOST")
-	switch r.host {
-	case "github.com":
-	case githubHost:
-	default:
+	if _, ok := hosts.Lookup(r.host); !ok {
 		return sce.WithMessage(sce.ErrUnsupportedHost, r.host)
 	}
 
@@ -126,7 +119,9 @@ func (r *Repo) commitExpression() string {
 }
 
 // MakeGithubRepo takes input of form "owner/repo" or "github.com/owner/repo"
-// and returns an implementation of clients.Repo interface.
+// and returns an implementation of clients.Repo interface. Repos on GitHub
+// Enterprise Server are accepted for the hosts set in GH_HOST or
+// GH_ENTERPRISE_HOSTS.
 func MakeGithubRepo(input string) (clients.Repo, error) {
 	var repo Repo
 	if err := repo.parse(input); err != nil {
@@ -22,11 +22,12 @@ import (
 
 func TestRepoURL_IsValid(t *testing.T) {
 	tests := []struct {
-		name     string
-		inputURL string
-		expected Repo
-		wantErr  bool
-		ghHost   bool
+		name            string
+		inputURL        string
+		enterpriseHosts string
+		expected        Repo
+		wantErr         bool
+		ghHost          bool
 	}{
 		{
 			name: "Valid http address",
@@ -100,12 +101,36 @@ func TestRepoURL_IsValid(t *testing.T) {
 			wantErr:  false,
 			ghHost:   true,
 		},
+		{
+			name: "Enterprise github repository of another host",
+			expected: Repo{
+				host:  "ghe.example.com",
+				owner: "corpfoo",
+				repo:  "kubeflow",
+			},
+			inputURL:        "https://ghe.example.com/corpfoo/kubeflow",
+			enterpriseHosts: "ghe.example.com;api=https://api.example.com/",
+			wantErr:         false,
+			ghHost:          true,
+		},
+		{
+			name: "Unknown enterprise github repository",
+			expected: Repo{
+				host:  "ghe.example.com",
+				owner: "corpfoo",
+				repo:  "kubeflow",
+			},
+			inputURL: "https://ghe.example.com/corpfoo/kubeflow",
+			wantErr:  true,
+			ghHost:   true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.ghHost {
 				t.Setenv("GH_HOST", "github.corp.com")
 			}
+			t.Setenv("GH_ENTERPRISE_HOSTS", tt.enterpriseHosts)
 
 			r := Repo{
 				host:  tt.expected.host,
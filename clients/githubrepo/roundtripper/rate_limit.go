@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"go.opencensus.io/stats"
@@ -37,13 +38,36 @@ func MakeRateLimitedTransport(innerTransport http.RoundTripper, logger *log.Logg
 }
 
 // rateLimitTransport is a rate-limit aware http.Transport for GitHub.
+// Once a request hits a rate limit, concurrent requests sharing the transport
+// also wait for it to reset instead of spending their own request on it.
 type rateLimitTransport struct {
+	resumeAt       time.Time
 	logger         *log.Logger
 	innerTransport http.RoundTripper
+	mu             sync.Mutex
+}
+
+// pauseUntil holds all requests of the transport until t.
+func (gh *rateLimitTransport) pauseUntil(t time.Time) {
+	gh.mu.Lock()
+	defer gh.mu.Unlock()
+	if t.After(gh.resumeAt) {
+		gh.resumeAt = t
+	}
+}
+
+func (gh *rateLimitTransport) waitForReset() {
+	gh.mu.Lock()
+	duration := time.Until(gh.resumeAt)
+	gh.mu.Unlock()
+	if duration > 0 {
+		time.Sleep(duration)
+	}
 }
 
 // RoundTrip handles caching and rate-limiting of responses from GitHub.
 func (gh *rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	gh.waitForReset()
 	resp, err := gh.innerTransport.RoundTrip(r)
 	if err != nil {
 		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("innerTransport.RoundTrip: %v", err))
@@ -54,7 +78,8 @@ func (gh *rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		stats.Record(r.Context(), githubstats.RetryAfter.M(int64(retryAfter)))
 		duration := time.Duration(retryAfter) * time.Second
 		gh.logger.Info(fmt.Sprintf("Retry-After header set. Waiting %s to retry...", duration))
-		time.Sleep(duration)
+		gh.pauseUntil(time.Now().Add(duration))
+		gh.waitForReset()
 		gh.logger.Info("Retry-After header set. Retrying...")
 		return gh.RoundTrip(r)
 	}
@@ -83,7 +108,8 @@ func (gh *rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		gh.logger.Info(fmt.Sprintf("Rate limit exceeded. Waiting %s to retry...", duration))
 
 		// Retry
-		time.Sleep(duration)
+		gh.pauseUntil(time.Unix(int64(reset), 0))
+		gh.waitForReset()
 		// TODO(log): Previously Warn. Consider logging an error here.
 		gh.logger.Info("Rate limit exceeded. Retrying...")
 		return gh.RoundTrip(r)
@@ -21,10 +21,13 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 
+	"github.com/ossf/scorecard/v5/clients/githubrepo/hosts"
 	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper/tokens"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
 	"github.com/ossf/scorecard/v5/log"
 )
 
@@ -39,9 +42,31 @@ const (
 
 var errGithubCredentials = errors.New("an error occurred while getting GitHub credentials")
 
+// Option configures the transport returned by [NewTransport].
+type Option func(*transportConfig)
+
+type transportConfig struct {
+	cacheDir string
+}
+
+// WithCacheDir caches responses on disk in dir, revalidating them with
+// conditional requests which don't count against GitHub rate limits.
+func WithCacheDir(dir string) Option {
+	return func(c *transportConfig) {
+		c.cacheDir = dir
+	}
+}
+
 // NewTransport returns a configured http.Transport for use with GitHub.
-func NewTransport(ctx context.Context, logger *log.Logger) http.RoundTripper {
+func NewTransport(ctx context.Context, logger *log.Logger, opts ...Option) http.RoundTripper {
+	var config transportConfig
+	for _, option := range opts {
+		option(&config)
+	}
 	transport := http.DefaultTransport
+	enterpriseTokens := makeEnterpriseTokenAccessors()
+	// an app installed on the default GHES host authenticates its requests.
+	var enterpriseApp http.RoundTripper
 
 	//nolint:nestif
 	if tokenAccessor := tokens.MakeTokenAccessor(); tokenAccessor != nil {
@@ -56,15 +81,51 @@ func NewTransport(ctx context.Context, logger *log.Logger) http.RoundTripper {
 		if err != nil {
 			logger.Error(err, "getting GitHub application installation ID")
 		}
-		transport, err = ghinstallation.NewKeyFromFile(transport, int64(appID), int64(installationID), keyPath)
+		appTransport, err := ghinstallation.NewKeyFromFile(transport, int64(appID), int64(installationID), keyPath)
 		if err != nil {
 			logger.Error(err, "getting a private key from file")
+		} else {
+			if h := hosts.Default(); h.IsEnterprise() {
+				appTransport.BaseURL = strings.TrimSuffix(h.APIURL, "/")
+				enterpriseApp = appTransport
+			} else {
+				transport = appTransport
+			}
 		}
-	} else {
+	} else if len(enterpriseTokens) == 0 {
 		// TODO(log): Improve error message
 		//nolint:lll
 		logger.Error(errGithubCredentials, "GitHub token env var is not set. Please read https://github.com/ossf/scorecard#authentication")
 	}
 
-	return MakeCensusTransport(MakeRateLimitedTransport(transport, logger))
+	if len(hosts.Enterprise()) > 0 {
+		et := makeEnterpriseTransport(transport, http.DefaultTransport, enterpriseTokens)
+		if _, ok := et.hosts[hosts.Default().Name]; !ok && enterpriseApp != nil {
+			et.hosts[hosts.Default().Name] = enterpriseApp
+		}
+		transport = et
+	}
+
+	transport = MakeCensusTransport(MakeRateLimitedTransport(transport, logger))
+	if config.cacheDir != "" {
+		cached, err := httpcache.NewTransport(transport, config.cacheDir)
+		if err != nil {
+			logger.Error(err, "disabling response cache")
+			return transport
+		}
+		transport = cached
+	}
+	return transport
+}
+
+// makeEnterpriseTokenAccessors returns the token accessors of the GitHub
+// Enterprise Server hosts with a token, by host name.
+func makeEnterpriseTokenAccessors() map[string]tokens.TokenAccessor {
+	accessors := map[string]tokens.TokenAccessor{}
+	for _, h := range hosts.Enterprise() {
+		if accessor := tokens.MakeEnterpriseTokenAccessor(h.Name); accessor != nil {
+			accessors[h.Name] = accessor
+		}
+	}
+	return accessors
 }
@@ -27,6 +27,14 @@ const githubAuthServer = "GITHUB_AUTH_SERVER"
 // env variables from which GitHub auth tokens are read, in order of precedence.
 var githubAuthTokenEnvVars = []string{"GITHUB_AUTH_TOKEN", "GITHUB_TOKEN", "GH_TOKEN", "GH_AUTH_TOKEN"}
 
+// enterpriseTokenEnvVarPrefix is followed by the host of a GitHub Enterprise
+// Server, e.g. GH_ENTERPRISE_TOKEN_GITHUB_CORP_COM for github.corp.com.
+const enterpriseTokenEnvVarPrefix = "GH_ENTERPRISE_TOKEN_"
+
+// env variables from which the tokens of every GitHub Enterprise Server host
+// are read, in order of precedence, following the conventions of the gh CLI.
+var enterpriseTokenEnvVars = []string{"GH_ENTERPRISE_TOKEN", "GITHUB_ENTERPRISE_TOKEN"}
+
 // TokenAccessor interface defines a `retrieve-once` data structure.
 // Implementations of this interface must be thread-safe.
 type TokenAccessor interface {
@@ -45,8 +53,12 @@ var logDuplicateTokenWarning = func(firstName string, clashingName string) {
 }
 
 func readGitHubTokens() (string, bool) {
+	return readTokens(githubAuthTokenEnvVars)
+}
+
+func readTokens(envVars []string) (string, bool) {
 	var firstName, firstToken string
-	for _, name := range githubAuthTokenEnvVars {
+	for _, name := range envVars {
 		if token, exists := os.LookupEnv(name); exists && token != "" {
 			if firstName == "" {
 				firstName = name
@@ -73,3 +85,25 @@ func MakeTokenAccessor() TokenAccessor {
 	}
 	return nil
 }
+
+// MakeEnterpriseTokenAccessor returns a TokenAccessor for the GitHub Enterprise
+// Server at host, or nil if no token is set for it. The tokens are read from
+// GH_ENTERPRISE_TOKEN_<HOST>, with the host in upper case and other characters
+// than letters and digits replaced by underscores, then GH_ENTERPRISE_TOKEN
+// and GITHUB_ENTERPRISE_TOKEN.
+func MakeEnterpriseTokenAccessor(host string) TokenAccessor {
+	if value, exists := readTokens(append([]string{enterpriseTokenEnvVar(host)}, enterpriseTokenEnvVars...)); exists {
+		return makeRoundRobinAccessor(strings.Split(value, ","))
+	}
+	return nil
+}
+
+func enterpriseTokenEnvVar(host string) string {
+	name := strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, host)
+	return enterpriseTokenEnvVarPrefix + strings.ToUpper(name)
+}
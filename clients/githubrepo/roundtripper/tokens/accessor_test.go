@@ -19,6 +19,8 @@ import (
 	"os"
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 //nolint:paralleltest // test uses t.Setenv indirectly
@@ -169,11 +171,61 @@ func TestNoTokensDoNoDisplayWarning(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // test uses t.Setenv
+func TestMakeEnterpriseTokenAccessor(t *testing.T) {
+	tests := []struct {
+		env  map[string]string
+		name string
+		want []string
+	}{
+		{
+			name: "no token",
+			env:  map[string]string{"GITHUB_AUTH_TOKEN": "github"},
+		},
+		{
+			name: "token of all hosts",
+			env:  map[string]string{"GH_ENTERPRISE_TOKEN": "ghe1,ghe2", "GITHUB_ENTERPRISE_TOKEN": "ghe1,ghe2"},
+			want: []string{"ghe1", "ghe2"},
+		},
+		{
+			name: "token of the host",
+			env: map[string]string{
+				"GH_ENTERPRISE_TOKEN_GITHUB_CORP_COM_8443": "corp",
+				"GH_ENTERPRISE_TOKEN":                      "ghe",
+				"GH_ENTERPRISE_TOKEN_GHE_EXAMPLE_COM":      "example",
+			},
+			want: []string{"corp"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetTokens(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			got := MakeEnterpriseTokenAccessor("github.corp.com:8443")
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("MakeEnterpriseTokenAccessor() = %v, want nil", got)
+				}
+				return
+			}
+			raccess, ok := got.(*roundRobinAccessor)
+			if !ok {
+				t.Fatalf("MakeEnterpriseTokenAccessor() = %v, want *roundRobinAccessor", got)
+			}
+			if diff := cmp.Diff(tt.want, raccess.accessTokens); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // temporarily unset all of the github token env vars,
 // as tests may otherwise fail depending on the local environment.
 func unsetTokens(t *testing.T) {
 	t.Helper()
-	for _, name := range githubAuthTokenEnvVars {
+	for _, name := range append(githubAuthTokenEnvVars, enterpriseTokenEnvVars...) {
 		// equivalent to t.Unsetenv (which does not exist)
 		t.Setenv(name, "")
 		os.Unsetenv(name)
@@ -17,9 +17,11 @@ package roundtripper
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"go.opencensus.io/tag"
 
+	"github.com/ossf/scorecard/v5/clients/githubrepo/hosts"
 	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper/tokens"
 	githubstats "github.com/ossf/scorecard/v5/clients/githubrepo/stats"
 )
@@ -56,3 +58,51 @@ func (gt *githubTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 
 	return resp, nil
 }
+
+// makeEnterpriseTransport authenticates requests to GitHub Enterprise Server
+// hosts with their own tokens, by host name, instead of those of github.com.
+// Requests to github.com are sent with defaultTransport, and all others
+// unauthenticated with innerTransport.
+func makeEnterpriseTransport(defaultTransport, innerTransport http.RoundTripper,
+	accessors map[string]tokens.TokenAccessor,
+) *enterpriseTransport {
+	transports := make(map[string]http.RoundTripper, len(accessors))
+	for host, accessor := range accessors {
+		transports[host] = makeGitHubTransport(innerTransport, accessor)
+	}
+	return &enterpriseTransport{
+		defaultTransport: defaultTransport,
+		innerTransport:   innerTransport,
+		hosts:            transports,
+	}
+}
+
+type enterpriseTransport struct {
+	defaultTransport http.RoundTripper
+	innerTransport   http.RoundTripper
+	hosts            map[string]http.RoundTripper
+}
+
+func (et *enterpriseTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if h, ok := hosts.Match(r.URL.Host); ok {
+		if transport, ok := et.hosts[h.Name]; ok {
+			return transport.RoundTrip(r) //nolint:wrapcheck // errors are wrapped by githubTransport
+		}
+	} else if isGitHubHost(r.URL.Hostname()) {
+		return et.defaultTransport.RoundTrip(r) //nolint:wrapcheck // errors are wrapped by the default transport
+	}
+	// the tokens of github.com are never sent to other hosts.
+	resp, err := et.innerTransport.RoundTrip(r)
+	if err != nil {
+		return nil, fmt.Errorf("error in HTTP: %w", err)
+	}
+	return resp, nil
+}
+
+// isGitHubHost reports whether host serves github.com, including its API and
+// the downloads it redirects to.
+func isGitHubHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == hosts.GitHub || strings.HasSuffix(host, "."+hosts.GitHub) ||
+		strings.HasSuffix(host, ".githubusercontent.com")
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roundtripper
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper/tokens"
+)
+
+type recordingTransport struct {
+	authorization map[string]string
+}
+
+func (rt *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.authorization[r.URL.Host] = r.Header.Get("Authorization")
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+}
+
+//nolint:paralleltest // test uses t.Setenv
+func TestEnterpriseTransport(t *testing.T) {
+	t.Setenv("GH_HOST", "github.corp.com")
+	t.Setenv("GH_ENTERPRISE_HOSTS", "ghe.example.com;api=https://api.example.com/")
+	t.Setenv("GITHUB_AUTH_TOKEN", "github")
+	t.Setenv("GH_ENTERPRISE_TOKEN_GHE_EXAMPLE_COM", "example")
+
+	inner := &recordingTransport{authorization: map[string]string{}}
+	transport := makeEnterpriseTransport(
+		makeGitHubTransport(inner, tokens.MakeTokenAccessor()), inner, makeEnterpriseTokenAccessors())
+
+	want := map[string]string{
+		"api.github.com":  "Bearer github",
+		"api.example.com": "Bearer example",
+		"ghe.example.com": "Bearer example",
+		// subdomains are not API hosts.
+		"codeload.ghe.example.com": "",
+		"example.org":              "",
+		"codeload.github.com":      "Bearer github",
+		// without a token of its own, GH_HOST is sent requests unauthenticated.
+		"github.corp.com": "",
+	}
+	for host, auth := range want {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://"+host+"/", nil)
+		if err != nil {
+			t.Fatalf("http.NewRequestWithContext: %v", err)
+		}
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip: %v", err)
+		}
+		resp.Body.Close()
+		if got := inner.authorization[host]; got != auth {
+			t.Errorf("Authorization for %s = %q, want %q", host, got, auth)
+		}
+	}
+}
@@ -71,6 +71,7 @@ func checkRunsFrom(data []*gitlab.PipelineInfo) []clients.CheckRun {
 func parseGitlabStatus(info *gitlab.PipelineInfo) clients.CheckRun {
 	checkrun := clients.CheckRun{
 		URL: info.WebURL,
+		App: clients.CheckRunApp{Slug: clients.GitLabCISlug},
 	}
 	const completed = "completed"
 
@@ -41,6 +41,7 @@ func Test_CheckRuns(t *testing.T) {
 				{
 					Status:     "queued",
 					URL:        "https://example.com/foo/bar/pipelines/48",
+					App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 					Conclusion: "",
 				},
 			},
@@ -103,6 +104,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "queued",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -111,6 +113,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "queued",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -119,6 +122,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "queued",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -127,6 +131,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "queued",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -135,6 +140,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "queued",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -143,6 +149,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "in_progress",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -151,6 +158,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "completed",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "failure",
 			},
 		},
@@ -159,6 +167,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "completed",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "success",
 			},
 		},
@@ -167,6 +176,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "completed",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "cancelled",
 			},
 		},
@@ -175,6 +185,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "completed",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "skipped",
 			},
 		},
@@ -183,6 +194,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "completed",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "action_required",
 			},
 		},
@@ -191,6 +203,7 @@ func TestParseGitlabStatus(t *testing.T) {
 			want: clients.CheckRun{
 				Status:     "invalid_status",
 				URL:        "https://example.com/foo/bar/pipelines/48",
+				App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
 				Conclusion: "",
 			},
 		},
@@ -0,0 +1,104 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// CISettings are the CI/CD settings of a project.
+type CISettings struct {
+	// URL is the page of the settings.
+	URL string
+	// ExposedVariables are the CI/CD variables which pipelines for unprotected
+	// refs can read, e.g. those of merge requests.
+	ExposedVariables []string
+	// ForkPipelines is whether members can run pipelines for merge requests
+	// from forks in the project.
+	ForkPipelines bool
+	// JobTokenAllowlist is whether only the CI/CD job tokens of allowlisted
+	// projects can access the project.
+	JobTokenAllowlist bool
+	// JobTokenPush is whether CI/CD job tokens can push to the repository.
+	JobTokenPush bool
+}
+
+type ciSettingsHandler struct {
+	glClient *gitlab.Client
+	once     *sync.Once
+	errSetup error
+	repourl  *Repo
+	project  *gitlab.Project
+	settings *CISettings
+}
+
+func (handler *ciSettingsHandler) init(repourl *Repo, project *gitlab.Project) {
+	handler.repourl = repourl
+	handler.project = project
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.settings = nil
+}
+
+func (handler *ciSettingsHandler) setup() error {
+	handler.once.Do(func() {
+		// only maintainers can read the settings.
+		scope, resp, err := handler.glClient.JobTokenScope.GetProjectJobTokenAccessSettings(handler.repourl.projectID)
+		if resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return
+		}
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for job token access settings failed with %w", err)
+			return
+		}
+
+		settings := &CISettings{
+			URL:               handler.project.WebURL + "/-/settings/ci_cd",
+			ForkPipelines:     handler.project.CIAllowForkPipelinesToRunInParentProject,
+			JobTokenAllowlist: scope.InboundEnabled,
+			JobTokenPush:      handler.project.CIPushRepositoryForJobTokenAllowed,
+		}
+		opts := &gitlab.ListProjectVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+		for {
+			variables, resp, err := handler.glClient.ProjectVariables.ListVariables(handler.repourl.projectID, opts)
+			if err != nil {
+				handler.errSetup = fmt.Errorf("request for project variables failed with %w", err)
+				return
+			}
+			for _, v := range variables {
+				if !v.Protected {
+					settings.ExposedVariables = append(settings.ExposedVariables, v.Key)
+				}
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+		handler.settings = settings
+	})
+	return handler.errSetup
+}
+
+func (handler *ciSettingsHandler) getCISettings() (*CISettings, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during ciSettingsHandler.setup: %w", err)
+	}
+	return handler.settings, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestGetCISettings(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want      *CISettings
+		name      string
+		scope     string
+		variables string
+		status    int
+	}{
+		{
+			name:      "maintainer",
+			status:    http.StatusOK,
+			scope:     `{"inbound_enabled": true, "outbound_enabled": false}`,
+			variables: `[{"key": "DEPLOY_TOKEN", "protected": true}, {"key": "SONAR_TOKEN", "protected": false}]`,
+			want: &CISettings{
+				URL:               "https://gitlab.com/owner/project/-/settings/ci_cd",
+				ExposedVariables:  []string{"SONAR_TOKEN"},
+				ForkPipelines:     true,
+				JobTokenAllowlist: true,
+			},
+		},
+		{
+			name:   "not a maintainer",
+			status: http.StatusForbidden,
+			scope:  `{"message": "403 Forbidden"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			responses := map[string]string{
+				"/api/v4/projects/123/job_token_scope": tt.scope,
+				"/api/v4/projects/123/variables":       tt.variables,
+			}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/api/v4/projects/123/job_token_scope" {
+					w.WriteHeader(tt.status)
+				}
+				if _, err := w.Write([]byte(responses[r.URL.Path])); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer srv.Close()
+
+			glclient, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			if err != nil {
+				t.Fatalf("gitlab.NewClient error: %v", err)
+			}
+			handler := &ciSettingsHandler{glClient: glclient}
+			handler.init(&Repo{projectID: "123"}, &gitlab.Project{
+				WebURL:                                   "https://gitlab.com/owner/project",
+				CIAllowForkPipelinesToRunInParentProject: true,
+			})
+
+			got, err := handler.getCISettings()
+			if err != nil {
+				t.Fatalf("getCISettings() error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getCISettings() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
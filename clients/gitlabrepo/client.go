@@ -21,15 +21,21 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
 	sce "github.com/ossf/scorecard/v5/errors"
 )
 
+// orgProfileProject is the project which holds the profile and community files
+// of a group.
+const orgProfileProject = "gitlab-profile"
+
 var (
 	_                clients.RepoClient = &Client{}
 	errInputRepoType                    = errors.New("input repo should be of type repoURL")
@@ -51,6 +57,7 @@ type Client struct {
 	search        *searchHandler
 	searchCommits *searchCommitsHandler
 	webhook       *webhookHandler
+	ciSettings    *ciSettingsHandler
 	languages     *languagesHandler
 	licenses      *licensesHandler
 	tarball       *tarballHandler
@@ -147,6 +154,9 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Init webhookHandler
 	client.webhook.init(client.repourl)
 
+	// Init ciSettingsHandler
+	client.ciSettings.init(client.repourl, repo)
+
 	// Init languagesHandler
 	client.languages.init(client.repourl)
 
@@ -201,7 +211,12 @@ func (client *Client) ListCommits() ([]clients.Commit, error) {
 		return []clients.Commit{}, err
 	}
 
-	return client.commits.zip(commitsRaw, mrDetails), nil
+	signatures, err := client.commits.listSignatures(commitsRaw)
+	if err != nil {
+		return []clients.Commit{}, err
+	}
+
+	return client.commits.zip(commitsRaw, mrDetails, signatures), nil
 }
 
 func (client *Client) ListIssues() ([]clients.Issue, error) {
@@ -220,6 +235,10 @@ func (client *Client) IsArchived() (bool, error) {
 	return client.project.isArchived()
 }
 
+func (client *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
 func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 	return client.branches.getDefaultBranch()
 }
@@ -236,8 +255,26 @@ func (client *Client) GetCreatedAt() (time.Time, error) {
 	return client.project.getCreatedAt()
 }
 
+// GetOrgRepoClient returns a client for the gitlab-profile project of the
+// group containing the repository, which is GitLab's counterpart of the .github
+// repository of GitHub organizations.
 func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
-	return nil, fmt.Errorf("GetOrgRepoClient (GitLab): %w", clients.ErrUnsupportedFeature)
+	namespace := client.repourl.owner
+	if client.repo.Namespace != nil && client.repo.Namespace.FullPath != "" {
+		namespace = client.repo.Namespace.FullPath
+	}
+	profileRepo := &Repo{
+		scheme:  client.repourl.scheme,
+		host:    client.repourl.host,
+		owner:   namespace,
+		project: orgProfileProject,
+	}
+
+	c := newClient(ctx, client.glClient, client.tarball.httpClient)
+	if err := c.InitRepo(profileRepo, clients.HeadSHA, 0); err != nil {
+		return nil, fmt.Errorf("error during InitRepo: %w", err)
+	}
+	return c, nil
 }
 
 func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
@@ -256,6 +293,12 @@ func (client *Client) ListStatuses(ref string) ([]clients.Status, error) {
 	return client.statuses.listStatuses(ref)
 }
 
+// GetCISettings returns the CI/CD settings of the project, or nil if the
+// token can't read them.
+func (client *Client) GetCISettings() (*CISettings, error) {
+	return client.ciSettings.getCISettings()
+}
+
 func (client *Client) ListProgrammingLanguages() ([]clients.Language, error) {
 	return client.languages.listProgrammingLanguages()
 }
@@ -283,12 +326,34 @@ func CreateGitlabClient(ctx context.Context, host string) (clients.RepoClient, e
 }
 
 func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clients.RepoClient, error) {
+	return newGitlabClient(ctx, token, host, http.DefaultClient)
+}
+
+// CreateGitlabClientWithCache returns a client which caches responses on disk
+// in cacheDir, revalidating them with conditional requests.
+func CreateGitlabClientWithCache(ctx context.Context, host, cacheDir string) (clients.RepoClient, error) {
+	rt, err := httpcache.NewTransport(http.DefaultTransport, cacheDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not create gitlab client with error: %w", err)
+	}
+	httpClient := &http.Client{Transport: rt}
+	token := os.Getenv("GITLAB_AUTH_TOKEN")
+	return newGitlabClient(ctx, token, host, httpClient, gitlab.WithHTTPClient(httpClient))
+}
+
+func newGitlabClient(ctx context.Context, token, host string, httpClient *http.Client,
+	opts ...gitlab.ClientOptionFunc,
+) (clients.RepoClient, error) {
 	url := "https://" + host
-	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(url))
+	opts = append(opts, gitlab.WithBaseURL(url))
+	client, err := gitlab.NewClient(token, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not create gitlab client with error: %w", err)
 	}
+	return newClient(ctx, client, httpClient), nil
+}
 
+func newClient(ctx context.Context, client *gitlab.Client, httpClient *http.Client) *Client {
 	return &Client{
 		ctx:      ctx,
 		glClient: client,
@@ -328,13 +393,18 @@ func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clien
 		webhook: &webhookHandler{
 			glClient: client,
 		},
+		ciSettings: &ciSettingsHandler{
+			glClient: client,
+		},
 		languages: &languagesHandler{
 			glClient: client,
 		},
 		licenses: &licensesHandler{},
-		tarball:  &tarballHandler{},
-		graphql:  &graphqlHandler{},
-	}, nil
+		tarball: &tarballHandler{
+			httpClient: httpClient,
+		},
+		graphql: &graphqlHandler{},
+	}
 }
 
 // TODO(#2266): implement CreateOssFuzzRepoClient.
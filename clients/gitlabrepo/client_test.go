@@ -17,12 +17,15 @@ package gitlabrepo
 import (
 	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/ossf/scorecard/v5/clients"
+	sce "github.com/ossf/scorecard/v5/errors"
 )
 
 func TestCheckRepoInaccessible(t *testing.T) {
@@ -137,3 +140,70 @@ func TestListCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOrgRepoClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		wantErr   error
+		namespace *gitlab.ProjectNamespace
+		name      string
+		profile   string
+		wantURI   string
+	}{
+		{
+			name:      "profile project of a subgroup",
+			namespace: &gitlab.ProjectNamespace{FullPath: "group/subgroup"},
+			profile:   "/api/v4/projects/group%2Fsubgroup%2Fgitlab-profile",
+			wantURI:   "/group/subgroup/gitlab-profile",
+		},
+		{
+			name:    "falls back to the owner without a namespace",
+			profile: "/api/v4/projects/group%2Fgitlab-profile",
+			wantURI: "/group/gitlab-profile",
+		},
+		{
+			name:      "group without a profile project",
+			namespace: &gitlab.ProjectNamespace{FullPath: "group"},
+			wantErr:   sce.ErrRepoUnreachable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.profile == "" || r.URL.EscapedPath() != tt.profile {
+					http.NotFound(w, r)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				body := `{"id": 7, "default_branch": "main", "repository_access_level": "enabled"}`
+				if _, err := w.Write([]byte(body)); err != nil {
+					t.Errorf("failed to write response: %v", err)
+				}
+			}))
+			defer srv.Close()
+
+			glclient, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+			if err != nil {
+				t.Fatalf("gitlab.NewClient error: %v", err)
+			}
+			host := strings.TrimPrefix(srv.URL, "http://")
+			client := newClient(t.Context(), glclient, srv.Client())
+			client.repo = &gitlab.Project{Namespace: tt.namespace}
+			client.repourl = &Repo{scheme: "http", host: host, owner: "group", project: "subgroup/project"}
+
+			got, err := client.GetOrgRepoClient(t.Context())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetOrgRepoClient() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(host+tt.wantURI, got.URI()); diff != "" {
+				t.Errorf("URI() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
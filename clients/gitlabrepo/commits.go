@@ -15,12 +15,15 @@
 package gitlabrepo
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 
+	"golang.org/x/crypto/ssh"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/ossf/scorecard/v5/clients"
@@ -97,12 +100,91 @@ func (handler *commitsHandler) listRawCommits() ([]*gitlab.Commit, error) {
 	return handler.commitsRaw, nil
 }
 
+// commitSignature is the signature of a commit:
+// https://docs.gitlab.com/api/commits/#get-signature-of-a-commit
+type commitSignature struct {
+	X509Certificate *struct {
+		Email      string `json:"email"`
+		X509Issuer struct {
+			Subject string `json:"subject"`
+		} `json:"x509_issuer"`
+	} `json:"x509_certificate"`
+	Key *struct {
+		Key string `json:"key"`
+	} `json:"key"`
+	SignatureType      string `json:"signature_type"`
+	VerificationStatus string `json:"verification_status"`
+	GPGKeyPrimaryKeyID string `json:"gpg_key_primary_keyid"`
+	GPGKeyUserEmail    string `json:"gpg_key_user_email"`
+}
+
+// listSignatures returns the signatures of the signed commits by their SHA.
+// The REST API has no way to list them with the commits, so this makes one
+// call per commit.
+func (handler *commitsHandler) listSignatures(commitsRaw []*gitlab.Commit) (map[string]*clients.Signature, error) {
+	signatures := make(map[string]*clients.Signature)
+	for _, c := range commitsRaw {
+		path := fmt.Sprintf("projects/%s/repository/commits/%s/signature",
+			gitlab.PathEscape(handler.repourl.projectID), c.ID)
+		req, err := handler.glClient.NewRequest(http.MethodGet, path, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("request for commit signature failed with %w", err)
+		}
+		var sig commitSignature
+		if _, err := handler.glClient.Do(req, &sig); err != nil {
+			// unsigned commits have no signature.
+			if errors.Is(err, gitlab.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("request for commit signature failed with %w", err)
+		}
+		signatures[c.ID] = sig.convert()
+	}
+	return signatures, nil
+}
+
+func (s *commitSignature) convert() *clients.Signature {
+	sig := &clients.Signature{
+		Type:  clients.SignatureTypeUnknown,
+		State: s.VerificationStatus,
+		// verified_system is used for commits GitLab made and signed itself.
+		Verified: s.VerificationStatus == "verified" || s.VerificationStatus == "verified_system",
+	}
+	switch s.SignatureType {
+	case "PGP":
+		sig.Type = clients.SignatureTypeGPG
+		sig.Signer = s.GPGKeyUserEmail
+		if sig.Signer == "" {
+			sig.Signer = s.GPGKeyPrimaryKeyID
+		}
+	case "SSH":
+		sig.Type = clients.SignatureTypeSSH
+		if s.Key != nil {
+			if key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s.Key.Key)); err == nil {
+				sig.Signer = ssh.FingerprintSHA256(key)
+			}
+		}
+	case "X509":
+		sig.Type = clients.SignatureTypeSMIME
+		if s.X509Certificate != nil {
+			sig.Signer = s.X509Certificate.Email
+			// certificates of gitsign are issued by the Sigstore CA.
+			if strings.Contains(s.X509Certificate.X509Issuer.Subject, "sigstore") {
+				sig.Type = clients.SignatureTypeSigstore
+			}
+		}
+	}
+	return sig
+}
+
 // zip combines Commit information from the GitLab REST API with MergeRequests
 // information from the GitLab GraphQL API. The REST API doesn't provide any way to
 // get from Commits -> MRs that they were part of or vice-versa (MRs -> commits they
 // contain), except through a separate API call. Instead of calling the REST API
 // len(commits) times to get the associated MR, we make 3 calls (2 REST, 1 GraphQL).
-func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData) []clients.Commit {
+func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData,
+	signatures map[string]*clients.Signature,
+) []clients.Commit {
 	commitToMRIID := make(map[string]string) // which mr does a commit belong to?
 	for i := range data.Project.MergeRequests.Nodes {
 		mr := data.Project.MergeRequests.Nodes[i]
@@ -184,6 +266,7 @@ func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData
 				Message:                cRaw.Message,
 				SHA:                    cRaw.ID,
 				AssociatedMergeRequest: associatedMr,
+				Signature:              signatures[cRaw.ID],
 			})
 	}
 
@@ -16,6 +16,7 @@ package gitlabrepo
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -134,3 +135,64 @@ func TestListRawCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestListSignatures(t *testing.T) {
+	t.Parallel()
+	responses := map[string]string{
+		"/api/v4/projects/123/repository/commits/gpg/signature": `{"signature_type": "PGP",
+			"verification_status": "verified", "gpg_key_primary_keyid": "8254AAB3FBD54AC9",
+			"gpg_key_user_email": "alice@example.com"}`,
+		"/api/v4/projects/123/repository/commits/ssh/signature": `{"signature_type": "SSH",
+			"verification_status": "unverified", "key": {"key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPAtx3i5XMjcJtarUm1oixdHW50jpDA4mDR4/CSohos6"}}`,
+		"/api/v4/projects/123/repository/commits/gitsign/signature": `{"signature_type": "X509",
+			"verification_status": "unverified", "x509_certificate": {"email": "carol@example.com",
+			"x509_issuer": {"subject": "CN=sigstore-intermediate,O=sigstore.dev"}}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	glclient, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("gitlab.NewClient error: %v", err)
+	}
+	handler := &commitsHandler{glClient: glclient}
+	handler.init(&Repo{projectID: "123"}, 30)
+
+	got, err := handler.listSignatures([]*gitlab.Commit{
+		{ID: "gpg"}, {ID: "ssh"}, {ID: "gitsign"}, {ID: "unsigned"},
+	})
+	if err != nil {
+		t.Fatalf("listSignatures() error: %v", err)
+	}
+	want := map[string]*clients.Signature{
+		"gpg": {
+			Type:     clients.SignatureTypeGPG,
+			Signer:   "alice@example.com",
+			State:    "verified",
+			Verified: true,
+		},
+		"ssh": {
+			Type:   clients.SignatureTypeSSH,
+			Signer: "SHA256:AEbDjTrCOfJQ3NSynVgngqXPFiotdnIzbE/NHM64v80",
+			State:  "unverified",
+		},
+		"gitsign": {
+			Type:   clients.SignatureTypeSigstore,
+			Signer: "carol@example.com",
+			State:  "unverified",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("listSignatures() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -15,7 +15,9 @@
 package gitlabrepo
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 
@@ -51,6 +53,7 @@ func (handler *releasesHandler) setup() error {
 		}
 		if len(releases) > 0 {
 			handler.releases = releasesFrom(releases)
+			handler.errSetup = handler.addTags()
 		} else {
 			handler.releases = nil
 		}
@@ -58,6 +61,65 @@ func (handler *releasesHandler) setup() error {
 	return handler.errSetup
 }
 
+// addTags sets the tag of each release whose tag is among the most recently updated ones.
+func (handler *releasesHandler) addTags() error {
+	tags, _, err := handler.glClient.Tags.ListTags(handler.repourl.projectID, &gitlab.ListTagsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: ListTags failed", err)
+	}
+	byName := make(map[string]*gitlab.Tag, len(tags))
+	for _, t := range tags {
+		byName[t.Name] = t
+	}
+	for i := range handler.releases {
+		t, ok := byName[handler.releases[i].TagName]
+		if !ok {
+			continue
+		}
+		protected := t.Protected
+		tag := &clients.Tag{
+			Protected: &protected,
+			// the target of annotated tags is the tag object instead of the commit.
+			Annotated: t.Commit != nil && t.Target != t.Commit.ID,
+		}
+		if tag.Annotated {
+			tag.Signature, err = handler.getTagSignature(t)
+			if err != nil {
+				return err
+			}
+		}
+		handler.releases[i].Tag = tag
+	}
+	return nil
+}
+
+// getTagSignature returns the signature of an annotated tag. The REST API only
+// reports X.509 signatures, which share the format of commit signatures.
+func (handler *releasesHandler) getTagSignature(t *gitlab.Tag) (*clients.Signature, error) {
+	path := fmt.Sprintf("projects/%s/repository/tags/%s/signature",
+		gitlab.PathEscape(handler.repourl.projectID), gitlab.PathEscape(t.Name))
+	req, err := handler.glClient.NewRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request for tag signature failed with %w", err)
+	}
+	var sig commitSignature
+	_, err = handler.glClient.Do(req, &sig)
+	switch {
+	case err == nil:
+		return sig.convert(), nil
+	case !errors.Is(err, gitlab.ErrNotFound):
+		return nil, fmt.Errorf("request for tag signature failed with %w", err)
+	}
+	// other signatures may be kept at the end of the tag message.
+	var ret *clients.Signature
+	if i := strings.Index(t.Message, "-----BEGIN "); i >= 0 {
+		ret = clients.ParseSignature(t.Message[i:])
+	}
+	return ret, nil
+}
+
 func (handler *releasesHandler) getReleases() ([]clients.Release, error) {
 	if err := handler.setup(); err != nil {
 		return nil, fmt.Errorf("error during Releases.setup: %w", err)
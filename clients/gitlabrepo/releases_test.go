@@ -0,0 +1,92 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/ossf/scorecard/v5/clients"
+)
+
+func TestListReleasesTags(t *testing.T) {
+	t.Parallel()
+	responses := map[string]string{
+		"/api/v4/projects/123/releases": `[{"tag_name": "v1.2.0"}, {"tag_name": "v1.1.0"},
+			{"tag_name": "v1.0.0"}, {"tag_name": "v0.1.0"}]`,
+		"/api/v4/projects/123/repository/tags": `[
+			{"name": "v1.2.0", "target": "aaa", "protected": true, "commit": {"id": "111"}},
+			{"name": "v1.1.0", "target": "bbb", "protected": false, "commit": {"id": "222"}},
+			{"name": "v1.0.0", "target": "333", "protected": true, "commit": {"id": "333"}}]`,
+		"/api/v4/projects/123/repository/tags/v1.2.0/signature": `{"signature_type": "X509",
+			"verification_status": "verified", "x509_certificate": {"email": "carol@example.com",
+			"x509_issuer": {"subject": "CN=sigstore-intermediate,O=sigstore.dev"}}}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	glclient, err := gitlab.NewClient("", gitlab.WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("gitlab.NewClient error: %v", err)
+	}
+	handler := &releasesHandler{glClient: glclient}
+	handler.init(&Repo{projectID: "123", commitSHA: clients.HeadSHA})
+
+	releases, err := handler.getReleases()
+	if err != nil {
+		t.Fatalf("getReleases() error: %v", err)
+	}
+	got := make(map[string]*clients.Tag, len(releases))
+	for _, r := range releases {
+		got[r.TagName] = r.Tag
+	}
+	want := map[string]*clients.Tag{
+		"v1.2.0": {
+			Signature: &clients.Signature{
+				Type:     clients.SignatureTypeSigstore,
+				Signer:   "carol@example.com",
+				State:    "verified",
+				Verified: true,
+			},
+			Protected: gitlab.Ptr(true),
+			Annotated: true,
+		},
+		"v1.1.0": {
+			Protected: gitlab.Ptr(false),
+			Annotated: true,
+		},
+		"v1.0.0": {
+			Protected: gitlab.Ptr(true),
+		},
+		"v0.1.0": nil,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getReleases() tags mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -66,6 +66,7 @@ func extractAndValidateArchivePath(path, dest string) (string, error) {
 
 type tarballHandler struct {
 	errSetup    error
+	httpClient  *http.Client
 	once        *sync.Once
 	ctx         context.Context
 	repo        *gitlab.Project
@@ -186,7 +187,11 @@ func (handler *tarballHandler) apiFunction(url, tempDir string, repoFile *os.Fil
 		return fmt.Errorf("http.NewRequestWithContext: %w", err)
 	}
 	req.Header.Set("PRIVATE-TOKEN", os.Getenv("GITLAB_AUTH_TOKEN"))
-	resp, err := http.DefaultClient.Do(req)
+	httpClient := handler.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("%w io.Copy: %w", errTarballNotFound, err)
 	}
@@ -0,0 +1,361 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpcache implements a disk-backed http.RoundTripper which
+// revalidates cached responses with conditional requests.
+//
+// Only GET requests are cached. GraphQL queries are POST requests which
+// servers do not revalidate, so they always reach the server.
+package httpcache
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	sclog "github.com/ossf/scorecard/v5/log"
+)
+
+// Responses are cached by URL, so they are shared across tokens. The cache
+// directory must only be readable by the users allowed to see the responses.
+const dirMode = 0o700
+
+// defaultMaxSize bounds the size of the cache. Once exceeded, the least
+// recently used entries are evicted.
+const defaultMaxSize int64 = 1 << 30
+
+const tmpPattern = "tmp-*"
+
+var errTooLarge = errors.New("response larger than the cache")
+
+type transport struct {
+	base   http.RoundTripper
+	logger *sclog.Logger
+	dir    string
+	// mu guards size, the total size of the cache entries.
+	mu      sync.Mutex
+	size    int64
+	maxSize int64
+}
+
+// NewTransport returns an http.RoundTripper caching the successful GET
+// responses of base in dir. Cached responses are only reused after being
+// revalidated with If-None-Match or If-Modified-Since, so a 304 response
+// from the server is answered with the cached body. Failures of the cache
+// are logged and never fail a request.
+func NewTransport(base http.RoundTripper, dir string) (http.RoundTripper, error) {
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return nil, fmt.Errorf("creating cache dir: %w", err)
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	entries, err := listEntries(dir)
+	if err != nil {
+		return nil, err
+	}
+	var size int64
+	for _, e := range entries {
+		size += e.size
+	}
+	return &transport{
+		base:    base,
+		logger:  sclog.NewLogger(sclog.DefaultLevel),
+		dir:     dir,
+		size:    size,
+		maxSize: defaultMaxSize,
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !cacheable(r) {
+		return t.base.RoundTrip(r) //nolint:wrapcheck
+	}
+	path := t.path(r)
+	cached := load(path, r)
+	if cached != nil {
+		// RoundTrip must not modify the caller's request.
+		r = r.Clone(r.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			r.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		if cached != nil {
+			cached.Body.Close()
+		}
+		return nil, err //nolint:wrapcheck
+	}
+
+	if cached != nil {
+		if resp.StatusCode == http.StatusNotModified {
+			resp.Body.Close()
+			// the 304 response carries fresh headers, e.g. rate limits.
+			for k, v := range resp.Header {
+				cached.Header[k] = v
+			}
+			cached.Request = r
+			// the modification time orders entries for eviction.
+			now := time.Now()
+			if err := os.Chtimes(path, now, now); err != nil {
+				t.logger.Info(fmt.Sprintf("httpcache: %v", err))
+			}
+			return cached, nil
+		}
+		cached.Body.Close()
+	}
+
+	if !storable(resp) {
+		return resp, nil
+	}
+	// The entry is written as the body is read, so the response is still
+	// streamed to the caller, and served unchanged if the entry cannot be written.
+	tmp, err := t.create(resp)
+	if err != nil {
+		t.logger.Info(fmt.Sprintf("httpcache: %v", err))
+		return resp, nil
+	}
+	resp.Body = &cachingBody{ReadCloser: resp.Body, t: t, tmp: tmp, path: path}
+	return resp, nil
+}
+func (t *transport) path(r *http.Request) string {
+	// Authorization is deliberately not part of the key, responses are
+	// revalidated with the token of each request instead.
+	h := sha256.Sum256([]byte(r.URL.String() + "\n" + r.Header.Get("Accept")))
+	return filepath.Join(t.dir, hex.EncodeToString(h[:]))
+}
+
+func cacheable(r *http.Request) bool {
+	return r.Method == http.MethodGet &&
+		r.Header.Get("Range") == "" &&
+		r.Header.Get("If-None-Match") == "" &&
+		r.Header.Get("If-Modified-Since") == ""
+}
+
+func storable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	if strings.Contains(strings.ToLower(resp.Header.Get("Cache-Control")), "no-store") {
+		return false
+	}
+	// without validators the response could never be revalidated.
+	return resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+// create writes the status line and headers of resp to a temporary entry.
+// The body is not consumed.
+func (t *transport) create(resp *http.Response) (*os.File, error) {
+	tmp, err := os.CreateTemp(t.dir, tmpPattern)
+	if err != nil {
+		return nil, fmt.Errorf("creating cache entry: %w", err)
+	}
+	// The stored body is read until EOF, as it is neither chunked nor
+	// of a known length while it is being written.
+	header := resp.Header.Clone()
+	header.Del("Content-Length")
+	header.Del("Transfer-Encoding")
+	w := bufio.NewWriter(tmp)
+	fmt.Fprintf(w, "HTTP/1.1 %s\r\n", resp.Status)
+	err = header.Write(w)
+	if err == nil {
+		_, err = w.WriteString("\r\n")
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("writing cache entry: %w", err)
+	}
+	return tmp, nil
+}
+
+// commit stores the temporary entry tmp at path and evicts the least
+// recently used entries if the cache is full.
+func (t *transport) commit(tmp *os.File, path string) error {
+	defer os.Remove(tmp.Name())
+	info, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing cache entry: %w", err)
+	}
+	// renaming is atomic, so concurrent readers never see partial entries.
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("storing cache entry: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.size += info.Size()
+	if t.size <= t.maxSize {
+		return nil
+	}
+	return t.evict()
+}
+
+// evict removes the least recently used entries until the cache is 3/4 full,
+// so that the cache directory is not listed on every stored response.
+// t.mu must be held.
+func (t *transport) evict() error {
+	entries, err := listEntries(t.dir)
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return a.modTime.Compare(b.modTime)
+	})
+	t.size = 0
+	for _, e := range entries {
+		t.size += e.size
+	}
+	for _, e := range entries {
+		if t.size <= t.maxSize/4*3 {
+			break
+		}
+		if err := os.Remove(filepath.Join(t.dir, e.name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("evicting cache entry: %w", err)
+		}
+		t.size -= e.size
+	}
+	return nil
+}
+
+type entry struct {
+	modTime time.Time
+	name    string
+	size    int64
+}
+
+// listEntries returns the cache entries in dir, without temporary entries.
+func listEntries(dir string) ([]entry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing cache entries: %w", err)
+	}
+	entries := make([]entry, 0, len(dirEntries))
+	for _, d := range dirEntries {
+		if d.IsDir() || strings.HasPrefix(d.Name(), strings.TrimSuffix(tmpPattern, "*")) {
+			continue
+		}
+		info, err := d.Info()
+		if err != nil {
+			// the entry was removed concurrently.
+			continue
+		}
+		entries = append(entries, entry{name: d.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+	return entries, nil
+}
+
+// cachingBody writes the body of a response to a cache entry as it is
+// read. The entry is stored once the body is read to the end, and
+// abandoned if the body is closed before or the entry cannot be written.
+type cachingBody struct {
+	io.ReadCloser
+	t       *transport
+	tmp     *os.File
+	path    string
+	written int64
+}
+
+func (b *cachingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.tmp != nil && n > 0 {
+		b.written += int64(n)
+		if b.written > b.t.maxSize {
+			b.abandon(fmt.Errorf("%w: %s", errTooLarge, b.path))
+		} else if _, werr := b.tmp.Write(p[:n]); werr != nil {
+			b.abandon(fmt.Errorf("writing cache entry: %w", werr))
+		}
+	}
+	if b.tmp != nil && err != nil {
+		if errors.Is(err, io.EOF) {
+			tmp := b.tmp
+			b.tmp = nil
+			if cerr := b.t.commit(tmp, b.path); cerr != nil {
+				b.t.logger.Info(fmt.Sprintf("httpcache: %v", cerr))
+			}
+		} else {
+			b.abandon(nil)
+		}
+	}
+	return n, err //nolint:wrapcheck
+}
+
+func (b *cachingBody) Close() error {
+	if b.tmp != nil {
+		b.abandon(nil)
+	}
+	return b.ReadCloser.Close() //nolint:wrapcheck
+}
+
+// abandon removes the temporary entry, logging err if it is not nil.
+func (b *cachingBody) abandon(err error) {
+	if err != nil {
+		b.t.logger.Info(fmt.Sprintf("httpcache: %v", err))
+	}
+	b.tmp.Close()
+	os.Remove(b.tmp.Name())
+	b.tmp = nil
+}
+
+// load returns the response cached at path, or nil if there is none.
+// The returned body is streamed from disk.
+func load(path string, r *http.Request) *http.Response {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(f), r)
+	if err != nil {
+		f.Close()
+		return nil
+	}
+	resp.Body = &fileBody{ReadCloser: resp.Body, f: f}
+	return resp
+}
+
+type fileBody struct {
+	io.ReadCloser
+	f *os.File
+}
+
+func (b *fileBody) Close() error {
+	err := b.ReadCloser.Close()
+	if ferr := b.f.Close(); err == nil {
+		err = ferr
+	}
+	return err //nolint:wrapcheck
+}
@@ -0,0 +1,317 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcache
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const etag = `"v1"`
+
+//nolint:govet
+type response struct {
+	status int
+	body   string
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		method       string
+		cacheControl string
+		etag         string
+		want         []response
+		wantFull     int32
+	}{
+		{
+			name:   "revalidated with etag",
+			method: http.MethodGet,
+			etag:   etag,
+			want: []response{
+				{status: http.StatusOK, body: "hello"},
+				{status: http.StatusOK, body: "hello"},
+				{status: http.StatusOK, body: "hello"},
+			},
+			wantFull: 1,
+		},
+		{
+			name:   "no validators",
+			method: http.MethodGet,
+			want: []response{
+				{status: http.StatusOK, body: "hello"},
+				{status: http.StatusOK, body: "hello"},
+			},
+			wantFull: 2,
+		},
+		{
+			name:         "no-store",
+			method:       http.MethodGet,
+			etag:         etag,
+			cacheControl: "private, no-store",
+			want: []response{
+				{status: http.StatusOK, body: "hello"},
+				{status: http.StatusOK, body: "hello"},
+			},
+			wantFull: 2,
+		},
+		{
+			name:   "post is not cached",
+			method: http.MethodPost,
+			etag:   etag,
+			want: []response{
+				{status: http.StatusOK, body: "hello"},
+				{status: http.StatusOK, body: "hello"},
+			},
+			wantFull: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var full atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.etag != "" {
+					if r.Header.Get("If-None-Match") == tt.etag {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+					w.Header().Set("ETag", tt.etag)
+				}
+				if tt.cacheControl != "" {
+					w.Header().Set("Cache-Control", tt.cacheControl)
+				}
+				full.Add(1)
+				io.WriteString(w, "hello")
+			}))
+			defer srv.Close()
+
+			rt, err := NewTransport(nil, t.TempDir())
+			if err != nil {
+				t.Fatalf("NewTransport: %v", err)
+			}
+			client := &http.Client{Transport: rt}
+			for i, want := range tt.want {
+				req, err := http.NewRequestWithContext(t.Context(), tt.method, srv.URL, nil)
+				if err != nil {
+					t.Fatalf("NewRequest: %v", err)
+				}
+				// responses are shared across tokens.
+				req.Header.Set("Authorization", "token "+string(rune('a'+i)))
+				resp, err := client.Do(req)
+				if err != nil {
+					t.Fatalf("request %d: %v", i, err)
+				}
+				body, err := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				if err != nil {
+					t.Fatalf("request %d: reading body: %v", i, err)
+				}
+				if got := (response{status: resp.StatusCode, body: string(body)}); got != want {
+					t.Errorf("request %d: got %+v, want %+v", i, got, want)
+				}
+			}
+			if got := full.Load(); got != tt.wantFull {
+				t.Errorf("server sent %d full responses, want %d", got, tt.wantFull)
+			}
+		})
+	}
+}
+
+func TestTransport_doesNotModifyRequest(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		io.WriteString(w, "hello")
+	}))
+	defer srv.Close()
+
+	rt, err := NewTransport(nil, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewTransport: %v", err)
+	}
+	for range 2 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip: %v", err)
+		}
+		// responses are only cached once read to the end.
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if h := req.Header.Get("If-None-Match"); h != "" {
+			t.Errorf("request was modified: If-None-Match = %q", h)
+		}
+	}
+}
+
+// get requests url with rt, returning the body of the response.
+func get(t *testing.T, rt http.RoundTripper, url string) string {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	return string(body)
+}
+
+func TestTransport_cacheFailure(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		io.WriteString(w, "hello")
+	}))
+	defer srv.Close()
+
+	dir := filepath.Join(t.TempDir(), "cache")
+	rt, err := NewTransport(nil, dir)
+	if err != nil {
+		t.Fatalf("NewTransport: %v", err)
+	}
+	// entries can no longer be created.
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatalf("RemoveAll: %v", err)
+	}
+	if got := get(t, rt, srv.URL); got != "hello" {
+		t.Errorf("body = %q, want %q", got, "hello")
+	}
+}
+
+func TestTransport_eviction(t *testing.T) {
+	t.Parallel()
+	body := strings.Repeat("x", 100)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		io.WriteString(w, body)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	rt, err := NewTransport(nil, dir)
+	if err != nil {
+		t.Fatalf("NewTransport: %v", err)
+	}
+	// room for about two entries.
+	rt.(*transport).maxSize = 500
+	for i := range 5 {
+		url := fmt.Sprintf("%s/%d", srv.URL, i)
+		if got := get(t, rt, url); got != body {
+			t.Fatalf("body = %q, want %q", got, body)
+		}
+	}
+	entries, err := listEntries(dir)
+	if err != nil {
+		t.Fatalf("listEntries: %v", err)
+	}
+	var size int64
+	for _, e := range entries {
+		size += e.size
+	}
+	if size > 500 || len(entries) == 0 {
+		t.Errorf("cache holds %d entries of %d bytes, want at most 500 bytes", len(entries), size)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	now := time.Now()
+	for i, name := range []string{"old", "recent", "new"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, make([]byte, 100), 0o600); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		mtime := now.Add(time.Duration(i) * time.Hour)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatalf("Chtimes: %v", err)
+		}
+	}
+	tr := &transport{dir: dir, maxSize: 250}
+	tr.mu.Lock()
+	err := tr.evict()
+	tr.mu.Unlock()
+	if err != nil {
+		t.Fatalf("evict: %v", err)
+	}
+	entries, err := listEntries(dir)
+	if err != nil {
+		t.Fatalf("listEntries: %v", err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.name)
+	}
+	if diff := cmp.Diff([]string{"new"}, got); diff != "" {
+		t.Errorf("entries mismatch (-want +got):\n%s", diff)
+	}
+	if tr.size != 100 {
+		t.Errorf("size = %d, want 100", tr.size)
+	}
+}
+
+func TestTransport_partialRead(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", etag)
+		io.WriteString(w, "hello")
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	rt, err := NewTransport(nil, dir)
+	if err != nil {
+		t.Fatalf("NewTransport: %v", err)
+	}
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	resp.Body.Read(make([]byte, 2))
+	resp.Body.Close()
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("cache holds %d files after a partial read, want 0", len(files))
+	}
+}
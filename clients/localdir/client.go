@@ -29,6 +29,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-git/go-git/v5"
+
 	clients "github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/log"
 )
@@ -48,6 +50,12 @@ type Client struct {
 	errFiles    error
 	files       []string
 	commitDepth int
+	// gitRepo is set if path is a git checkout.
+	gitRepo     *git.Repository
+	commitSHA   string
+	onceCommits *sync.Once
+	errCommits  error
+	commits     []clients.Commit
 }
 
 // InitRepo sets up the local repo.
@@ -62,6 +70,17 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 		client.commitDepth = commitDepth
 	}
 	client.path = strings.TrimPrefix(localRepo.URI(), "file://")
+	client.commitSHA = commitSHA
+	client.onceCommits = new(sync.Once)
+	client.commits = nil
+	client.gitRepo = nil
+	if HasGitHistory(client.path) {
+		r, err := openGitRepo(client.path)
+		if err != nil {
+			return err
+		}
+		client.gitRepo = r
+	}
 
 	return nil
 }
@@ -76,6 +95,11 @@ func (client *Client) IsArchived() (bool, error) {
 	return false, fmt.Errorf("IsArchived: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivate implements RepoClient.IsPrivate.
+func (client *Client) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate: %w", clients.ErrUnsupportedFeature)
+}
+
 func isDir(p string) (bool, error) {
 	fileInfo, err := os.Stat(p)
 	if err != nil {
@@ -195,12 +219,21 @@ func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 
 // GetDefaultBranchName implements RepoClient.GetDefaultBranchName.
 func (client *Client) GetDefaultBranchName() (string, error) {
-	return "", fmt.Errorf("GetDefaultBranchName: %w", clients.ErrUnsupportedFeature)
+	if client.gitRepo == nil {
+		return "", fmt.Errorf("GetDefaultBranchName: %w", clients.ErrUnsupportedFeature)
+	}
+	return client.gitDefaultBranchName()
 }
 
 // ListCommits implements RepoClient.ListCommits.
 func (client *Client) ListCommits() ([]clients.Commit, error) {
-	return nil, fmt.Errorf("ListCommits: %w", clients.ErrUnsupportedFeature)
+	if client.gitRepo == nil {
+		return nil, fmt.Errorf("ListCommits: %w", clients.ErrUnsupportedFeature)
+	}
+	client.onceCommits.Do(func() {
+		client.commits, client.errCommits = client.listGitCommits()
+	})
+	return client.commits, client.errCommits
 }
 
 // ListIssues implements RepoClient.ListIssues.
@@ -208,14 +241,22 @@ func (client *Client) ListIssues() ([]clients.Issue, error) {
 	return nil, fmt.Errorf("ListIssues: %w", clients.ErrUnsupportedFeature)
 }
 
-// ListReleases implements RepoClient.ListReleases.
+// ListReleases implements RepoClient.ListReleases. The tags of a git
+// checkout are its releases, without any assets.
 func (client *Client) ListReleases() ([]clients.Release, error) {
-	return nil, fmt.Errorf("ListReleases: %w", clients.ErrUnsupportedFeature)
+	if client.gitRepo == nil {
+		return nil, fmt.Errorf("ListReleases: %w", clients.ErrUnsupportedFeature)
+	}
+	return client.listGitTags()
 }
 
-// ListContributors implements RepoClient.ListContributors.
+// ListContributors implements RepoClient.ListContributors. The commit
+// authors of a git checkout are its contributors, without any companies.
 func (client *Client) ListContributors() ([]clients.User, error) {
-	return nil, fmt.Errorf("ListContributors: %w", clients.ErrUnsupportedFeature)
+	if client.gitRepo == nil {
+		return nil, fmt.Errorf("ListContributors: %w", clients.ErrUnsupportedFeature)
+	}
+	return client.listGitAuthors()
 }
 
 // ListSuccessfulWorkflowRuns implements RepoClient.WorkflowRunsByFilename.
@@ -265,8 +306,12 @@ func (client *Client) ListLicenses() ([]clients.License, error) {
 	return nil, fmt.Errorf("ListLicenses: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetCreatedAt implements RepoClient.GetCreatedAt.
 func (client *Client) GetCreatedAt() (time.Time, error) {
-	return time.Time{}, fmt.Errorf("GetCreatedAt: %w", clients.ErrUnsupportedFeature)
+	if client.gitRepo == nil {
+		return time.Time{}, fmt.Errorf("GetCreatedAt: %w", clients.ErrUnsupportedFeature)
+	}
+	return client.gitCreatedAt()
 }
 
 func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
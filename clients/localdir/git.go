@@ -0,0 +1,305 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localdir
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	clients "github.com/ossf/scorecard/v5/clients"
+)
+
+var errShallowClone = errors.New("history of shallow clone is incomplete")
+
+// reMergeRequest matches the messages of merge commits created by the web
+// interfaces of code review platforms, and captures the number of the merged
+// pull request.
+var reMergeRequest = []*regexp.Regexp{
+	// GitHub and Gitea.
+	regexp.MustCompile(`^Merge pull request #(\d+) from `),
+	// GitLab.
+	regexp.MustCompile(`(?m)^See merge request \S+!(\d+)$`),
+	// Bitbucket.
+	regexp.MustCompile(`^Merged in \S+ \(pull request #(\d+)\)`),
+	// Azure DevOps.
+	regexp.MustCompile(`^Merged PR (\d+):`),
+}
+
+// HasGitHistory reports whether the directory at path is the root of a git
+// checkout, whose history is read instead of an API.
+func HasGitHistory(path string) bool {
+	// .git is a file in worktrees and submodules.
+	_, err := os.Stat(filepath.Join(path, ".git"))
+	return err == nil
+}
+
+func openGitRepo(path string) (*git.Repository, error) {
+	r, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{EnableDotGitCommonDir: true})
+	if err != nil {
+		return nil, fmt.Errorf("git.PlainOpen: %w", err)
+	}
+	return r, nil
+}
+
+// startCommit returns the commit which is scanned. It returns nil for
+// repositories without commits.
+func (client *Client) startCommit() (*object.Commit, error) {
+	hash, err := client.gitRepo.ResolveRevision(plumbing.Revision(client.commitSHA))
+	if errors.Is(err, plumbing.ErrReferenceNotFound) && strings.EqualFold(client.commitSHA, clients.HeadSHA) {
+		return nil, nil // a repository without commits.
+	}
+	if err != nil {
+		return nil, fmt.Errorf("git.ResolveRevision %s: %w", client.commitSHA, err)
+	}
+	commit, err := client.gitRepo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("git.CommitObject: %w", err)
+	}
+	return commit, nil
+}
+
+// firstParent returns the first parent of c, or nil for root commits and the
+// oldest commits of shallow clones.
+func firstParent(c *object.Commit) (*object.Commit, error) {
+	if c.NumParents() == 0 {
+		return nil, nil
+	}
+	parent, err := c.Parent(0)
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("commit.Parent: %w", err)
+	}
+	return parent, nil
+}
+
+// listGitCommits follows the first parents of the scanned commit, so that
+// the commits of a merged branch are represented by their merge commit.
+func (client *Client) listGitCommits() ([]clients.Commit, error) {
+	commit, err := client.startCommit()
+	if err != nil {
+		return nil, err
+	}
+	commits := make([]clients.Commit, 0, client.commitDepth)
+	for commit != nil && len(commits) < client.commitDepth {
+		commits = append(commits, makeCommit(commit))
+		if commit, err = firstParent(commit); err != nil {
+			return nil, err
+		}
+	}
+	return commits, nil
+}
+
+// makeCommit converts c, keeping trailers like Reviewed-on in its message.
+// Merge commits of pull requests are associated with the pull request, which
+// was merged by the author of the merge commit.
+func makeCommit(c *object.Commit) clients.Commit {
+	commit := clients.Commit{
+		SHA:           c.Hash.String(),
+		Message:       c.Message,
+		CommittedDate: c.Committer.When,
+		Committer: clients.User{
+			Login: c.Committer.Email,
+		},
+	}
+	// no keys are trusted locally, so signatures stay unverified.
+	if c.PGPSignature != "" {
+		commit.Signature = clients.ParseSignature(c.PGPSignature)
+	}
+	const mergeParents = 2
+	if c.NumParents() < mergeParents {
+		return commit
+	}
+	for _, re := range reMergeRequest {
+		match := re.FindStringSubmatch(c.Message)
+		if match == nil {
+			continue
+		}
+		number, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+		commit.AssociatedMergeRequest = clients.PullRequest{
+			Number:   number,
+			MergedAt: c.Committer.When,
+			HeadSHA:  c.ParentHashes[1].String(),
+			MergedBy: clients.User{Login: c.Author.Email},
+		}
+		if head, err := c.Parent(1); err == nil {
+			commit.AssociatedMergeRequest.Author = clients.User{Login: head.Author.Email}
+		}
+		break
+	}
+	return commit
+}
+
+// gitCreatedAt returns the date of the root commit. The root commit of a
+// shallow clone is unknown.
+func (client *Client) gitCreatedAt() (time.Time, error) {
+	shallow, err := client.gitRepo.Storer.Shallow()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("git.Shallow: %w", err)
+	}
+	if len(shallow) > 0 {
+		return time.Time{}, fmt.Errorf("GetCreatedAt: %w: %w", clients.ErrUnsupportedFeature, errShallowClone)
+	}
+	commit, err := client.startCommit()
+	if err != nil || commit == nil {
+		return time.Time{}, err
+	}
+	for {
+		parent, err := firstParent(commit)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if parent == nil {
+			return commit.Author.When, nil
+		}
+		commit = parent
+	}
+}
+
+// gitDefaultBranchName prefers the default branch of the origin remote over
+// the checked out branch, since CI systems often check out a detached HEAD.
+func (client *Client) gitDefaultBranchName() (string, error) {
+	if ref, err := client.gitRepo.Reference("refs/remotes/origin/HEAD", false); err == nil &&
+		ref.Type() == plumbing.SymbolicReference {
+		return strings.TrimPrefix(ref.Target().Short(), "origin/"), nil
+	}
+	head, err := client.gitRepo.Head()
+	if err != nil {
+		return "", fmt.Errorf("git.Head: %w", err)
+	}
+	if !head.Name().IsBranch() {
+		return "", fmt.Errorf("GetDefaultBranchName: detached HEAD: %w", clients.ErrUnsupportedFeature)
+	}
+	return head.Name().Short(), nil
+}
+
+// listGitTags returns the tags pointing to commits as releases, newest first.
+// Annotated tags are dated by their tagger, and lightweight ones by their
+// commit.
+func (client *Client) listGitTags() ([]clients.Release, error) {
+	iter, err := client.gitRepo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("git.Tags: %w", err)
+	}
+	type datedRelease struct {
+		when    time.Time
+		release clients.Release
+	}
+	var tags []datedRelease
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		hash, when := ref.Hash(), time.Time{}
+		// a checkout can't tell whether its remote protects tags.
+		t := &clients.Tag{}
+		if tag, err := client.gitRepo.TagObject(hash); err == nil {
+			if tag.TargetType != plumbing.CommitObject {
+				return nil
+			}
+			hash, when = tag.Target, tag.Tagger.When
+			t.Annotated = true
+			if tag.PGPSignature != "" {
+				t.Signature = clients.ParseSignature(tag.PGPSignature)
+			}
+		}
+		commit, err := client.gitRepo.CommitObject(hash)
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			// tags of trees and blobs, or of commits missing from a shallow clone.
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("git.CommitObject: %w", err)
+		}
+		if when.IsZero() {
+			when = commit.Committer.When
+		}
+		tags = append(tags, datedRelease{
+			when: when,
+			release: clients.Release{
+				Tag:             t,
+				TagName:         ref.Name().Short(),
+				TargetCommitish: commit.Hash.String(),
+			},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iter.ForEach: %w", err)
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		if tags[i].when.Equal(tags[j].when) {
+			return tags[i].release.TagName > tags[j].release.TagName
+		}
+		return tags[i].when.After(tags[j].when)
+	})
+	releases := make([]clients.Release, 0, len(tags))
+	for i := range tags {
+		releases = append(releases, tags[i].release)
+	}
+	return releases, nil
+}
+
+// listGitAuthors returns the authors of the scanned commit and its ancestors
+// by their email address, most active first.
+func (client *Client) listGitAuthors() ([]clients.User, error) {
+	commit, err := client.startCommit()
+	if err != nil || commit == nil {
+		return []clients.User{}, err
+	}
+	iter, err := client.gitRepo.Log(&git.LogOptions{From: commit.Hash})
+	if err != nil {
+		return nil, fmt.Errorf("git.Log: %w", err)
+	}
+	counts := map[string]int{}
+	bots := map[string]bool{}
+	var order []string
+	err = iter.ForEach(func(c *object.Commit) error {
+		email := c.Author.Email
+		if counts[email] == 0 {
+			order = append(order, email)
+		}
+		counts[email]++
+		bots[email] = strings.HasSuffix(c.Author.Name, "[bot]")
+		return nil
+	})
+	if err != nil && !errors.Is(err, plumbing.ErrObjectNotFound) {
+		return nil, fmt.Errorf("iter.ForEach: %w", err)
+	}
+	users := make([]clients.User, 0, len(order))
+	for _, email := range order {
+		users = append(users, clients.User{
+			Login:            email,
+			NumContributions: counts[email],
+			IsBot:            bots[email],
+		})
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].NumContributions > users[j].NumContributions
+	})
+	return users, nil
+}
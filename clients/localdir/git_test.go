@@ -0,0 +1,222 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localdir
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+type testHistory struct {
+	dir string
+	// signer is the fingerprint of the key which signed the gerrit commit.
+	signer                       string
+	root, feature, merge, gerrit plumbing.Hash
+}
+
+func signature(name string, when time.Time) *object.Signature {
+	return &object.Signature{Name: name, Email: name + "@example.com", When: when}
+}
+
+// newTestHistory creates a git checkout, in which a pull request was merged
+// into the root commit, followed by a signed commit reviewed on Gerrit.
+func newTestHistory(t *testing.T) testHistory {
+	t.Helper()
+	h := testHistory{dir: t.TempDir()}
+	r, err := git.PlainInit(h.dir, false)
+	if err != nil {
+		t.Fatalf("git.PlainInit: %v", err)
+	}
+	wt, err := r.Worktree()
+	if err != nil {
+		t.Fatalf("git.Worktree: %v", err)
+	}
+	var signKey *openpgp.Entity
+	commit := func(msg, author string, when time.Time, parents ...plumbing.Hash) plumbing.Hash {
+		hash, err := wt.Commit(msg, &git.CommitOptions{
+			Author:            signature(author, when),
+			Committer:         signature(author, when),
+			Parents:           parents,
+			SignKey:           signKey,
+			AllowEmptyCommits: true,
+		})
+		if err != nil {
+			t.Fatalf("git.Commit: %v", err)
+		}
+		return hash
+	}
+	h.root = commit("Initial commit\n", "alice", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	h.feature = commit("Add feature\n", "bob", time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC))
+	h.merge = commit("Merge pull request #5 from bob/feature\n\nAdd feature\n", "alice",
+		time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC), h.root, h.feature)
+	signKey, err = openpgp.NewEntity("alice", "", "alice@example.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	if err != nil {
+		t.Fatalf("openpgp.NewEntity: %v", err)
+	}
+	h.signer = fmt.Sprintf("%X", signKey.PrimaryKey.Fingerprint)
+	h.gerrit = commit("Fix typo\n\nChange-Id: I0123456789abcdef\nReviewed-on: https://review.example.com/c/1\n"+
+		"Reviewed-by: Bob <bob@example.com>\n", "alice", time.Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC))
+
+	if _, err := r.CreateTag("v1.0.0", h.root, nil); err != nil {
+		t.Fatalf("git.CreateTag: %v", err)
+	}
+	if _, err := r.CreateTag("v1.1.0", h.merge, &git.CreateTagOptions{
+		Tagger:  signature("alice", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Message: "v1.1.0",
+	}); err != nil {
+		t.Fatalf("git.CreateTag: %v", err)
+	}
+	if _, err := r.CreateTag("v1.2.0", h.gerrit, &git.CreateTagOptions{
+		Tagger:  signature("alice", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		Message: "v1.2.0",
+		SignKey: signKey,
+	}); err != nil {
+		t.Fatalf("git.CreateTag: %v", err)
+	}
+	return h
+}
+
+func newGitTestClient(t *testing.T, dir string) clients.RepoClient {
+	t.Helper()
+	repo, err := MakeLocalDirRepo(dir)
+	if err != nil {
+		t.Fatalf("MakeLocalDirRepo: %v", err)
+	}
+	client := CreateLocalDirClient(t.Context(), log.NewLogger(log.DebugLevel))
+	if err := client.InitRepo(repo, clients.HeadSHA, 30); err != nil {
+		t.Fatalf("InitRepo: %v", err)
+	}
+	return client
+}
+
+func TestClient_ListCommits(t *testing.T) {
+	t.Parallel()
+	h := newTestHistory(t)
+	got, err := newGitTestClient(t, h.dir).ListCommits()
+	if err != nil {
+		t.Fatalf("ListCommits: %v", err)
+	}
+	want := []clients.Commit{
+		{
+			SHA:           h.gerrit.String(),
+			Message:       "Fix typo\n\nChange-Id: I0123456789abcdef\nReviewed-on: https://review.example.com/c/1\nReviewed-by: Bob <bob@example.com>\n",
+			CommittedDate: time.Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC),
+			Committer:     clients.User{Login: "alice@example.com"},
+			Signature:     &clients.Signature{Type: clients.SignatureTypeGPG, Signer: h.signer},
+		},
+		{
+			SHA:           h.merge.String(),
+			Message:       "Merge pull request #5 from bob/feature\n\nAdd feature\n",
+			CommittedDate: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC),
+			Committer:     clients.User{Login: "alice@example.com"},
+			AssociatedMergeRequest: clients.PullRequest{
+				Number:   5,
+				MergedAt: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC),
+				HeadSHA:  h.feature.String(),
+				Author:   clients.User{Login: "bob@example.com"},
+				MergedBy: clients.User{Login: "alice@example.com"},
+			},
+		},
+		{
+			SHA:           h.root.String(),
+			Message:       "Initial commit\n",
+			CommittedDate: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Committer:     clients.User{Login: "alice@example.com"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListCommits() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_gitMetadata(t *testing.T) {
+	t.Parallel()
+	h := newTestHistory(t)
+	client := newGitTestClient(t, h.dir)
+
+	releases, err := client.ListReleases()
+	if err != nil {
+		t.Fatalf("ListReleases: %v", err)
+	}
+	wantReleases := []clients.Release{
+		{
+			Tag: &clients.Tag{
+				Signature: &clients.Signature{Type: clients.SignatureTypeGPG, Signer: h.signer},
+				Annotated: true,
+			},
+			TagName:         "v1.2.0",
+			TargetCommitish: h.gerrit.String(),
+		},
+		{Tag: &clients.Tag{Annotated: true}, TagName: "v1.1.0", TargetCommitish: h.merge.String()},
+		{Tag: &clients.Tag{}, TagName: "v1.0.0", TargetCommitish: h.root.String()},
+	}
+	if diff := cmp.Diff(wantReleases, releases); diff != "" {
+		t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+	}
+
+	contributors, err := client.ListContributors()
+	if err != nil {
+		t.Fatalf("ListContributors: %v", err)
+	}
+	wantContributors := []clients.User{
+		{Login: "alice@example.com", NumContributions: 3},
+		{Login: "bob@example.com", NumContributions: 1},
+	}
+	if diff := cmp.Diff(wantContributors, contributors); diff != "" {
+		t.Errorf("ListContributors() mismatch (-want +got):\n%s", diff)
+	}
+
+	createdAt, err := client.GetCreatedAt()
+	if want := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC); err != nil || !createdAt.Equal(want) {
+		t.Errorf("GetCreatedAt() = %v, %v, want %v", createdAt, err, want)
+	}
+	branch, err := client.GetDefaultBranchName()
+	if err != nil || branch != "master" {
+		t.Errorf("GetDefaultBranchName() = %q, %v, want master", branch, err)
+	}
+}
+
+func TestClient_withoutGitHistory(t *testing.T) {
+	t.Parallel()
+	client := newGitTestClient(t, "testdata/repo0")
+	if _, err := client.ListCommits(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("ListCommits() error = %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+	if _, err := client.ListReleases(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("ListReleases() error = %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+
+	// a checkout without any commits.
+	dir := t.TempDir()
+	if _, err := git.PlainInit(dir, false); err != nil {
+		t.Fatalf("git.PlainInit: %v", err)
+	}
+	commits, err := newGitTestClient(t, dir).ListCommits()
+	if err != nil || len(commits) != 0 {
+		t.Errorf("ListCommits() = %v, %v, want no commits", commits, err)
+	}
+}
@@ -172,6 +172,11 @@ func (c *client) IsArchived() (bool, error) {
 	return false, fmt.Errorf("IsArchived: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivate implements RepoClient.IsPrivate.
+func (c *client) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate: %w", clients.ErrUnsupportedFeature)
+}
+
 // LocalPath implements RepoClient.LocalPath.
 func (c *client) LocalPath() (string, error) {
 	return "", fmt.Errorf("LocalPath: %w", clients.ErrUnsupportedFeature)
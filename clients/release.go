@@ -16,12 +16,27 @@ package clients
 
 // Release represents a release version of a package/repo.
 type Release struct {
+	// Tag is nil for repository hosts which don't report the tags of releases.
+	Tag             *Tag
 	TagName         string
 	URL             string
 	TargetCommitish string
 	Assets          []ReleaseAsset
 }
 
+// Tag describes the git tag of a release.
+type Tag struct {
+	// Signature is nil for unsigned tags, which includes all lightweight tags.
+	Signature *Signature
+	// Protected is true when the repository host prevents moving or deleting
+	// the tag, e.g., with a ruleset, a protected tag or an immutable release.
+	// It is nil when the protection of tags is unknown.
+	Protected *bool
+	// Annotated is false for lightweight tags, which are plain references to
+	// a commit.
+	Annotated bool
+}
+
 // ReleaseAsset is part of the Release bundle.
 type ReleaseAsset struct {
 	Name string
@@ -22,10 +22,16 @@ const (
 	RepoTypeGitHub RepoType = "GitHub"
 	// RepoTypeGitLab represents a GitLab-hosted repository.
 	RepoTypeGitLab RepoType = "GitLab"
+	// RepoTypeBitbucket represents a Bitbucket Cloud or Data Center repository.
+	RepoTypeBitbucket RepoType = "Bitbucket"
+	// RepoTypeGitea represents a repository on a Gitea or Forgejo instance.
+	RepoTypeGitea RepoType = "Gitea"
 	// RepoTypeAzureDevOps represents an Azure DevOps-hosted repository.
 	RepoTypeAzureDevOps RepoType = "Azure DevOps"
 	// RepoTypeLocal represents a local directory.
 	RepoTypeLocal RepoType = "local"
+	// RepoTypeGit represents a repository on any git host, accessed with git only.
+	RepoTypeGit RepoType = "git"
 )
 
 // Repo interface uniquely identifies a repo.
@@ -33,6 +33,8 @@ type RepoClient interface {
 	InitRepo(repo Repo, commitSHA string, commitDepth int) error
 	URI() string
 	IsArchived() (bool, error)
+	// IsPrivate returns whether the repository is not visible to the public.
+	IsPrivate() (bool, error)
 	ListFiles(predicate func(string) (bool, error)) ([]string, error)
 	// Returns an absolute path to the local repository
 	// in the format that matches the local OS
@@ -0,0 +1,187 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"golang.org/x/crypto/ssh"
+)
+
+// SignatureType is the format of a commit or tag signature.
+type SignatureType string
+
+const (
+	// SignatureTypeGPG is an OpenPGP signature.
+	SignatureTypeGPG SignatureType = "gpg"
+	// SignatureTypeSSH is a signature made with an SSH key.
+	SignatureTypeSSH SignatureType = "ssh"
+	// SignatureTypeSMIME is a CMS signature made with an X.509 certificate.
+	SignatureTypeSMIME SignatureType = "smime"
+	// SignatureTypeSigstore is a CMS signature made with a short-lived
+	// certificate of a Sigstore CA, e.g., by gitsign.
+	SignatureTypeSigstore SignatureType = "sigstore"
+	// SignatureTypeUnknown is a signature in any other format.
+	SignatureTypeUnknown SignatureType = "unknown"
+)
+
+// Signature is the signature of a commit or tag.
+type Signature struct {
+	Type SignatureType
+	// Signer identifies who signed: the account the repository host matched
+	// the key to or, failing that, the OpenPGP key ID, the SSH key fingerprint
+	// or the identity in the certificate.
+	Signer string
+	// State is the verification state reported by the repository host,
+	// e.g., "VALID" or "unverified_key". It is empty for local repositories.
+	State string
+	// Verified is true when the repository host verified the signature with a
+	// key or certificate of the signer.
+	Verified bool
+}
+
+// fulcioOID is the prefix of the X.509 extensions of certificates issued by
+// Fulcio, the Sigstore CA.
+var fulcioOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1}
+
+// ParseSignature returns the type and signer of an armored signature, as
+// stored in the gpgsig header of git objects. The signature isn't verified.
+func ParseSignature(armored string) *Signature {
+	armored = strings.TrimSpace(armored)
+	switch {
+	case strings.HasPrefix(armored, "-----BEGIN PGP SIGNATURE-----"):
+		return &Signature{Type: SignatureTypeGPG, Signer: pgpKeyID(armored)}
+	case strings.HasPrefix(armored, "-----BEGIN SSH SIGNATURE-----"):
+		return &Signature{Type: SignatureTypeSSH, Signer: sshFingerprint(armored)}
+	case strings.HasPrefix(armored, "-----BEGIN SIGNED MESSAGE-----"),
+		strings.HasPrefix(armored, "-----BEGIN CMS-----"),
+		strings.HasPrefix(armored, "-----BEGIN PKCS7-----"):
+		return parseCMSSignature(armored)
+	default:
+		return &Signature{Type: SignatureTypeUnknown}
+	}
+}
+
+func pgpKeyID(armored string) string {
+	block, err := armor.Decode(strings.NewReader(armored))
+	if err != nil {
+		return ""
+	}
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return ""
+	}
+	sig, ok := p.(*packet.Signature)
+	switch {
+	case !ok:
+		return ""
+	case len(sig.IssuerFingerprint) > 0:
+		return fmt.Sprintf("%X", sig.IssuerFingerprint)
+	case sig.IssuerKeyId != nil:
+		return fmt.Sprintf("%016X", *sig.IssuerKeyId)
+	default:
+		return ""
+	}
+}
+
+// sshFingerprint returns the fingerprint of the public key in an SSHSIG blob:
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+func sshFingerprint(armored string) string {
+	block, _ := pem.Decode([]byte(armored))
+	if block == nil {
+		return ""
+	}
+	blob, ok := bytes.CutPrefix(block.Bytes, []byte("SSHSIG"))
+	// the version precedes the length of the public key.
+	if !ok || len(blob) < 8 {
+		return ""
+	}
+	n := binary.BigEndian.Uint32(blob[4:8])
+	if uint64(len(blob)-8) < uint64(n) {
+		return ""
+	}
+	key, err := ssh.ParsePublicKey(blob[8 : 8+n])
+	if err != nil {
+		return ""
+	}
+	return ssh.FingerprintSHA256(key)
+}
+
+// signedData is the SignedData content of a CMS message, RFC 5652 section 5.1.
+//
+//nolint:govet // the fields are in the order of the ASN.1 sequence.
+type signedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+func parseCMSSignature(armored string) *Signature {
+	sig := &Signature{Type: SignatureTypeSMIME}
+	cert := cmsCertificate(armored)
+	if cert == nil {
+		return sig
+	}
+	for _, ext := range cert.Extensions {
+		if len(ext.Id) > len(fulcioOID) && ext.Id[:len(fulcioOID)].Equal(fulcioOID) {
+			sig.Type = SignatureTypeSigstore
+			break
+		}
+	}
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		sig.Signer = cert.EmailAddresses[0]
+	case len(cert.URIs) > 0:
+		sig.Signer = cert.URIs[0].String()
+	default:
+		sig.Signer = cert.Subject.String()
+	}
+	return sig
+}
+
+// cmsCertificate returns the first certificate embedded in a CMS signature.
+func cmsCertificate(armored string) *x509.Certificate {
+	block, _ := pem.Decode([]byte(armored))
+	if block == nil {
+		return nil
+	}
+	var contentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue `asn1:"explicit,tag:0"`
+	}
+	if _, err := asn1.Unmarshal(block.Bytes, &contentInfo); err != nil {
+		return nil
+	}
+	var sd signedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &sd); err != nil {
+		return nil
+	}
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil || len(certs) == 0 {
+		return nil
+	}
+	return certs[0]
+}
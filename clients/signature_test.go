@@ -0,0 +1,97 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSignature(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want *Signature
+		name string
+		file string
+	}{
+		{
+			name: "gpg",
+			file: "gpg.asc",
+			want: &Signature{Type: SignatureTypeGPG, Signer: "0536F80E1E02DA6042A1BB3C127CF438218509B8"},
+		},
+		{
+			name: "ssh",
+			file: "ssh.sig",
+			want: &Signature{Type: SignatureTypeSSH, Signer: "SHA256:AEbDjTrCOfJQ3NSynVgngqXPFiotdnIzbE/NHM64v80"},
+		},
+		{
+			name: "s/mime",
+			file: "smime.pem",
+			want: &Signature{Type: SignatureTypeSMIME, Signer: "bob@example.com"},
+		},
+		{
+			name: "gitsign",
+			file: "gitsign.pem",
+			want: &Signature{Type: SignatureTypeSigstore, Signer: "carol@example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile("testdata/signatures/" + tt.file)
+			if err != nil {
+				t.Fatalf("os.ReadFile: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, ParseSignature(string(content))); diff != "" {
+				t.Errorf("ParseSignature() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseSignature_Invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want  *Signature
+		name  string
+		input string
+	}{
+		{
+			name:  "unknown format",
+			input: "not a signature",
+			want:  &Signature{Type: SignatureTypeUnknown},
+		},
+		{
+			name:  "truncated gpg",
+			input: "-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----",
+			want:  &Signature{Type: SignatureTypeGPG},
+		},
+		{
+			name:  "malformed cms",
+			input: "-----BEGIN SIGNED MESSAGE-----\nAAAA\n-----END SIGNED MESSAGE-----",
+			want:  &Signature{Type: SignatureTypeSMIME},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, ParseSignature(tt.input)); diff != "" {
+				t.Errorf("ParseSignature() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+
+	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+)
+
+var (
+	errInvalidAzureDevOpsOrg = errors.New("not an azure devops organization or project")
+	errUnexpectedStatus      = errors.New("unexpected status")
+)
+
+// IsAzureDevOpsOrg reports whether input names an Azure DevOps organization,
+// or a project of one.
+func IsAzureDevOpsOrg(input string) bool {
+	return azuredevopsrepo.HasAzureDevOpsHost(strings.TrimSpace(input))
+}
+
+// ListAzureDevOpsRepos lists the enabled repositories of an Azure DevOps
+// organization or project, skipping forks. The AZURE_DEVOPS_AUTH_TOKEN
+// environment variable is used to authenticate. If rt is nil,
+// http.DefaultTransport is used.
+func ListAzureDevOpsRepos(ctx context.Context, orgName string, rt http.RoundTripper) ([]string, error) {
+	org, project := parseAzureDevOpsOrg(orgName)
+	if org == "" {
+		return nil, fmt.Errorf("%w: %s", errInvalidAzureDevOpsOrg, orgName)
+	}
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	// https://learn.microsoft.com/en-us/rest/api/azure/devops/git/repositories/list
+	u := "https://dev.azure.com/" + url.PathEscape(org)
+	if project != "" {
+		u += "/" + url.PathEscape(project)
+	}
+	u += "/_apis/git/repositories?api-version=7.1"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if token := os.Getenv("AZURE_DEVOPS_AUTH_TOKEN"); token != "" {
+		req.Header.Set("Authorization", azuredevops.CreateBasicAuthHeaderValue("", token))
+	}
+
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repos: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list repos: %w: %s", errUnexpectedStatus, resp.Status)
+	}
+
+	var repos struct {
+		Value []git.GitRepository `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
+		return nil, fmt.Errorf("decoding repos: %w", err)
+	}
+
+	var urls []string
+	for i := range repos.Value {
+		r := &repos.Value[i]
+		if isTrue(r.IsDisabled) || isTrue(r.IsFork) || r.WebUrl == nil {
+			continue
+		}
+		urls = append(urls, *r.WebUrl)
+	}
+	return urls, nil
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+// parseAzureDevOpsOrg extracts the organization, and optionally the project,
+// from a supported input.
+// Supported:
+//   - dev.azure.com/org > org
+//   - https://dev.azure.com/org/project > org, project
+//   - org.visualstudio.com > org
+//   - org.visualstudio.com/DefaultCollection/project > org, project
+//
+// Returns an empty organization if none can be parsed.
+func parseAzureDevOpsOrg(input string) (org, project string) {
+	s := strings.TrimSpace(input)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", ""
+	}
+
+	host := strings.ToLower(u.Hostname())
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if segments[0] == "" {
+		segments = nil
+	}
+	switch {
+	case host == "dev.azure.com":
+		if len(segments) == 0 {
+			return "", ""
+		}
+		org, segments = segments[0], segments[1:]
+	case strings.HasSuffix(host, ".visualstudio.com"):
+		org = strings.TrimSuffix(host, ".visualstudio.com")
+		if len(segments) > 0 && strings.EqualFold(segments[0], "DefaultCollection") {
+			segments = segments[1:]
+		}
+	default:
+		return "", ""
+	}
+	if len(segments) > 1 || strings.Contains(org, ".") {
+		return "", ""
+	}
+	if len(segments) == 1 {
+		project = segments[0]
+	}
+	return org, project
+}
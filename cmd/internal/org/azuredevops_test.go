@@ -0,0 +1,82 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseAzureDevOpsOrg(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in          string
+		wantOrg     string
+		wantProject string
+	}{
+		{"dev.azure.com/org", "org", ""},
+		{"https://dev.azure.com/org/project/", "org", "project"},
+		{"org.visualstudio.com", "org", ""},
+		{"https://org.visualstudio.com/DefaultCollection/project", "org", "project"},
+		{"dev.azure.com", "", ""},
+		{"dev.azure.com/org/project/_git/repo", "", ""},
+		{"github.com/owner", "", ""},
+	}
+	for _, c := range cases {
+		org, project := parseAzureDevOpsOrg(c.in)
+		if org != c.wantOrg || project != c.wantProject {
+			t.Errorf("parseAzureDevOpsOrg(%q) = %q, %q; want %q, %q", c.in, org, project, c.wantOrg, c.wantProject)
+		}
+	}
+}
+
+// Test ListAzureDevOpsRepos filters disabled and forked repositories.
+func TestListAzureDevOpsRepos(t *testing.T) {
+	t.Parallel()
+	body := `{"count": 3, "value": [
+		{"webUrl": "https://dev.azure.com/org/project/_git/repo1", "isDisabled": true},
+		{"webUrl": "https://dev.azure.com/org/project/_git/repo2", "isDisabled": false},
+		{"webUrl": "https://dev.azure.com/org/project/_git/fork", "isFork": true}
+	]}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/org/project/_apis/git/repositories" {
+			http.NotFound(w, r)
+			return
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	rt := roundTripperToServer(srv.URL)
+	repos, err := ListAzureDevOpsRepos(context.Background(), "dev.azure.com/org/project", rt)
+	if err != nil {
+		t.Fatalf("ListAzureDevOpsRepos returned error: %v", err)
+	}
+	want := []string{"https://dev.azure.com/org/project/_git/repo2"}
+	if diff := cmp.Diff(want, repos); diff != "" {
+		t.Errorf("ListAzureDevOpsRepos() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := ListAzureDevOpsRepos(context.Background(), "dev.azure.com/other", rt); err == nil {
+		t.Error("expected error for an unknown organization")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+var errInvalidGitLabGroup = errors.New("not a gitlab group")
+
+// IsGitLabGroup reports whether input names a group on gitlab.com or on a
+// self-managed instance, which is either hosted at a gitlab.* subdomain or
+// configured with the GL_HOST environment variable.
+func IsGitLabGroup(input string) bool {
+	_, group := parseGitLabGroup(input)
+	return group != ""
+}
+
+// ListGitLabGroupRepos lists the non-archived projects of a GitLab group and
+// its subgroups, skipping forks and projects shared with the group. The
+// GITLAB_AUTH_TOKEN environment variable is used to authenticate. If rt is
+// nil, http.DefaultTransport is used.
+func ListGitLabGroupRepos(ctx context.Context, groupName string, rt http.RoundTripper) ([]string, error) {
+	baseURL, group := parseGitLabGroup(groupName)
+	if group == "" {
+		return nil, fmt.Errorf("%w: %s", errInvalidGitLabGroup, groupName)
+	}
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	client, err := gitlab.NewClient(os.Getenv("GITLAB_AUTH_TOKEN"),
+		gitlab.WithBaseURL(baseURL),
+		gitlab.WithHTTPClient(&http.Client{Transport: rt}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create gitlab client: %w", err)
+	}
+
+	opt := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: 100},
+		Archived:         gitlab.Ptr(false),
+		IncludeSubGroups: gitlab.Ptr(true),
+		WithShared:       gitlab.Ptr(false),
+	}
+
+	var urls []string
+	for {
+		projects, resp, err := client.Groups.ListGroupProjects(group, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+
+		for _, p := range projects {
+			if p.Archived || p.ForkedFromProject != nil {
+				continue
+			}
+			urls = append(urls, p.WebURL)
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return urls, nil
+}
+
+// parseGitLabGroup splits a GitLab group URL into the base URL of the
+// instance and the full path of the group. Supported:
+//   - gitlab.com/group/subgroup > https://gitlab.com, group/subgroup
+//   - https://gitlab.example.com/group > https://gitlab.example.com, group
+//   - foo.com/bar/group > https://foo.com/bar, group (with GL_HOST=foo.com/bar)
+//
+// Returns an empty group if input isn't a GitLab group.
+func parseGitLabGroup(input string) (baseURL, group string) {
+	s := strings.TrimSpace(input)
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return "", ""
+	}
+
+	host := u.Host
+	path := strings.Trim(u.Path, "/")
+	known := strings.Contains(host, "gitlab.")
+	// self-managed instances may be hosted at a slug, see GL_HOST in gitlabrepo.
+	if h := os.Getenv("GL_HOST"); h != "" {
+		if !strings.Contains(h, "://") {
+			h = "https://" + h
+		}
+		hostURL, err := url.Parse(h)
+		if err == nil && hostURL.Host == u.Host {
+			known = true
+			if slug := strings.Trim(hostURL.Path, "/"); slug != "" {
+				// paths outside of the slug are not on the instance.
+				rest, ok := strings.CutPrefix(path, slug+"/")
+				if !ok {
+					return "", ""
+				}
+				host += "/" + slug
+				path = strings.Trim(rest, "/")
+			}
+		}
+	}
+	if !known || path == "" {
+		return "", ""
+	}
+	return u.Scheme + "://" + host, path
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package org
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseGitLabGroup(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		in          string
+		wantBaseURL string
+		wantGroup   string
+	}{
+		{"gitlab.com/group", "https://gitlab.com", "group"},
+		{"https://gitlab.com/group/subgroup/", "https://gitlab.com", "group/subgroup"},
+		{"http://gitlab.example.com/group", "http://gitlab.example.com", "group"},
+		{"gitlab.com", "", ""},
+		{"github.com/owner", "", ""},
+		{"owner", "", ""},
+		{"", "", ""},
+	}
+	for _, c := range cases {
+		baseURL, group := parseGitLabGroup(c.in)
+		if baseURL != c.wantBaseURL || group != c.wantGroup {
+			t.Errorf("parseGitLabGroup(%q) = %q, %q; want %q, %q", c.in, baseURL, group, c.wantBaseURL, c.wantGroup)
+		}
+	}
+}
+
+//nolint:paralleltest // t.Setenv is incompatible with t.Parallel
+func TestParseGitLabGroup_GLHost(t *testing.T) {
+	t.Setenv("GL_HOST", "foo.com/bar")
+	baseURL, group := parseGitLabGroup("foo.com/bar/group/subgroup")
+	if baseURL != "https://foo.com/bar" || group != "group/subgroup" {
+		t.Errorf("parseGitLabGroup() = %q, %q; want %q, %q", baseURL, group, "https://foo.com/bar", "group/subgroup")
+	}
+	if !IsGitLabGroup("foo.com/bar/group") {
+		t.Errorf("IsGitLabGroup() = false for a group under GL_HOST")
+	}
+	for _, in := range []string{"foo.com/barbaz/group", "foo.com/bar", "foo.com/other/group"} {
+		if baseURL, group := parseGitLabGroup(in); baseURL != "" || group != "" {
+			t.Errorf("parseGitLabGroup(%q) = %q, %q; want no group", in, baseURL, group)
+		}
+	}
+}
+
+// Test ListGitLabGroupRepos follows pages, includes subgroups and filters
+// archived and forked projects.
+func TestListGitLabGroupRepos(t *testing.T) {
+	t.Parallel()
+	pages := map[string]string{
+		"": `[
+			{"web_url": "https://gitlab.com/group/repo1", "archived": true},
+			{"web_url": "https://gitlab.com/group/repo2"}
+		]`,
+		"2": `[
+			{"web_url": "https://gitlab.com/group/sub/repo3"},
+			{"web_url": "https://gitlab.com/group/fork", "forked_from_project": {"id": 1}}
+		]`,
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v4/groups/group/projects" {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		if q.Get("include_subgroups") != "true" || q.Get("archived") != "false" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		page := q.Get("page")
+		if page == "" {
+			w.Header().Set("X-Next-Page", "2")
+		}
+		if _, err := w.Write([]byte(pages[page])); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	repos, err := ListGitLabGroupRepos(context.Background(), "gitlab.com/group", roundTripperToServer(srv.URL))
+	if err != nil {
+		t.Fatalf("ListGitLabGroupRepos returned error: %v", err)
+	}
+	want := []string{"https://gitlab.com/group/repo2", "https://gitlab.com/group/sub/repo3"}
+	if diff := cmp.Diff(want, repos); diff != "" {
+		t.Errorf("ListGitLabGroupRepos() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListGitLabGroupRepos_InvalidGroup(t *testing.T) {
+	t.Parallel()
+	if _, err := ListGitLabGroupRepos(context.Background(), "github.com/owner", nil); err == nil {
+		t.Fatal("expected error for a non-GitLab group")
+	}
+}
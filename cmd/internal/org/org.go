@@ -23,21 +23,34 @@ import (
 
 	"github.com/google/go-github/v82/github"
 
+	"github.com/ossf/scorecard/v5/clients/githubrepo/hosts"
 	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
 	"github.com/ossf/scorecard/v5/log"
 )
 
-// ErrNilResponse indicates the GitHub API returned a nil response object.
-var ErrNilResponse = errors.New("nil response from GitHub API")
+var (
+	// ErrNilResponse indicates the GitHub API returned a nil response object.
+	ErrNilResponse = errors.New("nil response from GitHub API")
+	errUnknownHost = errors.New("unknown GitHub host, set GH_HOST or GH_ENTERPRISE_HOSTS")
+)
 
-// ListOrgRepos lists all non-archived repositories for a GitHub organization.
-// The caller should provide an http.RoundTripper (rt). If rt is nil, the
-// default transport will be created via roundtripper.NewTransport.
+// ListOrgRepos lists all non-archived repositories for a GitHub organization,
+// on github.com or a GitHub Enterprise Server host set in GH_HOST or
+// GH_ENTERPRISE_HOSTS. The caller should provide an http.RoundTripper (rt).
+// If rt is nil, the default transport will be created via
+// roundtripper.NewTransport.
 func ListOrgRepos(ctx context.Context, orgName string, rt http.RoundTripper) ([]string, error) {
 	// Parse org name if needed.
+	host := hosts.Default()
 	if len(orgName) > 0 {
-		if parsed := parseOrgName(orgName); parsed != "" {
+		if hostName, parsed := parseOrgName(orgName); parsed != "" {
 			orgName = parsed
+			if hostName != "" {
+				var ok bool
+				if host, ok = hosts.Lookup(hostName); !ok {
+					return nil, fmt.Errorf("%w: %s", errUnknownHost, hostName)
+				}
+			}
 		}
 	}
 
@@ -50,6 +63,13 @@ func ListOrgRepos(ctx context.Context, orgName string, rt http.RoundTripper) ([]
 	}
 	httpClient := &http.Client{Transport: rt}
 	client := github.NewClient(httpClient)
+	if host.IsEnterprise() {
+		var err error
+		client, err = client.WithEnterpriseURLs(host.APIURL, host.UploadURL)
+		if err != nil {
+			return nil, fmt.Errorf("github enterprise client for %s: %w", host.Name, err)
+		}
+	}
 
 	opt := &github.RepositoryListByOrgOptions{
 		Type: "all",
@@ -81,18 +101,18 @@ func ListOrgRepos(ctx context.Context, orgName string, rt http.RoundTripper) ([]
 	return urls, nil
 }
 
-// parseOrgName extracts the GitHub organization from a supported input.
-// Supported:
-//   - owner > owner
-//   - github.com/owner > owner
-//   - http://github.com/owner > owner
-//   - https://github.com/owner > owner
+// parseOrgName extracts the GitHub host and organization from a supported
+// input. Supported:
+//   - owner > "", owner
+//   - github.com/owner > github.com, owner
+//   - http://github.com/owner > github.com, owner
+//   - https://github.corp.com/owner > github.corp.com, owner
 //
-// Returns "" if no org can be parsed.
-func parseOrgName(input string) string {
+// Returns an empty org if no org can be parsed.
+func parseOrgName(input string) (host, org string) {
 	s := strings.TrimSpace(input)
 	if s == "" {
-		return ""
+		return "", ""
 	}
 
 	// Strip optional scheme.
@@ -103,14 +123,11 @@ func parseOrgName(input string) string {
 		s = strings.TrimPrefix(s, "http://")
 	}
 
-	// If it's exactly the host, there's no org.
-	if s == "github.com" {
-		return ""
-	}
-
-	// Strip host prefix if present.
-	if after, ok := strings.CutPrefix(s, "github.com/"); ok {
-		s = after
+	// Strip host prefix if present. Hosts are told apart from orgs by their
+	// dots, which org names can't contain.
+	first, rest, _ := strings.Cut(s, "/")
+	if strings.Contains(first, ".") {
+		host, s = first, rest
 	}
 
 	// Keep only the first path segment (the org).
@@ -120,8 +137,8 @@ func parseOrgName(input string) string {
 
 	// Basic sanity: org shouldn't contain dots (to avoid host-like values).
 	if s == "" || strings.Contains(s, ".") {
-		return ""
+		return "", ""
 	}
 
-	return s
+	return host, s
 }
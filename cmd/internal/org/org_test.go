@@ -25,18 +25,22 @@ import (
 func TestParseOrgName(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
-		in   string
-		want string
+		in       string
+		wantHost string
+		want     string
 	}{
-		{"http://github.com/owner", "owner"},
-		{"https://github.com/owner", "owner"},
-		{"github.com/owner", "owner"},
-		{"owner", "owner"},
-		{"", ""},
+		{"http://github.com/owner", "github.com", "owner"},
+		{"https://github.com/owner", "github.com", "owner"},
+		{"github.com/owner", "github.com", "owner"},
+		{"owner", "", "owner"},
+		{"", "", ""},
+		{"github.com", "", ""},
+		{"https://github.corp.com/owner/repo", "github.corp.com", "owner"},
+		{"ghe.example.com:8443/owner", "ghe.example.com:8443", "owner"},
 	}
 	for _, c := range cases {
-		if got := parseOrgName(c.in); got != c.want {
-			t.Fatalf("parseOrgName(%q) = %q; want %q", c.in, got, c.want)
+		if host, got := parseOrgName(c.in); host != c.wantHost || got != c.want {
+			t.Fatalf("parseOrgName(%q) = %q, %q; want %q, %q", c.in, host, got, c.wantHost, c.want)
 		}
 	}
 }
@@ -74,6 +78,39 @@ func TestListOrgRepos_PaginationAndArchived(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest // test uses t.Setenv
+func TestListOrgRepos_Enterprise(t *testing.T) {
+	t.Setenv("GH_HOST", "")
+	t.Setenv("GH_ENTERPRISE_HOSTS", "ghe.example.com;api=https://api.example.com/")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`[{"html_url": "https://ghe.example.com/owner/repo1"}]`)); err != nil {
+			t.Errorf("failed to write response: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	var gotURL string
+	rt := httpTransportFunc(func(r *http.Request) (*http.Response, error) {
+		gotURL = r.URL.Host + r.URL.Path
+		return roundTripperToServer(srv.URL).RoundTrip(r)
+	})
+	repos, err := ListOrgRepos(t.Context(), "https://ghe.example.com/owner", rt)
+	if err != nil {
+		t.Fatalf("ListOrgRepos returned error: %v", err)
+	}
+	if len(repos) != 1 || repos[0] != "https://ghe.example.com/owner/repo1" {
+		t.Errorf("unexpected repos: %v", repos)
+	}
+	if want := "api.example.com/orgs/owner/repos"; gotURL != want {
+		t.Errorf("request URL = %q, want %q", gotURL, want)
+	}
+
+	if _, err := ListOrgRepos(t.Context(), "ghe.unknown.com/owner", roundTripperToServer(srv.URL)); err == nil {
+		t.Errorf("ListOrgRepos of an unknown host succeeded")
+	}
+}
+
 // roundTripperToServer returns an http.RoundTripper that rewrites requests
 // to the given serverURL, keeping the path and query intact.
 func roundTripperToServer(serverURL string) http.RoundTripper {
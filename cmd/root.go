@@ -20,9 +20,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/google/osv-scanner/v2/pkg/osvscanner"
 	"github.com/spf13/cobra"
@@ -31,14 +35,20 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bitbucketrepo"
+	gitclient "github.com/ossf/scorecard/v5/clients/git"
+	"github.com/ossf/scorecard/v5/clients/gitearepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
+	"github.com/ossf/scorecard/v5/clients/githubrepo/roundtripper"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
+	"github.com/ossf/scorecard/v5/clients/httpcache"
 	"github.com/ossf/scorecard/v5/clients/localdir"
 	orgpkg "github.com/ossf/scorecard/v5/cmd/internal/org"
 	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
 	docs "github.com/ossf/scorecard/v5/docs/checks"
 	sclog "github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/conformance"
 	"github.com/ossf/scorecard/v5/pkg/scorecard"
 	"github.com/ossf/scorecard/v5/policy"
 )
@@ -47,6 +57,10 @@ import (
 // error during execution.
 var errChecksFailed = errors.New("one or more checks failed during execution")
 
+// errScoreBelowThreshold is returned when the aggregate score of a repo is
+// below the --fail-under threshold.
+var errScoreBelowThreshold = errors.New("aggregate score below")
+
 const (
 	scorecardLong = "A program that shows the OpenSSF scorecard for an open source software."
 	scorecardUse  = `./scorecard (--repo=<repo> | --local=<folder> | --org=<organization> | ` +
@@ -97,9 +111,29 @@ func buildRepoURLs(ctx context.Context, o *options.Options) ([]string, error) {
 		return urls, nil
 	}
 
-	// --org: expand to all non-archived repos
+	// --org: expand to all non-archived repos of a GitHub org, GitLab group
+	// or Azure DevOps organization
 	if o.Org != "" {
-		repos, err := orgpkg.ListOrgRepos(ctx, o.Org, nil)
+		var rt http.RoundTripper
+		listRepos := orgpkg.ListOrgRepos
+		switch {
+		case orgpkg.IsGitLabGroup(o.Org), orgpkg.IsAzureDevOpsOrg(o.Org):
+			listRepos = orgpkg.ListGitLabGroupRepos
+			if orgpkg.IsAzureDevOpsOrg(o.Org) {
+				listRepos = orgpkg.ListAzureDevOpsRepos
+			}
+			if o.CacheDir != "" {
+				var err error
+				rt, err = httpcache.NewTransport(http.DefaultTransport, o.CacheDir)
+				if err != nil {
+					return nil, fmt.Errorf("httpcache.NewTransport: %w", err)
+				}
+			}
+		case o.CacheDir != "":
+			logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+			rt = roundtripper.NewTransport(ctx, logger, roundtripper.WithCacheDir(o.CacheDir))
+		}
+		repos, err := listRepos(ctx, o.Org, rt)
 		if err != nil {
 			return nil, fmt.Errorf("listing repositories for org %q: %w", o.Org, err)
 		}
@@ -148,8 +182,9 @@ func rootCmd(o *options.Options) error {
 	}
 
 	var requiredRequestTypes []checker.RequestType
-	// if local option not set add file based
-	if o.Local != "" {
+	// local directories only support file based checks, unless they are git
+	// checkouts with a history.
+	if o.Local != "" && !localdir.HasGitHistory(o.Local) {
 		requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
 	}
 	// if commit option set to anything other than HEAD add commit based
@@ -188,28 +223,93 @@ func rootCmd(o *options.Options) error {
 	if strings.EqualFold(o.FileMode, options.FileModeGit) {
 		opts = append(opts, scorecard.WithFileModeGit())
 	}
+	if o.CacheDir != "" {
+		opts = append(opts, scorecard.WithCacheDir(o.CacheDir))
+	}
+	if o.Format == options.FormatConformance {
+		framework, err := conformance.Load(o.Framework)
+		if err != nil {
+			return fmt.Errorf("loading conformance framework: %w", err)
+		}
+		opts = append(opts, scorecard.WithConformanceFramework(framework))
+	}
+
+	scanOpts := repoScanOptions{
+		enabledProbes: enabledProbes,
+		enabledChecks: enabledChecks,
+		opts:          opts,
+	}
+	if o.Parallelism > 1 {
+		// concurrent scans of GitHub repos share a transport, so that they
+		// all wait for the rate limit to reset once one of them hits it.
+		logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+		scanOpts.githubTransport = roundtripper.NewTransport(ctx, logger, roundtripper.WithCacheDir(o.CacheDir))
+	}
+
+	output := io.Writer(os.Stdout)
+	if o.ResultsFile != "" && (o.Format == options.FormatNDJSON || o.Format == options.FormatAggregate) {
+		// the results of all repos are written to the same file.
+		f, err := os.Create(o.ResultsFile)
+		if err != nil {
+			return fmt.Errorf("unable to create output file: %w", err)
+		}
+		defer f.Close()
+		output = f
+	}
+	jsonOpts := &scorecard.AsJSON2ResultOption{
+		Details:     o.ShowDetails,
+		Annotations: o.ShowAnnotations,
+		LogLevel:    sclog.ParseLevel(o.LogLevel),
+	}
 
 	// Track whether any check produced a runtime error during scans. We want to
 	// continue scanning all repos but return a non-nil error at the end so the
 	// process exit code reflects that something went wrong.
 	var sawRuntimeErr bool
-	// Iterate and scan each repo using a helper to keep rootCmd small.
-	for _, uri := range repoURLs {
-		res, err := processRepo(ctx, uri, o, enabledProbes, enabledChecks, opts, checkDocs, pol)
+	entries := make([]scorecard.AggregateEntry, len(repoURLs))
+	scanRepos(repoURLs, o.Parallelism, func(uri string) (*scorecard.Result, error) {
+		return processRepo(ctx, uri, o, &scanOpts)
+	}, func(i int, uri string, res *scorecard.Result, err error) {
+		entries[i] = scorecard.AggregateEntry{Repo: uri, Result: res, Err: err}
 		if err != nil {
 			// processRepo already logged details; skip this URI.
 			fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", uri, err)
-			continue
+			return
 		}
 
-		// If any checks had runtime errors, remember that fact so we can return
-		// a non-zero exit code after processing all repos.
-		for _, c := range res.Checks {
-			if c.Error != nil {
+		switch o.Format {
+		case options.FormatAggregate:
+			// written once all repos are scanned.
+		case options.FormatNDJSON:
+			if err := res.AsJSON2(output, checkDocs, jsonOpts); err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to format results for %s: %v\n", uri, err)
+			}
+		default:
+			if err := scorecard.FormatResults(o, res, checkDocs, pol); err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to format results for %s: %v\n", uri, err)
+			}
+		}
+
+		// Surface per-check runtime errors (non-fatal), and remember that
+		// fact so we can return a non-zero exit code after processing all repos.
+		for _, r := range res.Checks {
+			if r.Error != nil {
+				fmt.Fprintf(os.Stderr, "Check %s failed for %s: %v\n", r.Name, uri, r.Error)
 				sawRuntimeErr = true
-				break
 			}
 		}
+	})
+
+	if o.Format == options.FormatAggregate {
+		if err := scorecard.AsAggregateJSON(output, entries, checkDocs, jsonOpts); err != nil {
+			return fmt.Errorf("formatting results: %w", err)
+		}
+	}
+
+	if o.FailUnder > 0 {
+		if err := checkFailUnder(entries, checkDocs, o.FailUnder); err != nil {
+			return err
+		}
 	}
 
 	if sawRuntimeErr {
@@ -219,6 +319,57 @@ func rootCmd(o *options.Options) error {
 	return nil
 }
 
+// scanRepos scans uris with up to parallelism scans at once. report is called
+// with the outcome of each scan as it completes, one call at a time.
+func scanRepos(
+	uris []string,
+	parallelism int,
+	scan func(uri string) (*scorecard.Result, error),
+	report func(i int, uri string, res *scorecard.Result, err error),
+) {
+	sem := make(chan struct{}, max(parallelism, 1))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, uri := range uris {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			res, err := scan(uri)
+			mu.Lock()
+			defer mu.Unlock()
+			report(i, uri, res, err)
+		}()
+	}
+	wg.Wait()
+}
+
+// checkFailUnder returns an error listing the repos whose aggregate score is
+// below threshold, or which couldn't be scanned.
+func checkFailUnder(entries []scorecard.AggregateEntry, checkDocs docs.Doc, threshold float64) error {
+	var failed []string
+	for _, entry := range entries {
+		if entry.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s (not scanned)", entry.Repo))
+			continue
+		}
+		score, err := entry.Result.GetAggregateScore(checkDocs)
+		if err != nil {
+			return fmt.Errorf("computing score of %s: %w", entry.Repo, err)
+		}
+		if score < threshold {
+			failed = append(failed, fmt.Sprintf("%s (%.1f)", entry.Repo, score))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%w %.1f: %s", errScoreBelowThreshold, threshold, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
 func printProbeStart(repo string, enabledProbes []string) {
 	for _, probeName := range enabledProbes {
 		fmt.Fprintf(os.Stderr, "Starting (%s) probe [%s]\n", repo, probeName)
@@ -244,29 +395,50 @@ func printCheckResults(repo string, enabledChecks checker.CheckNameToFnMap) {
 }
 
 // makeRepo helps turn a URI into the appropriate clients.Repo.
-// currently this is a decision between GitHub, GitLab, and Azure DevOps,
-// but may expand in the future.
+// currently this is a decision between GitHub, GitLab, Azure DevOps and
+// plain git hosts, but may expand in the future.
 func makeRepo(uri string) (clients.Repo, error) {
 	var repo clients.Repo
-	var errGitHub, errGitLab, errAzureDevOps error
+	var errGitHub, errGitLab, errAzureDevOps, errBitbucket, errGitea, errGit error
 	var compositeErr error
 
+	if gitclient.HasGitScheme(uri) {
+		repo, errGit = gitclient.MakeGitRepo(uri)
+		if errGit != nil {
+			return nil, fmt.Errorf("unable to parse git repository URI: %w", errGit)
+		}
+		return repo, nil
+	}
+
 	repo, errGitHub = githubrepo.MakeGithubRepo(uri)
 	if errGitHub == nil {
 		return repo, nil
 	}
 	compositeErr = errors.Join(compositeErr, errGitHub)
 
-	_, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL")
-	if experimental {
-		repo, errAzureDevOps = azuredevopsrepo.MakeAzureDevOpsRepo(uri)
-		if errAzureDevOps == nil {
-			return repo, nil
+	repo, errAzureDevOps = azuredevopsrepo.MakeAzureDevOpsRepo(uri)
+	if errAzureDevOps == nil {
+		return repo, nil
+	}
+	compositeErr = errors.Join(compositeErr, errAzureDevOps)
+	if azuredevopsrepo.HasAzureDevOpsHost(uri) {
+		return nil, fmt.Errorf("unable to parse Azure DevOps repository URI: %w", errAzureDevOps)
+	}
+
+	if bitbucketrepo.HasBitbucketHost(uri) {
+		repo, errBitbucket = bitbucketrepo.MakeBitbucketRepo(uri)
+		if errBitbucket != nil {
+			return nil, fmt.Errorf("unable to parse Bitbucket repository URI: %w", errBitbucket)
 		}
-		compositeErr = errors.Join(compositeErr, errAzureDevOps)
-		if azuredevopsrepo.HasAzureDevOpsHost(uri) {
-			return nil, fmt.Errorf("unable to parse Azure DevOps repository URI: %w", errAzureDevOps)
+		return repo, nil
+	}
+
+	if gitearepo.HasGiteaHost(uri) {
+		repo, errGitea = gitearepo.MakeGiteaRepo(uri)
+		if errGitea != nil {
+			return nil, fmt.Errorf("unable to parse Gitea repository URI: %w", errGitea)
 		}
+		return repo, nil
 	}
 
 	repo, errGitLab = gitlabrepo.MakeGitlabRepo(uri)
@@ -275,25 +447,40 @@ func makeRepo(uri string) (clients.Repo, error) {
 	}
 	compositeErr = errors.Join(compositeErr, errGitLab)
 
-	return nil, fmt.Errorf("unable to parse as github, gitlab, or azuredevops: %w", compositeErr)
+	// self-hosted Gitea and Forgejo instances are recognized by their API.
+	repo, errGitea = gitearepo.MakeGiteaRepo(uri)
+	if errGitea == nil {
+		return repo, nil
+	}
+	compositeErr = errors.Join(compositeErr, errGitea)
+
+	// any other git host, e.g. Bitbucket Server or cgit mirrors.
+	repo, errGit = gitclient.MakeGitRepo(uri)
+	if errGit == nil {
+		return repo, nil
+	}
+	compositeErr = errors.Join(compositeErr, errGit)
+
+	return nil, fmt.Errorf("unable to parse as github, gitlab, azuredevops, bitbucket, gitea, or git: %w", compositeErr)
+}
+
+// repoScanOptions are the options shared by the scans of all repos.
+type repoScanOptions struct {
+	// githubTransport is shared by the clients of all GitHub repos, if set.
+	githubTransport http.RoundTripper
+	enabledChecks   checker.CheckNameToFnMap
+	enabledProbes   []string
+	opts            []scorecard.Option
 }
 
-// processRepo performs the scanning and formatting for a single repo URI.
+// processRepo performs the scanning of a single repo URI.
 // It returns the Result when successful or an error describing why the URI
 // should be skipped.
-func processRepo(
-	ctx context.Context,
-	uri string,
-	o *options.Options,
-	enabledProbes []string,
-	enabledChecks checker.CheckNameToFnMap,
-	opts []scorecard.Option,
-	checkDocs docs.Doc,
-	pol *policy.ScorecardPolicy,
-) (*scorecard.Result, error) {
+func processRepo(ctx context.Context, uri string, o *options.Options, s *repoScanOptions) (*scorecard.Result, error) {
 	var repo clients.Repo
 	var err error
 
+	opts := s.opts
 	if o.Local != "" && uri == o.Local {
 		repo, err = localdir.MakeLocalDirRepo(uri)
 		if err != nil {
@@ -304,14 +491,25 @@ func processRepo(
 		if err != nil {
 			return nil, err
 		}
+		if _, ok := repo.(*githubrepo.Repo); ok && s.githubTransport != nil {
+			clientOpts := []githubrepo.Option{githubrepo.WithRoundTripper(s.githubTransport)}
+			if strings.EqualFold(o.FileMode, options.FileModeGit) {
+				clientOpts = append(clientOpts, githubrepo.WithFileModeGit())
+			}
+			client, err := githubrepo.NewRepoClient(ctx, clientOpts...)
+			if err != nil {
+				return nil, fmt.Errorf("creating github client: %w", err)
+			}
+			opts = append(slices.Clip(opts), scorecard.WithRepoClient(client))
+		}
 	}
 
 	// Start banners with repo uri (show banners in default format only)
 	if o.Format == options.FormatDefault {
-		if len(enabledProbes) > 0 {
-			printProbeStart(uri, enabledProbes)
+		if len(s.enabledProbes) > 0 {
+			printProbeStart(uri, s.enabledProbes)
 		} else {
-			printCheckStart(uri, enabledChecks)
+			printCheckStart(uri, s.enabledChecks)
 		}
 	}
 
@@ -329,24 +527,13 @@ func processRepo(
 
 	// End banners BEFORE RESULTS
 	if o.Format == options.FormatDefault {
-		if len(enabledProbes) > 0 {
-			printProbeResults(uri, enabledProbes)
+		if len(s.enabledProbes) > 0 {
+			printProbeResults(uri, s.enabledProbes)
 		} else {
-			printCheckResults(uri, enabledChecks)
+			printCheckResults(uri, s.enabledChecks)
 			fmt.Fprintln(os.Stderr, "\nRESULTS\n-------")
 		}
 	}
 
-	if err := scorecard.FormatResults(o, &result, checkDocs, pol); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to format results for %s: %v\n", uri, err)
-	}
-
-	// Surface per-check runtime errors (non-fatal)
-	for _, r := range result.Checks {
-		if r.Error != nil {
-			fmt.Fprintf(os.Stderr, "Check %s failed for %s: %v\n", r.Name, uri, r.Error)
-		}
-	}
-
 	return &result, nil
 }
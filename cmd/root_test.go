@@ -15,14 +15,18 @@
 package cmd
 
 import (
+	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	"github.com/ossf/scorecard/v5/pkg/scorecard"
 )
 
 func TestMakeRepoLegacyAzureDevOpsURL(t *testing.T) {
-	t.Setenv("SCORECARD_EXPERIMENTAL", "1")
-
 	repo, err := makeRepo(
 		"https://dnceng-public.visualstudio.com/DefaultCollection/public/_git/public",
 	)
@@ -38,8 +42,6 @@ func TestMakeRepoLegacyAzureDevOpsURL(t *testing.T) {
 }
 
 func TestMakeRepoRejectsMalformedAzureDevOpsURL(t *testing.T) {
-	t.Setenv("SCORECARD_EXPERIMENTAL", "1")
-
 	tests := []string{
 		"http://teamgitlab.visualstudio.com/project/_git/repo",
 		"https://teamgitlab.visualstudio.com:8443/project/_git/repo",
@@ -50,3 +52,139 @@ func TestMakeRepoRejectsMalformedAzureDevOpsURL(t *testing.T) {
 		}
 	}
 }
+
+func TestMakeRepoGit(t *testing.T) {
+	t.Parallel()
+	tests := []string{
+		"git+https://gitea.example.com/owner/repo",
+		"ssh://git@gitea.example.com/owner/repo.git",
+	}
+	for _, repoURI := range tests {
+		repo, err := makeRepo(repoURI)
+		if err != nil {
+			t.Fatalf("makeRepo(%q) error = %v", repoURI, err)
+		}
+		if got, want := repo.Type(), clients.RepoTypeGit; got != want {
+			t.Errorf("makeRepo(%q).Type() = %q, want %q", repoURI, got, want)
+		}
+	}
+}
+
+func TestMakeRepoGitea(t *testing.T) {
+	t.Parallel()
+	repo, err := makeRepo("https://codeberg.org/forgejo/forgejo")
+	if err != nil {
+		t.Fatalf("makeRepo() error = %v", err)
+	}
+	if got, want := repo.Type(), clients.RepoTypeGitea; got != want {
+		t.Errorf("makeRepo().Type() = %q, want %q", got, want)
+	}
+}
+
+func TestMakeRepoBitbucket(t *testing.T) {
+	t.Parallel()
+	for _, uri := range []string{
+		"https://bitbucket.org/atlassian/python-bitbucket",
+		"https://git.example.com/projects/OSSF/repos/scorecard",
+	} {
+		repo, err := makeRepo(uri)
+		if err != nil {
+			t.Fatalf("makeRepo(%q) error = %v", uri, err)
+		}
+		if got, want := repo.Type(), clients.RepoTypeBitbucket; got != want {
+			t.Errorf("makeRepo(%q).Type() = %q, want %q", uri, got, want)
+		}
+	}
+}
+
+func TestScanRepos(t *testing.T) {
+	t.Parallel()
+	uris := []string{"a", "b", "c", "d", "e"}
+	var running, maxRunning atomic.Int32
+	reported := make([]string, len(uris))
+	var reporting atomic.Bool
+	scanRepos(uris, 2, func(uri string) (*scorecard.Result, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if uri == "c" {
+			return nil, errScan
+		}
+		return &scorecard.Result{Repo: scorecard.RepoInfo{Name: uri}}, nil
+	}, func(i int, uri string, res *scorecard.Result, err error) {
+		if !reporting.CompareAndSwap(false, true) {
+			t.Error("report called concurrently")
+		}
+		defer reporting.Store(false)
+		if (err != nil) != (uri == "c") {
+			t.Errorf("report(%s) error = %v", uri, err)
+		}
+		reported[i] = uri
+	})
+
+	for i, uri := range uris {
+		if reported[i] != uri {
+			t.Errorf("reported[%d] = %q, want %q", i, reported[i], uri)
+		}
+	}
+	if n := maxRunning.Load(); n != 2 {
+		t.Errorf("%d scans ran at once, want 2", n)
+	}
+}
+
+func TestCheckFailUnder(t *testing.T) {
+	t.Parallel()
+	checkDocs, err := docs.Read()
+	if err != nil {
+		t.Fatalf("docs.Read: %v", err)
+	}
+	result := func(score int) *scorecard.Result {
+		return &scorecard.Result{Checks: []checker.CheckResult{{Name: "Code-Review", Score: score}}}
+	}
+	tests := []struct {
+		name    string
+		entries []scorecard.AggregateEntry
+		wantErr bool
+	}{
+		{
+			name: "all above",
+			entries: []scorecard.AggregateEntry{
+				{Repo: "a", Result: result(10)},
+				{Repo: "b", Result: result(7)},
+			},
+		},
+		{
+			name: "one below",
+			entries: []scorecard.AggregateEntry{
+				{Repo: "a", Result: result(10)},
+				{Repo: "b", Result: result(3)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "not scanned",
+			entries: []scorecard.AggregateEntry{
+				{Repo: "a", Err: errScan},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := checkFailUnder(tt.entries, checkDocs, 7)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkFailUnder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, errScoreBelowThreshold) {
+				t.Errorf("checkFailUnder() error = %v, want %v", err, errScoreBelowThreshold)
+			}
+		})
+	}
+}
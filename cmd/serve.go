@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -24,6 +25,7 @@ import (
 	"os"
 	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -32,6 +34,7 @@ import (
 
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
+	gitclient "github.com/ossf/scorecard/v5/clients/git"
 	pmc "github.com/ossf/scorecard/v5/cmd/internal/packagemanager"
 	docs "github.com/ossf/scorecard/v5/docs/checks"
 	"github.com/ossf/scorecard/v5/log"
@@ -40,8 +43,23 @@ import (
 	"github.com/ossf/scorecard/v5/policy"
 )
 
+var (
+	errInvalidRequest     = errors.New("invalid request")
+	errInvalidServeConfig = errors.New("invalid serve configuration")
+)
+
+// scanFunc runs scorecard with the given options and returns the JSON result.
+type scanFunc func(ctx context.Context, opts *options.Options) ([]byte, error)
+
 type server struct {
 	logger *log.Logger
+	scan   scanFunc
+	// results caches and coalesces scans, if set.
+	results *resultCache
+	jobs    *jobManager
+	// gitHosts are the hosts on which repos may be scanned with plain git.
+	gitHosts []string
+	batch    batchConfig
 }
 
 type scorecardRequest struct {
@@ -60,17 +78,21 @@ type scorecardRequest struct {
 }
 
 func newServer(logger *log.Logger) *server {
-	return &server{
+	s := &server{
 		logger: logger,
+		batch:  batchConfig{concurrency: 8, size: 200},
 	}
+	s.scan = s.runScorecard
+	return s
 }
 
-func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
+// parseRequest reads a scorecardRequest from the JSON body of POST requests,
+// or from the query parameters otherwise.
+func parseRequest(r *http.Request) (scorecardRequest, error) {
 	var req scorecardRequest
 	if r.Method == http.MethodPost {
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			http.Error(w, "invalid request body", http.StatusBadRequest)
-			return
+			return req, fmt.Errorf("%w: invalid request body", errInvalidRequest)
 		}
 	} else {
 		req.Repo = r.URL.Query().Get("repo")
@@ -85,6 +107,14 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 		req.Probes = strings.Split(r.URL.Query().Get("probes"), ",")
 		req.FileMode = r.URL.Query().Get("file_mode")
 	}
+	return req, nil
+}
+
+// requestOptions converts a request into validated options.
+func (s *server) requestOptions(req *scorecardRequest) (*options.Options, error) {
+	if err := s.checkRepoURI(req.Repo); err != nil {
+		return nil, err
+	}
 
 	// Create a new options instance for each request to avoid race conditions
 	opts := options.New()
@@ -115,36 +145,121 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 
 	// Validate options
 	if err := opts.Validate(); err != nil {
-		http.Error(w, fmt.Sprintf("invalid options: %v", err), http.StatusBadRequest)
+		return nil, fmt.Errorf("%w: invalid options: %w", errInvalidRequest, err)
+	}
+	return opts, nil
+}
+
+// checkRepoURI rejects the repo URIs which would make the server reach a
+// host of the caller's choosing: local repos, and URLs only reachable with
+// plain git, such as ssh:// and git+ URLs, on hosts not in s.gitHosts.
+func (s *server) checkRepoURI(uri string) error {
+	// the server's file system is not for callers to scan.
+	if strings.Contains(strings.ToLower(uri), "file://") {
+		return fmt.Errorf("%w: local repositories can't be scanned", errInvalidRequest)
+	}
+	if !gitclient.HasGitScheme(uri) {
+		return nil
+	}
+	repo, err := gitclient.MakeGitRepo(uri)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidRequest, err)
+	}
+	return s.checkGitHost(repo)
+}
+
+func (s *server) checkGitHost(repo clients.Repo) error {
+	if repo.Type() != clients.RepoTypeGit {
+		return nil
+	}
+	for _, h := range s.gitHosts {
+		if strings.EqualFold(h, repo.Host()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: repositories on %s can't be scanned with plain git", errInvalidRequest, repo.Host())
+}
+
+// makeRepo makes the repo of a scan, which may only be accessed with plain
+// git on the hosts in s.gitHosts.
+func (s *server) makeRepo(uri string) (clients.Repo, error) {
+	if err := s.checkRepoURI(uri); err != nil {
+		return nil, err
+	}
+	repo, err := makeRepo(uri)
+	if err != nil {
+		return nil, fmt.Errorf("making remote repo: %w", err)
+	}
+	if err := s.checkGitHost(repo); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	opts, err := s.requestOptions(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var result cachedResult
+	if s.results != nil {
+		result, err = s.results.get(r.Context(), opts)
+	} else {
+		var body []byte
+		body, err = s.scan(r.Context(), opts)
+		result = newCachedResult(body, time.Now())
+	}
+	if err != nil {
+		s.logger.Error(err, "running scorecard")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", result.etag)
+	w.Header().Set("Age", strconv.Itoa(int(time.Since(result.created).Seconds())))
+	if r.Header.Get("If-None-Match") == result.etag {
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
+	// Return results
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(result.body); err != nil {
+		s.logger.Error(err, "writing JSON response")
+	}
+}
+
+// runScorecard is the default scanFunc.
+func (s *server) runScorecard(ctx context.Context, opts *options.Options) ([]byte, error) {
 	p := &pmc.PackageManagerClient{}
 	// Set repo from package managers
 	pkgResp, err := fetchGitRepositoryFromPackageManagers(opts.NPM, opts.PyPI, opts.RubyGems, opts.Nuget, p)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("fetchGitRepositoryFromPackageManagers: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("fetchGitRepositoryFromPackageManagers: %w", err)
 	}
 	if pkgResp.exists {
 		opts.Repo = pkgResp.associatedRepo
 	}
 
-	ctx := r.Context()
-
 	var repo clients.Repo
 
-	repo, err = makeRepo(opts.Repo)
+	// repos of package managers are checked like requested repos.
+	repo, err = s.makeRepo(opts.Repo)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("making remote repo: %v", err), http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
 	// Read docs
 	checkDocs, err := docs.Read()
 	if err != nil {
-		http.Error(w, fmt.Sprintf("cannot read yaml file: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("cannot read yaml file: %w", err)
 	}
 
 	var requiredRequestTypes []checker.RequestType
@@ -156,8 +271,7 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 	}
 	enabledChecks, err := policy.GetEnabled(nil, opts.Checks(), requiredRequestTypes, repo.Type())
 	if err != nil {
-		http.Error(w, fmt.Sprintf("GetEnabled: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("GetEnabled: %w", err)
 	}
 
 	checks := make([]string, 0, len(enabledChecks))
@@ -181,9 +295,7 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 
 	repoResult, err := scorecard.Run(ctx, repo, scorecardOpts...)
 	if err != nil {
-		s.logger.Error(err, "scorecard.Run")
-		http.Error(w, fmt.Sprintf("scorecard.Run: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("scorecard.Run: %w", err)
 	}
 
 	repoResult.Metadata = append(repoResult.Metadata, opts.Metadata...)
@@ -193,30 +305,15 @@ func (s *server) handleScorecard(w http.ResponseWriter, r *http.Request) {
 		return repoResult.Checks[i].Name < repoResult.Checks[j].Name
 	})
 
-	// Return results
-	w.Header().Set("Content-Type", "application/json")
-	if err := repoResult.AsJSON2(w, checkDocs, &scorecard.AsJSON2ResultOption{
+	var buf bytes.Buffer
+	if err := repoResult.AsJSON2(&buf, checkDocs, &scorecard.AsJSON2ResultOption{
 		LogLevel:    log.ParseLevel(opts.LogLevel),
 		Details:     opts.ShowDetails,
 		Annotations: opts.ShowAnnotations,
 	}); err != nil {
-		s.logger.Error(err, "writing JSON response")
-		http.Error(w, fmt.Sprintf("failed to format results: %v", err), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("failed to format results: %w", err)
 	}
-}
-
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type")
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-		next.ServeHTTP(w, r)
-	})
+	return buf.Bytes(), nil
 }
 
 func recoverMiddleware(next http.Handler) http.Handler {
@@ -231,23 +328,142 @@ func recoverMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-func loggerMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
-		next.ServeHTTP(w, r)
-		stdlog.Printf("request completed method=%s path=%s duration=%v", r.Method, r.URL.Path, time.Since(start))
-	})
+// jobManagerConfigFromEnv configures the job API from the environment, like
+// the PORT the server listens on.
+func jobManagerConfigFromEnv() (jobManagerConfig, error) {
+	config := jobManagerConfig{
+		resultsDir: os.Getenv("SCORECARD_SERVE_RESULTS_DIR"),
+		workers:    4,
+		queueSize:  100,
+		ttl:        time.Hour,
+	}
+	for name, dst := range map[string]*int{
+		"SCORECARD_SERVE_WORKERS":    &config.workers,
+		"SCORECARD_SERVE_QUEUE_SIZE": &config.queueSize,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return config, fmt.Errorf("%w: %s must be a positive integer: %q", errInvalidServeConfig, name, v)
+			}
+			*dst = n
+		}
+	}
+	if v := os.Getenv("SCORECARD_SERVE_JOB_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return config, fmt.Errorf("%w: SCORECARD_SERVE_JOB_TTL: %w", errInvalidServeConfig, err)
+		}
+		config.ttl = ttl
+	}
+	return config, nil
+}
+
+// batchConfigFromEnv configures the batch API from the environment.
+func batchConfigFromEnv(config batchConfig) (batchConfig, error) {
+	for name, dst := range map[string]*int{
+		"SCORECARD_SERVE_BATCH_CONCURRENCY": &config.concurrency,
+		"SCORECARD_SERVE_BATCH_SIZE":        &config.size,
+	} {
+		if v := os.Getenv(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return config, fmt.Errorf("%w: %s must be a positive integer: %q", errInvalidServeConfig, name, v)
+			}
+			*dst = n
+		}
+	}
+	return config, nil
+}
+
+// resultCacheConfigFromEnv returns the ttl and maximum number of results of
+// the result cache. A ttl of 0 disables caching.
+func resultCacheConfigFromEnv() (time.Duration, int, error) {
+	ttl, size := time.Hour, 1000
+	if v := os.Getenv("SCORECARD_SERVE_CACHE_TTL"); v != "" {
+		var err error
+		if ttl, err = time.ParseDuration(v); err != nil {
+			return 0, 0, fmt.Errorf("%w: SCORECARD_SERVE_CACHE_TTL: %w", errInvalidServeConfig, err)
+		}
+	}
+	if v := os.Getenv("SCORECARD_SERVE_CACHE_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("%w: SCORECARD_SERVE_CACHE_SIZE must be a non-negative integer: %q",
+				errInvalidServeConfig, v)
+		}
+		size = n
+	}
+	return ttl, size, nil
 }
 
 func serveCmd(o *options.Options) *cobra.Command {
 	return &cobra.Command{
 		Use:   "serve",
 		Short: "Serve the scorecard program over http",
-		Long:  `Start an HTTP server to run scorecard checks on repositories with REST API support.`,
+		Long: `Start an HTTP server to run scorecard checks on repositories with REST API support.
+
+Scans can be run asynchronously: POST /v1/jobs queues a scan and returns its ID,
+GET /v1/jobs/{id} returns its status and result, and DELETE /v1/jobs/{id} cancels it.
+The job API is configured with the SCORECARD_SERVE_WORKERS, SCORECARD_SERVE_QUEUE_SIZE,
+SCORECARD_SERVE_JOB_TTL and SCORECARD_SERVE_RESULTS_DIR environment variables.
+
+Results are cached per repo, resolved commit, checks, probes and scorecard version for
+SCORECARD_SERVE_CACHE_TTL (default 1h, 0 disables caching), up to SCORECARD_SERVE_CACHE_SIZE
+results, and concurrent identical scans are coalesced.
+
+POST /v1/batch scans a list of requests, {"requests": [{"repo": ...}, {"npm": ...}]}, and
+streams one JSON line per result as scans complete. SCORECARD_SERVE_BATCH_SIZE (default 200)
+limits the number of requests per batch, and SCORECARD_SERVE_BATCH_CONCURRENCY (default 8)
+the number of scans run at once per batch. Each request of a batch counts against the rate
+limit and quota of the caller, and requests over them fail.
+
+Requests are authenticated with the API keys of SCORECARD_SERVE_API_KEYS_FILE, passed in the
+X-API-Key header or as bearer tokens, or with bearer JWTs verified against the keys of
+SCORECARD_SERVE_JWKS_FILE, SCORECARD_SERVE_JWT_ISSUER and SCORECARD_SERVE_JWT_AUDIENCE.
+JWTs must expire and match both the issuer and the audience. Without either file, requests
+are anonymous. SCORECARD_SERVE_RATE, SCORECARD_SERVE_BURST and SCORECARD_SERVE_DAILY_QUOTA
+limit each caller, unless overridden per API key, and SCORECARD_SERVE_CORS_ORIGINS lists the
+allowed origins (default *). Anonymous callers are limited per client address, so behind a
+load balancer or reverse proxy, SCORECARD_SERVE_TRUSTED_PROXIES must list the addresses or
+CIDRs of the proxies, whose X-Forwarded-For header then identifies the client.
+
+Repositories are only scanned through the GitHub, GitLab, Azure DevOps, Bitbucket and Gitea
+APIs, unless their host is listed in SCORECARD_SERVE_GIT_HOSTS, which allows scanning them
+with plain git, including ssh:// and git+ URLs. Local repositories can't be scanned.
+
+GET /metrics serves check runtimes, check errors, GitHub API requests and remaining
+rate limits in the Prometheus text format, and requests are logged as JSON.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger := log.NewLogger(log.ParseLevel(o.LogLevel))
 			srv := newServer(logger)
 
+			cacheTTL, cacheSize, err := resultCacheConfigFromEnv()
+			if err != nil {
+				return err
+			}
+			srv.gitHosts = envList(os.Getenv("SCORECARD_SERVE_GIT_HOSTS"))
+			srv.results = newResultCache(srv.scan, srv.lsRemoteHead, cacheTTL, cacheSize, logger)
+
+			if srv.batch, err = batchConfigFromEnv(srv.batch); err != nil {
+				return err
+			}
+
+			config, err := jobManagerConfigFromEnv()
+			if err != nil {
+				return err
+			}
+			srv.jobs, err = newJobManager(srv.results.scanFunc(), config, logger)
+			if err != nil {
+				return err
+			}
+			workersCtx, stopWorkers := context.WithCancel(context.Background())
+			srv.jobs.start(workersCtx, config.workers)
+			defer func() {
+				stopWorkers()
+				srv.jobs.stop()
+			}()
+
 			mux := http.NewServeMux()
 			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 				if r.Method == http.MethodGet || r.Method == http.MethodPost {
@@ -259,8 +475,22 @@ func serveCmd(o *options.Options) *cobra.Command {
 			mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
 			})
-
-			handler := loggerMiddleware(recoverMiddleware(corsMiddleware(mux)))
+			mux.HandleFunc("POST /v1/batch", srv.handleBatch)
+			mux.HandleFunc("GET /metrics", handleMetrics(logger))
+			mux.HandleFunc("POST /v1/jobs", srv.handleCreateJob)
+			mux.HandleFunc("GET /v1/jobs/{id}", srv.handleGetJob)
+			mux.HandleFunc("DELETE /v1/jobs/{id}", srv.handleCancelJob)
+
+			auth, err := authConfigFromEnv()
+			if err != nil {
+				return err
+			}
+			if err := registerMetricViews(); err != nil {
+				return err
+			}
+			accessLogger := log.NewJSONLogger(log.ParseLevel(o.LogLevel))
+			handler := loggerMiddleware(accessLogger, recoverMiddleware(corsMiddleware(auth.origins,
+				authMiddleware(auth, newLimiter(), mux))))
 
 			port := os.Getenv("PORT")
 			if port == "" {
@@ -0,0 +1,526 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/time/rate"
+)
+
+var (
+	errUnauthenticated = errors.New("missing or invalid credentials")
+	errInvalidKeysFile = errors.New("invalid API keys file")
+	errUnknownKeyID    = errors.New("unknown key ID")
+	errNoExpiry        = errors.New("token has no expiry")
+	errLimitExceeded   = errors.New("rate limit or quota exceeded")
+)
+
+// limiterSweepInterval is how often the limiter forgets idle principals.
+const limiterSweepInterval = time.Minute
+
+// limits restricts the requests of a principal. Zero values are unlimited.
+type limits struct {
+	// Rate is the number of requests per second refilling the token bucket.
+	Rate float64 `yaml:"rate"`
+	// Burst is the size of the token bucket.
+	Burst int `yaml:"burst"`
+	// DailyQuota is the number of requests allowed per UTC day.
+	DailyQuota int `yaml:"daily_quota"`
+}
+
+// principal is the authenticated caller of a request.
+type principal struct {
+	name   string
+	limits limits
+}
+
+// authenticator authenticates requests. It returns a nil principal if the
+// request has no credentials it understands.
+type authenticator interface {
+	authenticate(r *http.Request) (*principal, error)
+}
+
+type apiKey struct {
+	Name string `yaml:"name"`
+	// SHA256 is the hex encoded SHA-256 of the key, so the file holds no secrets.
+	SHA256 string `yaml:"sha256"`
+	limits `yaml:",inline"`
+}
+
+type apiKeysFile struct {
+	Keys []apiKey `yaml:"keys"`
+}
+
+// apiKeyAuthenticator authenticates requests with the static API keys of a
+// file, passed in the X-API-Key header or as bearer tokens.
+type apiKeyAuthenticator struct {
+	keys []apiKey
+}
+
+func newAPIKeyAuthenticator(path string) (*apiKeyAuthenticator, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading API keys file: %w", err)
+	}
+	var f apiKeysFile
+	if err := yaml.Unmarshal(content, &f); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidKeysFile, err)
+	}
+	for i := range f.Keys {
+		k := &f.Keys[i]
+		if k.Name == "" {
+			return nil, fmt.Errorf("%w: key %d has no name", errInvalidKeysFile, i)
+		}
+		if sum, err := hex.DecodeString(k.SHA256); err != nil || len(sum) != sha256.Size {
+			return nil, fmt.Errorf("%w: key %q has an invalid sha256", errInvalidKeysFile, k.Name)
+		}
+		k.SHA256 = strings.ToLower(k.SHA256)
+	}
+	return &apiKeyAuthenticator{keys: f.Keys}, nil
+}
+
+func (a *apiKeyAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" {
+		key = bearerToken(r)
+		if key == "" || strings.Count(key, ".") == 2 {
+			// JWTs are left to the JWT authenticator.
+			return nil, nil
+		}
+	}
+	sum := sha256.Sum256([]byte(key))
+	got := []byte(hex.EncodeToString(sum[:]))
+	for i := range a.keys {
+		if subtle.ConstantTimeCompare(got, []byte(a.keys[i].SHA256)) == 1 {
+			return &principal{name: "key:" + a.keys[i].Name, limits: a.keys[i].limits}, nil
+		}
+	}
+	return nil, errUnauthenticated
+}
+
+// jwtAuthenticator authenticates requests with bearer JWTs signed by a key
+// of a local JWKS file.
+type jwtAuthenticator struct {
+	now      func() time.Time
+	keys     jose.JSONWebKeySet
+	issuer   string
+	audience string
+	limits   limits
+}
+
+func newJWTAuthenticator(jwksPath, issuer, audience string, l limits) (*jwtAuthenticator, error) {
+	// tokens minted by any issuer for any service trusting the same keys
+	// must not be accepted.
+	if issuer == "" || audience == "" {
+		return nil, fmt.Errorf("%w: SCORECARD_SERVE_JWKS_FILE requires "+
+			"SCORECARD_SERVE_JWT_ISSUER and SCORECARD_SERVE_JWT_AUDIENCE", errInvalidServeConfig)
+	}
+	content, err := os.ReadFile(jwksPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading JWKS file: %w", err)
+	}
+	a := &jwtAuthenticator{
+		now:      time.Now,
+		issuer:   issuer,
+		audience: audience,
+		limits:   l,
+	}
+	if err := json.Unmarshal(content, &a.keys); err != nil {
+		return nil, fmt.Errorf("parsing JWKS file: %w", err)
+	}
+	return a, nil
+}
+
+var jwtAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+func (a *jwtAuthenticator) authenticate(r *http.Request) (*principal, error) {
+	token := bearerToken(r)
+	if strings.Count(token, ".") != 2 {
+		return nil, nil
+	}
+	parsed, err := jwt.ParseSigned(token, jwtAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnauthenticated, err)
+	}
+	var kid string
+	if len(parsed.Headers) > 0 {
+		kid = parsed.Headers[0].KeyID
+	}
+	keys := a.keys.Key(kid)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: %w: %q", errUnauthenticated, errUnknownKeyID, kid)
+	}
+	var claims jwt.Claims
+	if err := parsed.Claims(keys[0].Public().Key, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnauthenticated, err)
+	}
+	// tokens without expiry would be valid forever.
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w: %w", errUnauthenticated, errNoExpiry)
+	}
+	expected := jwt.Expected{
+		Issuer:      a.issuer,
+		AnyAudience: jwt.Audience{a.audience},
+		Time:        a.now(),
+	}
+	if err := claims.ValidateWithLeeway(expected, jwt.DefaultLeeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnauthenticated, err)
+	}
+	return &principal{name: "jwt:" + claims.Subject, limits: a.limits}, nil
+}
+
+func bearerToken(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// limiter enforces the rate limits and daily quotas of principals.
+type limiter struct {
+	now       func() time.Time
+	buckets   map[string]*rate.Limiter
+	quotas    map[string]*quotaUsage
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+type quotaUsage struct {
+	day  time.Time
+	used int
+}
+
+func newLimiter() *limiter {
+	return &limiter{
+		now:     time.Now,
+		buckets: map[string]*rate.Limiter{},
+		quotas:  map[string]*quotaUsage{},
+	}
+}
+
+// allow records a request of p, and returns how long to wait before retrying
+// if the request is not allowed. Requests which don't count against the daily
+// quota are only rate limited.
+func (l *limiter) allow(p *principal, countQuota bool) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Sub(l.lastSweep) >= limiterSweepInterval {
+		l.sweep(now)
+		l.lastSweep = now
+	}
+
+	var q *quotaUsage
+	if countQuota && p.limits.DailyQuota > 0 {
+		day := now.UTC().Truncate(24 * time.Hour)
+		q = l.quotas[p.name]
+		if q == nil || !q.day.Equal(day) {
+			q = &quotaUsage{day: day}
+			l.quotas[p.name] = q
+		}
+		if q.used >= p.limits.DailyQuota {
+			return false, day.Add(24 * time.Hour).Sub(now)
+		}
+	}
+
+	if p.limits.Rate > 0 {
+		b, ok := l.buckets[p.name]
+		if !ok {
+			b = rate.NewLimiter(rate.Limit(p.limits.Rate), max(p.limits.Burst, 1))
+			l.buckets[p.name] = b
+		}
+		r := b.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			r.CancelAt(now)
+			return false, delay
+		}
+	}
+
+	if q != nil {
+		q.used++
+	}
+	return true, 0
+}
+
+// sweep forgets the principals whose token bucket is full and whose quota
+// usage is from a previous day, as they are limited the same as unknown
+// principals. Otherwise every client address would be remembered forever.
+func (l *limiter) sweep(now time.Time) {
+	day := now.UTC().Truncate(24 * time.Hour)
+	for name, q := range l.quotas {
+		if !q.day.Equal(day) {
+			delete(l.quotas, name)
+		}
+	}
+	for name, b := range l.buckets {
+		if b.TokensAt(now) >= float64(b.Burst()) {
+			delete(l.buckets, name)
+		}
+	}
+}
+
+// authConfig configures the authentication, limits and CORS of serve.
+type authConfig struct {
+	authenticators []authenticator
+	origins        []string
+	// trustedProxies are the proxies whose X-Forwarded-For header
+	// identifies anonymous clients.
+	trustedProxies []netip.Prefix
+	anonymous      limits
+}
+
+// authMiddleware authenticates requests and enforces the limits of their
+// principal. Without authenticators, requests are anonymous and limited per
+// client address.
+func authMiddleware(c *authConfig, l *limiter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" || r.URL.Path == "/metrics" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		p, err := authenticate(c.authenticators, r)
+		switch {
+		case err != nil:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scorecard"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		case p == nil && len(c.authenticators) > 0:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scorecard"`)
+			http.Error(w, errUnauthenticated.Error(), http.StatusUnauthorized)
+			return
+		case p == nil:
+			p = &principal{name: "anonymous:" + clientAddr(r, c.trustedProxies), limits: c.anonymous}
+		}
+		setAccessLogPrincipal(r.Context(), p.name)
+		if r.Method == http.MethodPost && r.URL.Path == "/v1/batch" {
+			// batches are charged once per scan by the batch handler.
+			charge := chargeFunc(func() (bool, time.Duration) { return l.allow(p, true) })
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), chargeKey{}, charge)))
+			return
+		}
+		// polling the status of a job doesn't start a scan.
+		countQuota := !(r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/jobs/"))
+		if ok, retryAfter := l.allow(p, countQuota); !ok {
+			tooManyRequests(w, retryAfter)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// chargeKey is the context key of the chargeFunc of a request.
+type chargeKey struct{}
+
+// chargeFunc records a scan of the principal of a request, and returns how
+// long to wait before retrying if the scan is not allowed.
+type chargeFunc func() (bool, time.Duration)
+
+// charge records a scan requested by r against the limits of its principal.
+// Requests which were not authenticated by authMiddleware are not limited.
+func charge(r *http.Request) (bool, time.Duration) {
+	if f, ok := r.Context().Value(chargeKey{}).(chargeFunc); ok {
+		return f()
+	}
+	return true, 0
+}
+
+func tooManyRequests(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	http.Error(w, errLimitExceeded.Error(), http.StatusTooManyRequests)
+}
+
+// clientAddr returns the address of the client of r. Behind trusted proxies,
+// it is the last address of X-Forwarded-For not added by a trusted proxy,
+// as earlier addresses can be forged by the client.
+func clientAddr(r *http.Request, trustedProxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !isTrustedProxy(host, trustedProxies) {
+		return host
+	}
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if addr == "" {
+			continue
+		}
+		host = addr
+		if !isTrustedProxy(addr, trustedProxies) {
+			break
+		}
+	}
+	return host
+}
+
+func isTrustedProxy(host string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func authenticate(authenticators []authenticator, r *http.Request) (*principal, error) {
+	for _, a := range authenticators {
+		p, err := a.authenticate(r)
+		if err != nil || p != nil {
+			return p, err
+		}
+	}
+	return nil, nil
+}
+
+// corsMiddleware allows cross-origin requests from the given origins, or from
+// any origin if they contain "*".
+func corsMiddleware(origins []string, next http.Handler) http.Handler {
+	anyOrigin := slices.Contains(origins, "*")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		switch {
+		case anyOrigin:
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		case origin != "" && slices.Contains(origins, origin):
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		}
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-API-Key")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authConfigFromEnv configures authentication, limits and CORS from the
+// environment. Without any of these variables, serve keeps accepting
+// anonymous requests from any origin.
+func authConfigFromEnv() (*authConfig, error) {
+	c := &authConfig{}
+	var err error
+	if c.anonymous.Rate, err = envFloat("SCORECARD_SERVE_RATE"); err != nil {
+		return nil, err
+	}
+	if c.anonymous.Burst, err = envNonNegativeInt("SCORECARD_SERVE_BURST"); err != nil {
+		return nil, err
+	}
+	if c.anonymous.DailyQuota, err = envNonNegativeInt("SCORECARD_SERVE_DAILY_QUOTA"); err != nil {
+		return nil, err
+	}
+
+	if path := os.Getenv("SCORECARD_SERVE_API_KEYS_FILE"); path != "" {
+		a, err := newAPIKeyAuthenticator(path)
+		if err != nil {
+			return nil, err
+		}
+		c.authenticators = append(c.authenticators, a)
+	}
+	if path := os.Getenv("SCORECARD_SERVE_JWKS_FILE"); path != "" {
+		a, err := newJWTAuthenticator(path,
+			os.Getenv("SCORECARD_SERVE_JWT_ISSUER"), os.Getenv("SCORECARD_SERVE_JWT_AUDIENCE"), c.anonymous)
+		if err != nil {
+			return nil, err
+		}
+		c.authenticators = append(c.authenticators, a)
+	}
+
+	c.origins = []string{"*"}
+	if v, ok := os.LookupEnv("SCORECARD_SERVE_CORS_ORIGINS"); ok {
+		c.origins = envList(v)
+	}
+	for _, v := range envList(os.Getenv("SCORECARD_SERVE_TRUSTED_PROXIES")) {
+		p, err := netip.ParsePrefix(v)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(v)
+			if aerr != nil {
+				return nil, fmt.Errorf("%w: SCORECARD_SERVE_TRUSTED_PROXIES must list addresses or CIDRs: %q",
+					errInvalidServeConfig, v)
+			}
+			p = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		}
+		c.trustedProxies = append(c.trustedProxies, p.Masked())
+	}
+	return c, nil
+}
+
+// envList returns the non-empty comma separated values of v.
+func envList(v string) []string {
+	var ret []string
+	for s := range strings.SplitSeq(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func envFloat(name string) (float64, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("%w: %s must be a non-negative number: %q", errInvalidServeConfig, name, v)
+	}
+	return f, nil
+}
+
+func envNonNegativeInt(name string) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%w: %s must be a non-negative integer: %q", errInvalidServeConfig, name, v)
+	}
+	return n, nil
+}
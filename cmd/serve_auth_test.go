@@ -0,0 +1,376 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+func writeTestFile(t *testing.T, name string, content []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTestAPIKeyAuthenticator(t *testing.T) *apiKeyAuthenticator {
+	t.Helper()
+	path := writeTestFile(t, "keys.yaml", []byte(`keys:
+  - name: bot
+    sha256: `+hashKey("s3cret")+`
+    daily_quota: 1
+`))
+	a, err := newAPIKeyAuthenticator(path)
+	if err != nil {
+		t.Fatalf("newAPIKeyAuthenticator: %v", err)
+	}
+	return a
+}
+
+// newTestJWTAuthenticator returns an authenticator trusting a new key, and a
+// function signing claims with it.
+func newTestJWTAuthenticator(t *testing.T) (*jwtAuthenticator, func(kid string, claims jwt.Claims) string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: key.Public(), KeyID: "k1", Algorithm: string(jose.ES256), Use: "sig"},
+	}})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	a, err := newJWTAuthenticator(writeTestFile(t, "jwks.json", jwks), testIssuer, "scorecard", limits{})
+	if err != nil {
+		t.Fatalf("newJWTAuthenticator: %v", err)
+	}
+	sign := func(kid string, claims jwt.Claims) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key},
+			(&jose.SignerOptions{}).WithHeader(jose.HeaderKey("kid"), kid))
+		if err != nil {
+			t.Fatalf("NewSigner: %v", err)
+		}
+		token, err := jwt.Signed(signer).Claims(claims).Serialize()
+		if err != nil {
+			t.Fatalf("Serialize: %v", err)
+		}
+		return token
+	}
+	return a, sign
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	validClaims := jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "ci",
+		Audience: jwt.Audience{"scorecard"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	expiredClaims := validClaims
+	expiredClaims.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+	otherIssuerClaims := validClaims
+	otherIssuerClaims.Issuer = "https://evil.example.com"
+	otherAudienceClaims := validClaims
+	otherAudienceClaims.Audience = jwt.Audience{"other"}
+	noExpiryClaims := validClaims
+	noExpiryClaims.Expiry = nil
+
+	keys := newTestAPIKeyAuthenticator(t)
+	jwts, sign := newTestJWTAuthenticator(t)
+	authenticators := []authenticator{keys, jwts}
+
+	tests := []struct {
+		headers map[string]string
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "no credentials",
+			headers: map[string]string{},
+		},
+		{
+			name:    "API key header",
+			headers: map[string]string{"X-API-Key": "s3cret"},
+			want:    "key:bot",
+		},
+		{
+			name:    "API key bearer token",
+			headers: map[string]string{"Authorization": "Bearer s3cret"},
+			want:    "key:bot",
+		},
+		{
+			name:    "wrong API key",
+			headers: map[string]string{"X-API-Key": "guess"},
+			wantErr: true,
+		},
+		{
+			name:    "JWT",
+			headers: map[string]string{"Authorization": "Bearer " + sign("k1", validClaims)},
+			want:    "jwt:ci",
+		},
+		{
+			name:    "expired JWT",
+			headers: map[string]string{"Authorization": "Bearer " + sign("k1", expiredClaims)},
+			wantErr: true,
+		},
+		{
+			name:    "JWT of another issuer",
+			headers: map[string]string{"Authorization": "Bearer " + sign("k1", otherIssuerClaims)},
+			wantErr: true,
+		},
+		{
+			name:    "JWT for another audience",
+			headers: map[string]string{"Authorization": "Bearer " + sign("k1", otherAudienceClaims)},
+			wantErr: true,
+		},
+		{
+			name:    "JWT without expiry",
+			headers: map[string]string{"Authorization": "Bearer " + sign("k1", noExpiryClaims)},
+			wantErr: true,
+		},
+		{
+			name:    "JWT with unknown key",
+			headers: map[string]string{"Authorization": "Bearer " + sign("k2", validClaims)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			p, err := authenticate(authenticators, r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got string
+			if p != nil {
+				got = p.name
+			}
+			if got != tt.want {
+				t.Errorf("authenticate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIKeyAuthenticator_invalid(t *testing.T) {
+	t.Parallel()
+	path := writeTestFile(t, "keys.yaml", []byte("keys:\n  - name: bot\n    sha256: s3cret\n"))
+	if _, err := newAPIKeyAuthenticator(path); err == nil {
+		t.Error("newAPIKeyAuthenticator() accepted a plain text key")
+	}
+}
+
+func TestNewJWTAuthenticator_requiresIssuerAndAudience(t *testing.T) {
+	t.Parallel()
+	path := writeTestFile(t, "jwks.json", []byte(`{"keys": []}`))
+	for _, tt := range []struct{ issuer, audience string }{
+		{issuer: "", audience: "scorecard"},
+		{issuer: testIssuer, audience: ""},
+	} {
+		if _, err := newJWTAuthenticator(path, tt.issuer, tt.audience, limits{}); !errors.Is(err, errInvalidServeConfig) {
+			t.Errorf("newJWTAuthenticator(%q, %q) error = %v, want %v",
+				tt.issuer, tt.audience, err, errInvalidServeConfig)
+		}
+	}
+}
+
+func TestLimiter_sweep(t *testing.T) {
+	t.Parallel()
+	l := newLimiter()
+	now := time.Date(2026, time.January, 1, 23, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	for i := range 3 {
+		p := &principal{name: fmt.Sprintf("anonymous:%d", i), limits: limits{Rate: 1, Burst: 1, DailyQuota: 10}}
+		l.allow(p, true)
+	}
+	// the buckets have refilled and the quotas are from the previous day.
+	now = now.Add(2 * time.Hour)
+	active := &principal{name: "active", limits: limits{Rate: 1, Burst: 1, DailyQuota: 10}}
+	l.allow(active, true)
+	if len(l.buckets) != 1 || len(l.quotas) != 1 {
+		t.Errorf("limiter remembers %d buckets and %d quotas, want 1 each", len(l.buckets), len(l.quotas))
+	}
+	// the usage of active principals is kept.
+	now = now.Add(limiterSweepInterval)
+	l.allow(&principal{name: "other"}, true)
+	if q := l.quotas["active"]; q == nil || q.used != 1 {
+		t.Errorf("quota usage of active principal = %+v, want 1", q)
+	}
+}
+
+func TestClientAddr(t *testing.T) {
+	t.Parallel()
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{name: "direct", remoteAddr: "192.0.2.1:1234", want: "192.0.2.1"},
+		{name: "untrusted proxy", remoteAddr: "192.0.2.1:1234", forwarded: "198.51.100.1", want: "192.0.2.1"},
+		{name: "trusted proxy", remoteAddr: "10.0.0.1:1234", forwarded: "198.51.100.1", want: "198.51.100.1"},
+		{
+			name:       "forged address",
+			remoteAddr: "10.0.0.1:1234",
+			forwarded:  "203.0.113.1, 198.51.100.1, 10.0.0.2",
+			want:       "198.51.100.1",
+		},
+		{name: "trusted proxy without header", remoteAddr: "10.0.0.1:1234", want: "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if got := clientAddr(r, trusted); got != tt.want {
+				t.Errorf("clientAddr() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+	l := newLimiter()
+	now := time.Date(2026, time.January, 1, 23, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	limited := &principal{name: "rate", limits: limits{Rate: 1, Burst: 2}}
+	for i, want := range []bool{true, true, false} {
+		if got, _ := l.allow(limited, true); got != want {
+			t.Errorf("rate limited request %d: allowed = %t, want %t", i, got, want)
+		}
+	}
+
+	quota := &principal{name: "quota", limits: limits{DailyQuota: 1}}
+	if ok, _ := l.allow(quota, true); !ok {
+		t.Error("first request of the day was not allowed")
+	}
+	if ok, _ := l.allow(quota, false); !ok {
+		t.Error("request not counting against the quota was not allowed")
+	}
+	ok, retryAfter := l.allow(quota, true)
+	if ok {
+		t.Error("request over quota was allowed")
+	}
+	if retryAfter != time.Hour {
+		t.Errorf("retry after %v, want until the next day", retryAfter)
+	}
+	now = now.Add(time.Hour)
+	if ok, _ := l.allow(quota, true); !ok {
+		t.Error("quota was not reset on the next day")
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := authMiddleware(&authConfig{authenticators: []authenticator{newTestAPIKeyAuthenticator(t)}},
+		newLimiter(), next)
+
+	tests := []struct {
+		name   string
+		path   string
+		key    string
+		status int
+	}{
+		{name: "health is public", path: "/health", status: http.StatusOK},
+		{name: "unauthenticated", path: "/", status: http.StatusUnauthorized},
+		{name: "authenticated", path: "/", key: "s3cret", status: http.StatusOK},
+		{name: "job status is not counted", path: "/v1/jobs/1", key: "s3cret", status: http.StatusOK},
+		{name: "over quota", path: "/", key: "s3cret", status: http.StatusTooManyRequests},
+	}
+	// subtests share the quota of the key, so they run in order.
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.key != "" {
+			r.Header.Set("X-API-Key", tt.key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != tt.status {
+			t.Errorf("%s: status %d, want %d", tt.name, w.Code, tt.status)
+		}
+	}
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	t.Parallel()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	tests := []struct {
+		name    string
+		origin  string
+		want    string
+		origins []string
+	}{
+		{name: "any origin", origins: []string{"*"}, origin: "https://a.example.com", want: "*"},
+		{name: "allowed origin", origins: []string{"https://a.example.com"}, origin: "https://a.example.com", want: "https://a.example.com"},
+		{name: "other origin", origins: []string{"https://a.example.com"}, origin: "https://b.example.com"},
+		{name: "no origins", origin: "https://a.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodOptions, "/", nil)
+			r.Header.Set("Origin", tt.origin)
+			w := httptest.NewRecorder()
+			corsMiddleware(tt.origins, next).ServeHTTP(w, r)
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.want {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
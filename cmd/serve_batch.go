@@ -0,0 +1,147 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+type batchRequest struct {
+	Requests []scorecardRequest `json:"requests"`
+}
+
+// batchResult is a line of the NDJSON response of a batch. Results are
+// written as they complete, so Index refers to the position of the request
+// in the batch.
+type batchResult struct {
+	Repo   string          `json:"repo,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Index  int             `json:"index"`
+}
+
+type batchConfig struct {
+	// concurrency is the maximum number of scans run at once per batch.
+	concurrency int
+	// size is the maximum number of requests per batch.
+	size int
+}
+
+func parseBatchRequest(r *http.Request, maxSize int) (batchRequest, error) {
+	var req batchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, fmt.Errorf("%w: invalid request body", errInvalidRequest)
+	}
+	if len(req.Requests) == 0 {
+		return req, fmt.Errorf("%w: no requests", errInvalidRequest)
+	}
+	if len(req.Requests) > maxSize {
+		return req, fmt.Errorf("%w: more than %d requests", errInvalidRequest, maxSize)
+	}
+	return req, nil
+}
+
+// handleBatch scans the repos of a batch concurrently, and streams their
+// results as newline delimited JSON. Invalid or failing requests of a batch
+// are reported on their own line, and don't fail the batch. Each request
+// counts against the limits of the caller, and requests over the limits
+// fail, or the whole batch if none is allowed.
+func (s *server) handleBatch(w http.ResponseWriter, r *http.Request) {
+	req, err := parseBatchRequest(r, s.batch.size)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	allowed := 0
+	for range req.Requests {
+		ok, retryAfter := charge(r)
+		if !ok {
+			if allowed == 0 {
+				tooManyRequests(w, retryAfter)
+				return
+			}
+			break
+		}
+		allowed++
+	}
+	scan := s.scan
+	if s.results != nil {
+		scan = s.results.scanFunc()
+	}
+
+	results := make(chan batchResult)
+	sem := make(chan struct{}, s.batch.concurrency)
+	var wg sync.WaitGroup
+	for i := range req.Requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			item := &req.Requests[i]
+			result := batchResult{Index: i, Repo: item.Repo}
+			opts, err := s.requestOptions(item)
+			if err == nil && i >= allowed {
+				err = errLimitExceeded
+			}
+			if err == nil {
+				select {
+				case sem <- struct{}{}:
+					result.Result, err = scan(r.Context(), opts)
+					<-sem
+				case <-r.Context().Done():
+					err = r.Context().Err()
+				}
+			}
+			if err != nil {
+				result.Result = nil
+				result.Error = err.Error()
+			}
+			if result.Repo == "" && opts != nil {
+				// repos of package managers are only known once scanned.
+				result.Repo = opts.Repo
+			}
+			results <- result
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+	enc := json.NewEncoder(w)
+	failed := false
+	for result := range results {
+		if failed {
+			// drain the results of the remaining scans, which are canceled
+			// along with the request.
+			continue
+		}
+		if err := enc.Encode(result); err != nil {
+			s.logger.Error(err, "writing batch result")
+			failed = true
+			continue
+		}
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			s.logger.Error(err, "flushing batch result")
+			failed = true
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+func TestHandleBatch(t *testing.T) {
+	t.Parallel()
+	var running, maxRunning atomic.Int32
+	srv := newServer(log.NewLogger(log.DefaultLevel))
+	srv.batch = batchConfig{concurrency: 2, size: 4}
+	srv.scan = func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		if opts.Repo == "github.com/ossf/broken" {
+			return nil, errScan
+		}
+		return []byte(`{"repo":"` + opts.Repo + `"}`), nil
+	}
+
+	body := `{"requests": [
+		{"repo": "github.com/ossf/scorecard"},
+		{"repo": "github.com/ossf/broken"},
+		{"repo": "github.com/ossf/scorecard-action"},
+		{"repo": "github.com/ossf/scorecard", "file_mode": "invalid"}
+	]}`
+	w := httptest.NewRecorder()
+	srv.handleBatch(w, httptest.NewRequest(http.MethodPost, "/v1/batch", strings.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/x-ndjson" {
+		t.Errorf("Content-Type = %q", got)
+	}
+
+	var got []batchResult
+	scanner := bufio.NewScanner(w.Body)
+	for scanner.Scan() {
+		var result batchResult
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			t.Fatalf("invalid line %q: %v", scanner.Text(), err)
+		}
+		// only keep whether there is an error, not its message.
+		if result.Error != "" {
+			result.Error = "error"
+		}
+		got = append(got, result)
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i].Index < got[j].Index })
+	want := []batchResult{
+		{Index: 0, Repo: "github.com/ossf/scorecard", Result: json.RawMessage(`{"repo":"github.com/ossf/scorecard"}`)},
+		{Index: 1, Repo: "github.com/ossf/broken", Error: "error"},
+		{Index: 2, Repo: "github.com/ossf/scorecard-action", Result: json.RawMessage(`{"repo":"github.com/ossf/scorecard-action"}`)},
+		{Index: 3, Repo: "github.com/ossf/scorecard", Error: "error"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("results mismatch (-want +got):\n%s", diff)
+	}
+	if n := maxRunning.Load(); n > 2 {
+		t.Errorf("%d scans ran at once, want at most 2", n)
+	}
+}
+
+func TestHandleBatch_invalid(t *testing.T) {
+	t.Parallel()
+	srv := newServer(log.NewLogger(log.DefaultLevel))
+	srv.batch = batchConfig{concurrency: 1, size: 1}
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "invalid body", body: "{"},
+		{name: "no requests", body: `{"requests": []}`},
+		{name: "too many requests", body: `{"requests": [{"repo": "a"}, {"repo": "b"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			w := httptest.NewRecorder()
+			srv.handleBatch(w, httptest.NewRequest(http.MethodPost, "/v1/batch", strings.NewReader(tt.body)))
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestHandleBatch_quota(t *testing.T) {
+	t.Parallel()
+	srv := newServer(log.NewLogger(log.DefaultLevel))
+	srv.batch = batchConfig{concurrency: 2, size: 2}
+	srv.scan = func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		return []byte(`{}`), nil
+	}
+	handler := authMiddleware(&authConfig{anonymous: limits{DailyQuota: 3}}, newLimiter(),
+		http.HandlerFunc(srv.handleBatch))
+	body := `{"requests": [{"repo": "github.com/ossf/scorecard"}, {"repo": "github.com/ossf/scorecard-action"}]}`
+
+	// the quota is charged once per request of a batch.
+	tests := []struct {
+		name       string
+		wantErrors []bool
+		status     int
+	}{
+		{name: "within quota", status: http.StatusOK, wantErrors: []bool{false, false}},
+		{name: "partly over quota", status: http.StatusOK, wantErrors: []bool{false, true}},
+		{name: "over quota", status: http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/batch", strings.NewReader(body)))
+		if w.Code != tt.status {
+			t.Fatalf("%s: status %d, want %d", tt.name, w.Code, tt.status)
+		}
+		if tt.status != http.StatusOK {
+			continue
+		}
+		gotErrors := make([]bool, 2)
+		scanner := bufio.NewScanner(w.Body)
+		for scanner.Scan() {
+			var result batchResult
+			if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+				t.Fatalf("%s: invalid line %q: %v", tt.name, scanner.Text(), err)
+			}
+			gotErrors[result.Index] = result.Error != ""
+		}
+		if diff := cmp.Diff(tt.wantErrors, gotErrors); diff != "" {
+			t.Errorf("%s: errors mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"sigs.k8s.io/release-utils/version"
+
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+var (
+	errNoHead             = errors.New("remote has no HEAD")
+	errUnresolvableCommit = errors.New("commit is neither HEAD nor a full SHA")
+
+	fullSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// resolveFunc resolves the commit of a repo to a commit SHA.
+type resolveFunc func(ctx context.Context, repo, commit string) (string, error)
+
+// cachedResult is a scan result, shared by every request with the same key.
+type cachedResult struct {
+	created time.Time
+	etag    string
+	body    []byte
+}
+
+type flight struct {
+	err    error
+	done   chan struct{}
+	cancel context.CancelFunc
+	result cachedResult
+	// waiters is the number of requests waiting for the flight. The scan is
+	// canceled once none are left.
+	waiters int
+}
+
+// resultCache caches scan results for a ttl, and coalesces concurrent scans
+// of the same repo at the same commit with the same options.
+type resultCache struct {
+	logger  *log.Logger
+	scan    scanFunc
+	resolve resolveFunc
+	now     func() time.Time
+	entries map[string]cachedResult
+	flights map[string]*flight
+	version string
+	ttl     time.Duration
+	size    int
+	mu      sync.Mutex
+}
+
+func newResultCache(scan scanFunc, resolve resolveFunc, ttl time.Duration, size int, logger *log.Logger,
+) *resultCache {
+	return &resultCache{
+		logger:  logger,
+		scan:    scan,
+		resolve: resolve,
+		now:     time.Now,
+		entries: map[string]cachedResult{},
+		flights: map[string]*flight{},
+		version: version.GetVersionInfo().GitVersion,
+		ttl:     ttl,
+		size:    size,
+	}
+}
+
+// key identifies the result of a scan, or is empty if the scan can't be
+// cached. Repos from package managers are only known once scanned.
+func (c *resultCache) key(ctx context.Context, opts *options.Options) string {
+	if opts.Repo == "" {
+		return ""
+	}
+	sha, err := c.resolve(ctx, opts.Repo, opts.Commit)
+	if err != nil {
+		c.logger.V(1).Info(fmt.Sprintf("not caching results of %s: %v", opts.Repo, err))
+		return ""
+	}
+	checks := slices.Sorted(slices.Values(opts.ChecksToRun))
+	probes := slices.Sorted(slices.Values(opts.ProbesToRun))
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n%s\n%s\n%s\n%d\n%t\n%t",
+		strings.ToLower(opts.Repo), sha, strings.Join(checks, ","), strings.Join(probes, ","),
+		c.version, strings.ToLower(opts.FileMode), opts.CommitDepth, opts.ShowDetails, opts.ShowAnnotations)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// get returns the cached result of the scan, running it if needed.
+func (c *resultCache) get(ctx context.Context, opts *options.Options) (cachedResult, error) {
+	key := c.key(ctx, opts)
+	if key == "" {
+		body, err := c.scan(ctx, opts)
+		if err != nil {
+			return cachedResult{}, err
+		}
+		return newCachedResult(body, c.now()), nil
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && c.now().Sub(entry.created) < c.ttl {
+		c.mu.Unlock()
+		return entry, nil
+	}
+	f, ok := c.flights[key]
+	if !ok {
+		// the scan outlives the request which started it, as long as other
+		// requests are waiting for it.
+		fctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		c.flights[key] = f
+		go c.run(fctx, key, f, opts)
+	}
+	f.waiters++
+	c.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.result, f.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			f.cancel()
+			// later requests must not wait for the canceled scan.
+			if c.flights[key] == f {
+				delete(c.flights, key)
+			}
+		}
+		c.mu.Unlock()
+		return cachedResult{}, ctx.Err() //nolint:wrapcheck
+	}
+}
+
+func (c *resultCache) run(ctx context.Context, key string, f *flight, opts *options.Options) {
+	body, err := c.scan(ctx, opts)
+	f.cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.flights[key] == f {
+		delete(c.flights, key)
+	}
+	f.err = err
+	if err == nil {
+		f.result = newCachedResult(body, c.now())
+		c.store(key, f.result)
+	}
+	close(f.done)
+}
+
+// store adds an entry, evicting expired and then the oldest entries to
+// respect the size of the cache. c.mu must be held.
+func (c *resultCache) store(key string, entry cachedResult) {
+	if c.ttl <= 0 || c.size <= 0 {
+		// caching is disabled, concurrent scans are still coalesced.
+		return
+	}
+	for k, e := range c.entries {
+		if c.now().Sub(e.created) >= c.ttl {
+			delete(c.entries, k)
+		}
+	}
+	for len(c.entries) >= c.size {
+		var oldest string
+		for k, e := range c.entries {
+			if oldest == "" || e.created.Before(c.entries[oldest].created) {
+				oldest = k
+			}
+		}
+		delete(c.entries, oldest)
+	}
+	c.entries[key] = entry
+}
+
+// scanFunc returns a scanFunc serving results from the cache.
+func (c *resultCache) scanFunc() scanFunc {
+	return func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		result, err := c.get(ctx, opts)
+		return result.body, err
+	}
+}
+
+func newCachedResult(body []byte, created time.Time) cachedResult {
+	sum := sha256.Sum256(body)
+	return cachedResult{
+		body:    body,
+		created: created,
+		etag:    `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}
+}
+
+// lsRemoteHead resolves the commit of a repo without using any API quota:
+// full commit SHAs are used as is, and other commits are resolved to the
+// HEAD of the remote.
+func (s *server) lsRemoteHead(ctx context.Context, uri, commit string) (string, error) {
+	if fullSHA.MatchString(commit) {
+		return commit, nil
+	}
+	if !strings.EqualFold(commit, clients.HeadSHA) {
+		return "", fmt.Errorf("%w: %q", errUnresolvableCommit, commit)
+	}
+	repo, err := s.makeRepo(uri)
+	if err != nil {
+		return "", err
+	}
+	remoteURL := repo.URI()
+	if !strings.Contains(remoteURL, "://") {
+		remoteURL = "https://" + remoteURL
+	}
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{remoteURL},
+	})
+	refs, err := remote.ListContext(ctx, &git.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("listing remote refs: %w", err)
+	}
+	target := plumbing.HEAD
+	for range 2 {
+		for _, ref := range refs {
+			if ref.Name() != target {
+				continue
+			}
+			if ref.Type() == plumbing.HashReference {
+				return ref.Hash().String(), nil
+			}
+			target = ref.Target()
+		}
+	}
+	return "", errNoHead
+}
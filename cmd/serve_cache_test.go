@@ -0,0 +1,243 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+// newTestCache returns a cache counting the scans, with a fake clock.
+func newTestCache(scan scanFunc, resolve resolveFunc) (*resultCache, *atomic.Int32, *time.Time) {
+	var scans atomic.Int32
+	c := newResultCache(func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		scans.Add(1)
+		return scan(ctx, opts)
+	}, resolve, time.Hour, 2, log.NewLogger(log.DefaultLevel))
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	return c, &scans, &now
+}
+
+func resolveToSHA(ctx context.Context, repo, commit string) (string, error) {
+	return "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", nil
+}
+
+func scanRepo(ctx context.Context, opts *options.Options) ([]byte, error) {
+	return []byte(`{"repo":"` + opts.Repo + `"}`), nil
+}
+
+func cacheTestOptions(repo string, checks ...string) *options.Options {
+	opts := options.New()
+	opts.Repo = repo
+	opts.ChecksToRun = checks
+	return opts
+}
+
+func TestResultCache(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		resolve   resolveFunc
+		name      string
+		requests  []*options.Options
+		advance   time.Duration
+		wantScans int32
+	}{
+		{
+			name:    "identical requests",
+			resolve: resolveToSHA,
+			requests: []*options.Options{
+				cacheTestOptions("github.com/foo/bar", "License", "Fuzzing"),
+				cacheTestOptions("github.com/foo/bar", "Fuzzing", "License"),
+			},
+			wantScans: 1,
+		},
+		{
+			name:    "different checks",
+			resolve: resolveToSHA,
+			requests: []*options.Options{
+				cacheTestOptions("github.com/foo/bar", "License"),
+				cacheTestOptions("github.com/foo/bar", "Fuzzing"),
+			},
+			wantScans: 2,
+		},
+		{
+			name:    "expired",
+			resolve: resolveToSHA,
+			advance: 2 * time.Hour,
+			requests: []*options.Options{
+				cacheTestOptions("github.com/foo/bar"),
+				cacheTestOptions("github.com/foo/bar"),
+			},
+			wantScans: 2,
+		},
+		{
+			name: "unresolvable commit",
+			resolve: func(ctx context.Context, repo, commit string) (string, error) {
+				return "", errUnresolvableCommit
+			},
+			requests: []*options.Options{
+				cacheTestOptions("github.com/foo/bar"),
+				cacheTestOptions("github.com/foo/bar"),
+			},
+			wantScans: 2,
+		},
+		{
+			name:    "evicted",
+			resolve: resolveToSHA,
+			requests: []*options.Options{
+				cacheTestOptions("github.com/foo/a"),
+				cacheTestOptions("github.com/foo/b"),
+				cacheTestOptions("github.com/foo/c"),
+				cacheTestOptions("github.com/foo/a"),
+			},
+			wantScans: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, scans, now := newTestCache(scanRepo, tt.resolve)
+			for _, opts := range tt.requests {
+				got, err := c.get(t.Context(), opts)
+				if err != nil {
+					t.Fatalf("get: %v", err)
+				}
+				if want := `{"repo":"` + opts.Repo + `"}`; string(got.body) != want {
+					t.Errorf("get() = %s, want %s", got.body, want)
+				}
+				*now = now.Add(tt.advance + time.Second)
+			}
+			if got := scans.Load(); got != tt.wantScans {
+				t.Errorf("ran %d scans, want %d", got, tt.wantScans)
+			}
+		})
+	}
+}
+
+func TestResultCache_coalesce(t *testing.T) {
+	t.Parallel()
+	release := make(chan struct{})
+	c, scans, _ := newTestCache(func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		<-release
+		return scanRepo(ctx, opts)
+	}, resolveToSHA)
+
+	const n = 5
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.get(t.Context(), cacheTestOptions("github.com/foo/bar")); err != nil {
+				t.Errorf("get: %v", err)
+			}
+		}()
+	}
+	// wait for every request to join the flight.
+	for {
+		c.mu.Lock()
+		var waiters int
+		for _, f := range c.flights {
+			waiters = f.waiters
+		}
+		c.mu.Unlock()
+		if waiters == n {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if got := scans.Load(); got != 1 {
+		t.Errorf("ran %d scans, want 1", got)
+	}
+}
+
+func TestResultCache_cancel(t *testing.T) {
+	t.Parallel()
+	canceled := make(chan struct{})
+	c, _, _ := newTestCache(func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	}, resolveToSHA)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+	go func() {
+		_, err := c.get(ctx, cacheTestOptions("github.com/foo/bar"))
+		done <- err
+	}()
+	cancel()
+	if err := <-done; err == nil {
+		t.Error("get() succeeded after its request was canceled")
+	}
+	// the scan is canceled once no request waits for it.
+	<-canceled
+}
+
+func TestHandleScorecard_etag(t *testing.T) {
+	t.Parallel()
+	srv := newServer(log.NewLogger(log.DefaultLevel))
+	c, scans, _ := newTestCache(scanRepo, resolveToSHA)
+	srv.results = c
+	ts := httptest.NewServer(http.HandlerFunc(srv.handleScorecard))
+	defer ts.Close()
+
+	get := func(etag string) *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL+"?repo=github.com/foo/bar", nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	first := get("")
+	if first.StatusCode != http.StatusOK {
+		t.Fatalf("status %d, want %d", first.StatusCode, http.StatusOK)
+	}
+	etag := first.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("no ETag header")
+	}
+
+	second := get(etag)
+	if second.StatusCode != http.StatusNotModified {
+		t.Errorf("status %d, want %d", second.StatusCode, http.StatusNotModified)
+	}
+	if second.Header.Get("Age") == "" {
+		t.Error("no Age header")
+	}
+	if got := scans.Load(); got != 1 {
+		t.Errorf("ran %d scans, want 1", got)
+	}
+}
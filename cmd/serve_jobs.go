@@ -0,0 +1,319 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+type jobStatus string
+
+const (
+	jobQueued    jobStatus = "queued"
+	jobRunning   jobStatus = "running"
+	jobSucceeded jobStatus = "succeeded"
+	jobFailed    jobStatus = "failed"
+	jobCanceled  jobStatus = "canceled"
+)
+
+var (
+	errQueueFull   = errors.New("job queue is full")
+	errJobNotFound = errors.New("job not found")
+	errJobFinished = errors.New("job already finished")
+)
+
+// jobView is the JSON representation of a job.
+type jobView struct {
+	CreatedAt  time.Time       `json:"created_at"`
+	StartedAt  *time.Time      `json:"started_at,omitempty"`
+	FinishedAt *time.Time      `json:"finished_at,omitempty"`
+	ID         string          `json:"id"`
+	Status     jobStatus       `json:"status"`
+	Error      string          `json:"error,omitempty"`
+	Result     json.RawMessage `json:"result,omitempty"`
+}
+
+type job struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	opts   *options.Options
+	// result is only kept in memory when no results directory is configured.
+	result []byte
+	view   jobView
+}
+
+func (j *job) finished() bool {
+	return j.view.Status == jobSucceeded || j.view.Status == jobFailed || j.view.Status == jobCanceled
+}
+
+// jobManager runs scans asynchronously on a bounded pool of workers.
+type jobManager struct {
+	logger *log.Logger
+	scan   scanFunc
+	jobs   map[string]*job
+	queue  chan *job
+	now    func() time.Time
+	// resultsDir stores job results as files instead of in memory, if set.
+	resultsDir string
+	wg         sync.WaitGroup
+	// ttl is how long finished jobs are retained.
+	ttl time.Duration
+	mu  sync.Mutex
+}
+
+type jobManagerConfig struct {
+	resultsDir string
+	workers    int
+	queueSize  int
+	ttl        time.Duration
+}
+
+func newJobManager(scan scanFunc, config jobManagerConfig, logger *log.Logger) (*jobManager, error) {
+	if config.resultsDir != "" {
+		if err := os.MkdirAll(config.resultsDir, 0o700); err != nil {
+			return nil, fmt.Errorf("creating results dir: %w", err)
+		}
+	}
+	return &jobManager{
+		logger:     logger,
+		scan:       scan,
+		jobs:       map[string]*job{},
+		queue:      make(chan *job, config.queueSize),
+		now:        time.Now,
+		resultsDir: config.resultsDir,
+		ttl:        config.ttl,
+	}, nil
+}
+
+// start launches the workers, which stop once ctx is done.
+func (m *jobManager) start(ctx context.Context, workers int) {
+	for range workers {
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-m.queue:
+					m.run(j)
+				}
+			}
+		}()
+	}
+}
+
+// stop cancels all unfinished jobs and waits for the workers to exit.
+// The context given to start must be done.
+func (m *jobManager) stop() {
+	m.mu.Lock()
+	for _, j := range m.jobs {
+		j.cancel()
+	}
+	m.mu.Unlock()
+	m.wg.Wait()
+}
+
+func (m *jobManager) submit(opts *options.Options) (jobView, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &job{
+		ctx:    ctx,
+		cancel: cancel,
+		opts:   opts,
+		view: jobView{
+			ID:        uuid.NewString(),
+			Status:    jobQueued,
+			CreatedAt: m.now(),
+		},
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prune()
+	select {
+	case m.queue <- j:
+	default:
+		cancel()
+		return jobView{}, errQueueFull
+	}
+	m.jobs[j.view.ID] = j
+	return j.view, nil
+}
+
+// prune forgets finished jobs older than the ttl. m.mu must be held.
+func (m *jobManager) prune() {
+	for id, j := range m.jobs {
+		if j.finished() && m.now().Sub(*j.view.FinishedAt) > m.ttl {
+			delete(m.jobs, id)
+			if m.resultsDir != "" {
+				if err := os.Remove(m.resultPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+					m.logger.Error(err, "removing job result")
+				}
+			}
+		}
+	}
+}
+
+func (m *jobManager) run(j *job) {
+	m.mu.Lock()
+	if j.finished() {
+		// canceled while queued.
+		m.mu.Unlock()
+		return
+	}
+	started := m.now()
+	j.view.Status = jobRunning
+	j.view.StartedAt = &started
+	m.mu.Unlock()
+
+	result, err := m.scan(j.ctx, j.opts)
+	if err == nil && m.resultsDir != "" {
+		if werr := os.WriteFile(m.resultPath(j.view.ID), result, 0o600); werr != nil {
+			err = fmt.Errorf("storing result: %w", werr)
+		}
+		result = nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	finished := m.now()
+	j.view.FinishedAt = &finished
+	switch {
+	case j.ctx.Err() != nil:
+		j.view.Status = jobCanceled
+	case err != nil:
+		m.logger.Error(err, "running scorecard job", "id", j.view.ID)
+		j.view.Status = jobFailed
+		j.view.Error = err.Error()
+	default:
+		j.view.Status = jobSucceeded
+		j.result = result
+	}
+	j.cancel()
+}
+
+func (m *jobManager) get(id string) (jobView, error) {
+	m.mu.Lock()
+	j, ok := m.jobs[id]
+	if !ok {
+		m.mu.Unlock()
+		return jobView{}, errJobNotFound
+	}
+	view := j.view
+	result := j.result
+	m.mu.Unlock()
+
+	if view.Status == jobSucceeded {
+		if m.resultsDir != "" {
+			var err error
+			if result, err = os.ReadFile(m.resultPath(id)); err != nil {
+				return jobView{}, fmt.Errorf("reading result: %w", err)
+			}
+		}
+		view.Result = result
+	}
+	return view, nil
+}
+
+func (m *jobManager) cancel(id string) (jobView, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	j, ok := m.jobs[id]
+	if !ok {
+		return jobView{}, errJobNotFound
+	}
+	if j.finished() {
+		return j.view, errJobFinished
+	}
+	j.cancel()
+	if j.view.Status == jobQueued {
+		// running jobs are marked canceled once their scan returns.
+		finished := m.now()
+		j.view.Status = jobCanceled
+		j.view.FinishedAt = &finished
+	}
+	return j.view, nil
+}
+
+func (m *jobManager) resultPath(id string) string {
+	return filepath.Join(m.resultsDir, id+".json")
+}
+
+func (s *server) handleCreateJob(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	opts, err := s.requestOptions(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	view, err := s.jobs.submit(opts)
+	if err != nil {
+		w.Header().Set("Retry-After", "60")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Location", "/v1/jobs/"+view.ID)
+	writeJSON(w, http.StatusAccepted, view, s.logger)
+}
+
+func (s *server) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	view, err := s.jobs.get(r.PathValue("id"))
+	switch {
+	case errors.Is(err, errJobNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case err != nil:
+		s.logger.Error(err, "getting job")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	default:
+		writeJSON(w, http.StatusOK, view, s.logger)
+	}
+}
+
+func (s *server) handleCancelJob(w http.ResponseWriter, r *http.Request) {
+	view, err := s.jobs.cancel(r.PathValue("id"))
+	switch {
+	case errors.Is(err, errJobNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, errJobFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		writeJSON(w, http.StatusAccepted, view, s.logger)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any, logger *log.Logger) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Error(err, "writing JSON response")
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/options"
+)
+
+var errScan = errors.New("scan failed")
+
+// newJobTestServer returns a server running scan on a single worker.
+func newJobTestServer(t *testing.T, scan scanFunc, config jobManagerConfig) *httptest.Server {
+	t.Helper()
+	logger := log.NewLogger(log.DefaultLevel)
+	srv := newServer(logger)
+	srv.scan = scan
+	config.workers = 1
+	if config.queueSize == 0 {
+		config.queueSize = 10
+	}
+	if config.ttl == 0 {
+		config.ttl = time.Hour
+	}
+	jobs, err := newJobManager(scan, config, logger)
+	if err != nil {
+		t.Fatalf("newJobManager: %v", err)
+	}
+	srv.jobs = jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	jobs.start(ctx, config.workers)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/jobs", srv.handleCreateJob)
+	mux.HandleFunc("GET /v1/jobs/{id}", srv.handleGetJob)
+	mux.HandleFunc("DELETE /v1/jobs/{id}", srv.handleCancelJob)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		ts.Close()
+		cancel()
+		jobs.stop()
+	})
+	return ts
+}
+
+func doJobRequest(t *testing.T, method, url, body string) (int, jobView) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	var view jobView
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(resp.Body).Decode(&view); err != nil {
+			t.Fatalf("decoding job: %v", err)
+		}
+	}
+	return resp.StatusCode, view
+}
+
+func waitForJob(t *testing.T, url string, want jobStatus) jobView {
+	t.Helper()
+	for range 100 {
+		_, view := doJobRequest(t, http.MethodGet, url, "")
+		if view.Status == want {
+			return view
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %s never reached status %q", url, want)
+	return jobView{}
+}
+
+const jobRequestBody = `{"repo": "github.com/ossf/scorecard"}`
+
+func TestJobs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		scan       scanFunc
+		name       string
+		wantStatus jobStatus
+		wantResult string
+		wantError  string
+		resultsDir bool
+	}{
+		{
+			name: "succeeded",
+			scan: func(ctx context.Context, opts *options.Options) ([]byte, error) {
+				return []byte(`{"repo":"` + opts.Repo + `"}`), nil
+			},
+			wantStatus: jobSucceeded,
+			wantResult: `{"repo":"github.com/ossf/scorecard"}`,
+		},
+		{
+			name: "succeeded with results dir",
+			scan: func(ctx context.Context, opts *options.Options) ([]byte, error) {
+				return []byte(`{"score":10}`), nil
+			},
+			resultsDir: true,
+			wantStatus: jobSucceeded,
+			wantResult: `{"score":10}`,
+		},
+		{
+			name: "failed",
+			scan: func(ctx context.Context, opts *options.Options) ([]byte, error) {
+				return nil, errScan
+			},
+			wantStatus: jobFailed,
+			wantError:  errScan.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var config jobManagerConfig
+			if tt.resultsDir {
+				config.resultsDir = t.TempDir()
+			}
+			ts := newJobTestServer(t, tt.scan, config)
+
+			status, created := doJobRequest(t, http.MethodPost, ts.URL+"/v1/jobs", jobRequestBody)
+			if status != http.StatusAccepted {
+				t.Fatalf("POST /v1/jobs: status %d, want %d", status, http.StatusAccepted)
+			}
+			if created.ID == "" {
+				t.Fatal("POST /v1/jobs: no job ID")
+			}
+			got := waitForJob(t, ts.URL+"/v1/jobs/"+created.ID, tt.wantStatus)
+			if string(got.Result) != tt.wantResult {
+				t.Errorf("result = %s, want %s", got.Result, tt.wantResult)
+			}
+			if got.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", got.Error, tt.wantError)
+			}
+			if got.FinishedAt == nil {
+				t.Error("finished job has no finish time")
+			}
+			if tt.resultsDir {
+				if _, err := os.Stat(config.resultsDir + "/" + created.ID + ".json"); err != nil {
+					t.Errorf("result file: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestJobs_cancel(t *testing.T) {
+	t.Parallel()
+	started := make(chan struct{})
+	ts := newJobTestServer(t, func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}, jobManagerConfig{})
+
+	_, running := doJobRequest(t, http.MethodPost, ts.URL+"/v1/jobs", jobRequestBody)
+	<-started
+	// the single worker is busy, so this one stays queued.
+	_, queued := doJobRequest(t, http.MethodPost, ts.URL+"/v1/jobs", jobRequestBody)
+
+	if status, view := doJobRequest(t, http.MethodDelete, ts.URL+"/v1/jobs/"+queued.ID, ""); status != http.StatusAccepted ||
+		view.Status != jobCanceled {
+		t.Errorf("DELETE queued job: status %d, job %q", status, view.Status)
+	}
+	if status, _ := doJobRequest(t, http.MethodDelete, ts.URL+"/v1/jobs/"+running.ID, ""); status != http.StatusAccepted {
+		t.Errorf("DELETE running job: status %d, want %d", status, http.StatusAccepted)
+	}
+	waitForJob(t, ts.URL+"/v1/jobs/"+running.ID, jobCanceled)
+
+	if status, _ := doJobRequest(t, http.MethodDelete, ts.URL+"/v1/jobs/"+running.ID, ""); status != http.StatusConflict {
+		t.Errorf("DELETE finished job: status %d, want %d", status, http.StatusConflict)
+	}
+}
+
+func TestJobs_errors(t *testing.T) {
+	t.Parallel()
+	block := make(chan struct{})
+	ts := newJobTestServer(t, func(ctx context.Context, opts *options.Options) ([]byte, error) {
+		select {
+		case <-block:
+		case <-ctx.Done():
+		}
+		return nil, ctx.Err()
+	}, jobManagerConfig{queueSize: 1})
+	t.Cleanup(func() { close(block) })
+
+	if status, _ := doJobRequest(t, http.MethodGet, ts.URL+"/v1/jobs/unknown", ""); status != http.StatusNotFound {
+		t.Errorf("GET unknown job: status %d, want %d", status, http.StatusNotFound)
+	}
+	if status, _ := doJobRequest(t, http.MethodDelete, ts.URL+"/v1/jobs/unknown", ""); status != http.StatusNotFound {
+		t.Errorf("DELETE unknown job: status %d, want %d", status, http.StatusNotFound)
+	}
+	if status, _ := doJobRequest(t, http.MethodPost, ts.URL+"/v1/jobs", "{"); status != http.StatusBadRequest {
+		t.Errorf("POST invalid body: status %d, want %d", status, http.StatusBadRequest)
+	}
+
+	// one job running and one queued fill the queue.
+	var full bool
+	for range 3 {
+		status, _ := doJobRequest(t, http.MethodPost, ts.URL+"/v1/jobs", jobRequestBody)
+		full = full || status == http.StatusServiceUnavailable
+	}
+	if !full {
+		t.Error("POST to a full queue was accepted")
+	}
+}
+
+func TestJobManager_prune(t *testing.T) {
+	t.Parallel()
+	m, err := newJobManager(nil, jobManagerConfig{queueSize: 2, ttl: time.Minute}, log.NewLogger(log.DefaultLevel))
+	if err != nil {
+		t.Fatalf("newJobManager: %v", err)
+	}
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	old, err := m.submit(options.New())
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	if _, err := m.cancel(old.ID); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := m.submit(options.New()); err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+	if _, err := m.get(old.ID); !errors.Is(err, errJobNotFound) {
+		t.Errorf("get(expired job) error = %v, want %v", err, errJobNotFound)
+	}
+}
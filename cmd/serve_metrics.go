@@ -0,0 +1,221 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	githubstats "github.com/ossf/scorecard/v5/clients/githubrepo/stats"
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+// metricView exposes an OpenCensus view as a Prometheus metric.
+type metricView struct {
+	view *view.View
+	name string
+}
+
+// metricViews are the views recorded by checks and the GitHub roundtripper.
+var metricViews = []metricView{
+	{view: &stats.CheckRuntime, name: "scorecard_check_runtime_seconds"},
+	{view: &stats.CheckErrorCount, name: "scorecard_check_errors_total"},
+	{view: &stats.OutgoingHTTPRequests, name: "scorecard_outgoing_http_requests_total"},
+	{view: &githubstats.GithubTokens, name: "scorecard_github_remaining_tokens"},
+}
+
+func registerMetricViews() error {
+	views := make([]*view.View, 0, len(metricViews))
+	for _, m := range metricViews {
+		views = append(views, m.view)
+	}
+	if err := view.Register(views...); err != nil {
+		return fmt.Errorf("registering views: %w", err)
+	}
+	return nil
+}
+
+// handleMetrics serves the registered views in the Prometheus text format.
+func handleMetrics(logger *log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := writeMetrics(w); err != nil {
+			logger.Error(err, "writing metrics")
+		}
+	}
+}
+
+func writeMetrics(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, m := range metricViews {
+		v := view.Find(m.view.Name)
+		if v == nil {
+			continue
+		}
+		rows, err := view.RetrieveData(v.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %w", v.Name, err)
+		}
+		writeMetric(bw, m.name, v, rows)
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("writing metrics: %w", err)
+	}
+	return nil
+}
+
+func writeMetric(w io.Writer, name string, v *view.View, rows []*view.Row) {
+	var metricType string
+	switch v.Aggregation.Type {
+	case view.AggTypeDistribution:
+		metricType = "histogram"
+	case view.AggTypeCount, view.AggTypeSum:
+		metricType = "counter"
+	default:
+		metricType = "gauge"
+	}
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, v.Description, name, metricType)
+
+	slices.SortFunc(rows, func(a, b *view.Row) int {
+		return strings.Compare(labels(a.Tags), labels(b.Tags))
+	})
+	for _, row := range rows {
+		l := labels(row.Tags)
+		switch data := row.Data.(type) {
+		case *view.DistributionData:
+			var cumulative int64
+			for i, bound := range v.Aggregation.Buckets {
+				cumulative += data.CountPerBucket[i]
+				fmt.Fprintf(w, "%s_bucket%s %d\n", name,
+					withLabel(l, "le", strconv.FormatFloat(bound, 'g', -1, 64)), cumulative)
+			}
+			fmt.Fprintf(w, "%s_bucket%s %d\n", name, withLabel(l, "le", "+Inf"), data.Count)
+			fmt.Fprintf(w, "%s_sum%s %s\n", name, l, formatFloat(data.Mean*float64(data.Count)))
+			fmt.Fprintf(w, "%s_count%s %d\n", name, l, data.Count)
+		case *view.CountData:
+			fmt.Fprintf(w, "%s%s %d\n", name, l, data.Value)
+		case *view.SumData:
+			fmt.Fprintf(w, "%s%s %s\n", name, l, formatFloat(data.Value))
+		case *view.LastValueData:
+			fmt.Fprintf(w, "%s%s %s\n", name, l, formatFloat(data.Value))
+		}
+	}
+}
+
+// labels formats tags as Prometheus labels, e.g. {checkName="Fuzzing"}.
+func labels(tags []tag.Tag) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		pairs = append(pairs, t.Key.Name()+`="`+escapeLabelValue(t.Value)+`"`)
+	}
+	slices.Sort(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func withLabel(labels, name, value string) string {
+	pair := name + `="` + escapeLabelValue(value) + `"`
+	if labels == "" {
+		return "{" + pair + "}"
+	}
+	return strings.TrimSuffix(labels, "}") + "," + pair + "}"
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(v string) string {
+	return labelValueReplacer.Replace(v)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+type accessLogKey struct{}
+
+// setAccessLogPrincipal records who made the request in its access log entry.
+func setAccessLogPrincipal(ctx context.Context, name string) {
+	if p, ok := ctx.Value(accessLogKey{}).(*string); ok {
+		*p = name
+	}
+}
+
+// statusRecorder records the status and size of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	n, err := s.ResponseWriter.Write(b)
+	s.bytes += n
+	return n, err //nolint:wrapcheck
+}
+
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// loggerMiddleware writes an access log entry for every request.
+func loggerMiddleware(logger *log.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		var principal string
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), accessLogKey{}, &principal)))
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		remote, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			remote = r.RemoteAddr
+		}
+		logger.Info("request completed",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"bytes", rec.bytes,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"remote", remote,
+			"user_agent", r.UserAgent(),
+			"principal", principal,
+		)
+	})
+}
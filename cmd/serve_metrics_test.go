@@ -0,0 +1,163 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	opencensusstats "go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/stats"
+)
+
+func TestWriteMetric(t *testing.T) {
+	t.Parallel()
+	histogram := &view.View{
+		Name:        "TestRuntime",
+		Description: "Test runtime",
+		Measure:     stats.CheckRuntimeInSec,
+		TagKeys:     []tag.Key{stats.CheckName},
+		Aggregation: view.Distribution(1, 10),
+	}
+	counter := &view.View{
+		Name:        "TestErrors",
+		Description: "Test errors",
+		Measure:     stats.CheckErrors,
+		TagKeys:     []tag.Key{stats.ErrorName},
+		Aggregation: view.Count(),
+	}
+	tests := []struct {
+		view *view.View
+		name string
+		want string
+		rows []*view.Row
+	}{
+		{
+			name: "histogram",
+			view: histogram,
+			rows: []*view.Row{
+				{
+					Tags: []tag.Tag{{Key: stats.CheckName, Value: "Fuzzing"}},
+					Data: &view.DistributionData{Count: 3, Mean: 4, CountPerBucket: []int64{1, 1, 1}},
+				},
+			},
+			want: `# HELP test_seconds Test runtime
+# TYPE test_seconds histogram
+test_seconds_bucket{checkName="Fuzzing",le="1"} 1
+test_seconds_bucket{checkName="Fuzzing",le="10"} 2
+test_seconds_bucket{checkName="Fuzzing",le="+Inf"} 3
+test_seconds_sum{checkName="Fuzzing"} 12
+test_seconds_count{checkName="Fuzzing"} 3
+`,
+		},
+		{
+			name: "counter",
+			view: counter,
+			rows: []*view.Row{
+				{
+					Tags: []tag.Tag{{Key: stats.ErrorName, Value: `Rate"Limit`}},
+					Data: &view.CountData{Value: 2},
+				},
+				{
+					Tags: []tag.Tag{{Key: stats.ErrorName, Value: "Internal"}},
+					Data: &view.CountData{Value: 1},
+				},
+			},
+			want: `# HELP test_seconds Test errors
+# TYPE test_seconds counter
+test_seconds{errorName="Internal"} 1
+test_seconds{errorName="Rate\"Limit"} 2
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			writeMetric(&buf, "test_seconds", tt.view, tt.rows)
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Errorf("writeMetric() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleMetrics(t *testing.T) {
+	t.Parallel()
+	if err := registerMetricViews(); err != nil {
+		t.Fatalf("registerMetricViews: %v", err)
+	}
+	ctx, err := tag.New(t.Context(),
+		tag.Upsert(stats.CheckName, "TestHandleMetrics"), tag.Upsert(stats.ErrorName, "ErrScorecardInternal"))
+	if err != nil {
+		t.Fatalf("tag.New: %v", err)
+	}
+	opencensusstats.Record(ctx, stats.CheckRuntimeInSec.M(2), stats.CheckErrors.M(1))
+
+	w := httptest.NewRecorder()
+	handleMetrics(log.NewLogger(log.DefaultLevel)).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, want := range []string{
+		`scorecard_check_runtime_seconds_count{checkName="TestHandleMetrics"} 1`,
+		`scorecard_check_errors_total{checkName="TestHandleMetrics",errorName="ErrScorecardInternal"} 1`,
+		"# TYPE scorecard_github_remaining_tokens gauge",
+	} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, w.Body.String())
+		}
+	}
+}
+
+func TestLoggerMiddleware(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	logrusLog := logrus.New()
+	logrusLog.SetOutput(&buf)
+	logrusLog.SetFormatter(&logrus.JSONFormatter{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		setAccessLogPrincipal(r.Context(), "key:bot")
+		http.Error(w, "teapot", http.StatusTeapot)
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/jobs/1", nil)
+	loggerMiddleware(log.NewLogrusLogger(logrusLog), next).ServeHTTP(httptest.NewRecorder(), r)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("access log is not JSON: %v: %s", err, buf.String())
+	}
+	want := map[string]any{
+		"method":    "GET",
+		"path":      "/v1/jobs/1",
+		"status":    float64(http.StatusTeapot),
+		"bytes":     float64(len("teapot\n")),
+		"remote":    "192.0.2.1",
+		"principal": "key:bot",
+	}
+	for k, v := range want {
+		if diff := cmp.Diff(v, entry[k]); diff != "" {
+			t.Errorf("access log field %s mismatch (-want +got):\n%s", k, diff)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	gitclient "github.com/ossf/scorecard/v5/clients/git"
+	"github.com/ossf/scorecard/v5/log"
+)
+
+func TestServer_checkRepoURI(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		uri      string
+		gitHosts []string
+		wantErr  bool
+	}{
+		{
+			name: "forge repo",
+			uri:  "github.com/ossf/scorecard",
+		},
+		{
+			name:    "local repo",
+			uri:     "file:///etc/scorecard",
+			wantErr: true,
+		},
+		{
+			name:     "local repo with allowed hosts",
+			uri:      "file:///etc/scorecard",
+			gitHosts: []string{""},
+			wantErr:  true,
+		},
+		{
+			name:    "ssh",
+			uri:     "ssh://git@git.example.com/foo/bar",
+			wantErr: true,
+		},
+		{
+			name:    "git+ internal host",
+			uri:     "git+http://169.254.169.254/latest/meta-data",
+			wantErr: true,
+		},
+		{
+			name:    "git+ forge host",
+			uri:     "git+https://github.com/ossf/scorecard",
+			wantErr: true,
+		},
+		{
+			name:     "ssh on allowed host",
+			uri:      "ssh://git@git.example.com/foo/bar",
+			gitHosts: []string{"Git.Example.com"},
+		},
+		{
+			name:     "git+ on other host",
+			uri:      "git+https://git.example.org/foo/bar",
+			gitHosts: []string{"git.example.com"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newServer(log.NewLogger(log.DefaultLevel))
+			srv.gitHosts = tt.gitHosts
+			err := srv.checkRepoURI(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkRepoURI(%q) error = %v, wantErr %t", tt.uri, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, errInvalidRequest) {
+				t.Errorf("checkRepoURI(%q) error = %v, want %v", tt.uri, err, errInvalidRequest)
+			}
+		})
+	}
+}
+
+func TestServer_checkGitHost(t *testing.T) {
+	t.Parallel()
+	// repos without a git scheme become generic git repos when makeRepo
+	// doesn't recognize their forge.
+	repo, err := gitclient.MakeGitRepo("https://git.example.com/foo/bar")
+	if err != nil {
+		t.Fatalf("MakeGitRepo: %v", err)
+	}
+	srv := newServer(log.NewLogger(log.DefaultLevel))
+	if err := srv.checkGitHost(repo); !errors.Is(err, errInvalidRequest) {
+		t.Errorf("checkGitHost() error = %v, want %v", err, errInvalidRequest)
+	}
+	srv.gitHosts = []string{"git.example.com"}
+	if err := srv.checkGitHost(repo); err != nil {
+		t.Errorf("checkGitHost() with allowed host: %v", err)
+	}
+}
@@ -66,18 +66,18 @@ var (
 		// "CII-Best-Practices",
 		"CI-Tests", // globally disabled
 		// "Code-Review",
-		"Contributors",           // globally disabled
-		"Dangerous-Workflow",     // not supported on gitlab
+		"Contributors", // globally disabled
+		// "Dangerous-Workflow",
 		"Dependency-Update-Tool", // globally disabled, not supported on gitlab
 		// "Fuzzing",
 		// "License",
 		// "Maintained",
 		// "Packaging",
 		// "Pinned-Dependencies",
-		"SAST", // not supported on gitlab
+		// "SAST",
 		// "Security-Policy",
 		// "Signed-Releases",
-		"Token-Permissions", /// not supported on gitlab
+		// "Token-Permissions",
 		// "Vulnerabilities",
 		"Webhooks", // globally disabled
 	}
@@ -44,6 +44,9 @@ func printProbe(w io.Writer, p *pyaml.Probe) {
 	printField(w, "Motivation", p.Motivation)
 	printField(w, "Implementation", p.Implementation)
 	printField(w, "Outcomes", "\n\n"+strings.Join(p.Outcomes, "\n"))
+	if len(p.Preconditions) > 0 {
+		printField(w, "Preconditions", strings.Join(p.Preconditions, ", "))
+	}
 	// TODO remediation
 	// TODO ecosystem
 }
@@ -0,0 +1,123 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package applicability decides whether a probe applies to a project,
+// based on project facts and the preconditions declared in the probe's def.yml.
+package applicability
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	pyaml "github.com/ossf/scorecard/v5/internal/probes/yaml"
+)
+
+// Precondition is a requirement a project must meet for a probe to apply.
+type Precondition string
+
+const (
+	// HasReleases requires the project to publish releases.
+	HasReleases Precondition = "hasReleases"
+	// HasWorkflows requires the project to have CI workflows.
+	HasWorkflows Precondition = "hasWorkflows"
+	// HasPackages requires the project to have package manifests.
+	HasPackages Precondition = "hasPackages"
+	// IsLibrary requires the project to be a library.
+	IsLibrary Precondition = "isLibrary"
+	// IsApplication requires the project to be an application.
+	IsApplication Precondition = "isApplication"
+	// UsesLanguages requires the project to use one of the
+	// languages listed in the probe's ecosystem.
+	UsesLanguages Precondition = "usesLanguages"
+)
+
+var errUnknownPrecondition = errors.New("unknown precondition")
+
+// Validate returns an error if any precondition is not supported.
+func Validate(preconditions []string) error {
+	for _, p := range preconditions {
+		switch Precondition(p) {
+		case HasReleases, HasWorkflows, HasPackages, IsLibrary, IsApplication, UsesLanguages:
+			continue
+		default:
+			return fmt.Errorf("%w: %q", errUnknownPrecondition, p)
+		}
+	}
+	return nil
+}
+
+// Unmet returns the reason the project does not meet the preconditions
+// of probe p, or false if the probe applies. Unknown facts never make a
+// probe inapplicable.
+func Unmet(p *pyaml.Probe, facts *checker.ProjectFacts) (string, bool) {
+	for _, pre := range p.Preconditions {
+		switch Precondition(pre) {
+		case HasReleases:
+			if isFalse(facts.HasReleases) {
+				return "project has no releases", true
+			}
+		case HasWorkflows:
+			if isFalse(facts.HasWorkflows) {
+				return "project has no CI workflows", true
+			}
+		case HasPackages:
+			if isFalse(facts.HasPackages) {
+				return "project has no package manifests", true
+			}
+		case IsLibrary:
+			if isFalse(facts.IsLibrary) {
+				return "project is not a library", true
+			}
+		case IsApplication:
+			if isTrue(facts.IsLibrary) {
+				return "project is a library", true
+			}
+		case UsesLanguages:
+			if !usesAny(facts.Languages, p.Ecosystem.Languages) {
+				return fmt.Sprintf("project does not use any of: %s",
+					strings.Join(p.Ecosystem.Languages, ", ")), true
+			}
+		}
+	}
+	return "", false
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+func isFalse(b *bool) bool {
+	return b != nil && !*b
+}
+
+func usesAny(projectLangs []clients.LanguageName, probeLangs []string) bool {
+	// unknown languages never make a probe inapplicable.
+	if projectLangs == nil || len(probeLangs) == 0 {
+		return true
+	}
+	for _, pl := range projectLangs {
+		if pl == clients.All {
+			return true
+		}
+		for _, l := range probeLangs {
+			if l == string(clients.All) || strings.EqualFold(string(pl), l) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicability
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	pyaml "github.com/ossf/scorecard/v5/internal/probes/yaml"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	if err := Validate([]string{"hasReleases", "usesLanguages"}); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if err := Validate([]string{"hasMagic"}); !errors.Is(err, errUnknownPrecondition) {
+		t.Errorf("Validate() error = %v, want %v", err, errUnknownPrecondition)
+	}
+}
+
+func TestUnmet(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		facts     checker.ProjectFacts
+		probe     pyaml.Probe
+		wantUnmet bool
+	}{
+		{
+			name:  "no preconditions",
+			facts: checker.ProjectFacts{HasReleases: asPtr(false)},
+		},
+		{
+			name:  "unknown fact",
+			probe: pyaml.Probe{Preconditions: []string{"hasReleases", "isLibrary"}},
+		},
+		{
+			name:      "no releases",
+			probe:     pyaml.Probe{Preconditions: []string{"hasReleases"}},
+			facts:     checker.ProjectFacts{HasReleases: asPtr(false)},
+			wantUnmet: true,
+		},
+		{
+			name:  "has workflows",
+			probe: pyaml.Probe{Preconditions: []string{"hasWorkflows"}},
+			facts: checker.ProjectFacts{HasWorkflows: asPtr(true)},
+		},
+		{
+			name:      "application precondition on library",
+			probe:     pyaml.Probe{Preconditions: []string{"isApplication"}},
+			facts:     checker.ProjectFacts{IsLibrary: asPtr(true)},
+			wantUnmet: true,
+		},
+		{
+			name: "language used",
+			probe: pyaml.Probe{
+				Preconditions: []string{"usesLanguages"},
+				Ecosystem:     pyaml.Ecosystem{Languages: []string{"go", "c#"}},
+			},
+			facts: checker.ProjectFacts{Languages: []clients.LanguageName{"C#"}},
+		},
+		{
+			name: "language not used",
+			probe: pyaml.Probe{
+				Preconditions: []string{"usesLanguages"},
+				Ecosystem:     pyaml.Ecosystem{Languages: []string{"go", "c#"}},
+			},
+			facts:     checker.ProjectFacts{Languages: []clients.LanguageName{clients.Python}},
+			wantUnmet: true,
+		},
+		{
+			name: "all languages",
+			probe: pyaml.Probe{
+				Preconditions: []string{"usesLanguages"},
+				Ecosystem:     pyaml.Ecosystem{Languages: []string{"go"}},
+			},
+			facts: checker.ProjectFacts{Languages: []clients.LanguageName{clients.All}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			reason, unmet := Unmet(&tt.probe, &tt.facts)
+			if unmet != tt.wantUnmet {
+				t.Errorf("Unmet() = %v, want %v", unmet, tt.wantUnmet)
+			}
+			if unmet && reason == "" {
+				t.Error("Unmet() returned no reason")
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicability
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+)
+
+// Compute derives the facts of the project from the raw data collected for
+// the checks in rawData. Raw data of other checks is not read, as it may not
+// have been collected yet. Facts which cannot be determined from the given
+// raw data are left unknown.
+func Compute(raw *checker.RawResults, rawData []checknames.CheckName) checker.ProjectFacts {
+	var facts checker.ProjectFacts
+	for _, name := range rawData {
+		switch name {
+		case checknames.SignedReleases:
+			facts.HasReleases = asPtr(len(raw.SignedReleasesResults.Releases) > 0)
+		case checknames.DangerousWorkflow:
+			facts.HasWorkflows = asPtr(raw.DangerousWorkflowResults.NumWorkflows > 0)
+		case checknames.TokenPermissions:
+			facts.HasWorkflows = asPtr(raw.TokenPermissionsResults.NumTokens > 0)
+		case checknames.Packaging:
+			// workflows publishing no package do not mean the project has none.
+			if publishesPackages(raw.PackagingResults.Packages) {
+				facts.HasPackages = asPtr(true)
+			}
+		case checknames.Fuzzing:
+			facts.Languages = usedLanguages(raw.FuzzingResults.Languages)
+		}
+	}
+	return facts
+}
+
+func asPtr(b bool) *bool {
+	return &b
+}
+
+func publishesPackages(pkgs []checker.Package) bool {
+	for i := range pkgs {
+		// packages with a message only carry debug information.
+		if pkgs[i].Msg == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// usedLanguages returns the names of langs, largest first.
+// Nil is returned if no language was reported, as the languages are then unknown.
+func usedLanguages(langs []clients.Language) []clients.LanguageName {
+	if len(langs) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(langs)
+	slices.SortStableFunc(sorted, func(a, b clients.Language) int {
+		return cmp.Compare(b.NumLines, a.NumLines)
+	})
+	ret := make([]clients.LanguageName, 0, len(sorted))
+	for _, l := range sorted {
+		ret = append(ret, l.Name)
+	}
+	return ret
+}
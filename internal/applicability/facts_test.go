@@ -0,0 +1,108 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicability
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+)
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+	debugMsg := "not a publishing workflow"
+	raw := checker.RawResults{
+		DangerousWorkflowResults: checker.DangerousWorkflowData{NumWorkflows: 2},
+		FuzzingResults: checker.FuzzingData{
+			Languages: []clients.Language{
+				{Name: clients.Dockerfile, NumLines: 5},
+				{Name: clients.Go, NumLines: 95},
+			},
+		},
+		PackagingResults: checker.PackagingData{
+			Packages: []checker.Package{{Msg: &debugMsg}},
+		},
+		SignedReleasesResults: checker.SignedReleasesData{
+			Releases: []clients.Release{{TagName: "v1.0.0"}},
+		},
+	}
+	tests := []struct {
+		raw     *checker.RawResults
+		want    checker.ProjectFacts
+		name    string
+		rawData []checknames.CheckName
+	}{
+		{
+			name: "nothing known without raw data",
+			raw:  &raw,
+			want: checker.ProjectFacts{},
+		},
+		{
+			name:    "raw data of other checks is not read",
+			raw:     &raw,
+			rawData: []checknames.CheckName{checknames.BinaryArtifacts},
+			want:    checker.ProjectFacts{},
+		},
+		{
+			name: "facts of the required raw data",
+			raw:  &raw,
+			rawData: []checknames.CheckName{
+				checknames.DangerousWorkflow, checknames.Fuzzing,
+				checknames.Packaging, checknames.SignedReleases,
+			},
+			want: checker.ProjectFacts{
+				HasReleases:  asPtr(true),
+				HasWorkflows: asPtr(true),
+				Languages:    []clients.LanguageName{clients.Go, clients.Dockerfile},
+			},
+		},
+		{
+			name: "empty raw data",
+			raw:  &checker.RawResults{},
+			rawData: []checknames.CheckName{
+				checknames.Fuzzing, checknames.SignedReleases, checknames.TokenPermissions,
+			},
+			want: checker.ProjectFacts{
+				HasReleases:  asPtr(false),
+				HasWorkflows: asPtr(false),
+			},
+		},
+		{
+			name: "published packages",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					Packages: []checker.Package{{Msg: &debugMsg}, {Runs: []checker.Run{{URL: "run"}}}},
+				},
+			},
+			rawData: []checknames.CheckName{checknames.Packaging},
+			want: checker.ProjectFacts{
+				HasPackages: asPtr(true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Compute(tt.raw, tt.rawData)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Compute() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
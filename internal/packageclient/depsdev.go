@@ -22,6 +22,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // This interface lets Scorecard look up package manager metadata for a project.
@@ -58,6 +59,15 @@ func CreateDepsDevClient() ProjectPackageClient {
 	}
 }
 
+// depsDevHosts are the hosts of the projects known to deps.dev. Projects on
+// other hosts, e.g. GitHub Enterprise Server, aren't looked up so that their
+// names aren't disclosed.
+var depsDevHosts = map[string]bool{
+	"github.com":    true,
+	"gitlab.com":    true,
+	"bitbucket.org": true,
+}
+
 var (
 	ErrDepsDevAPI            = errors.New("deps.dev")
 	ErrProjNotFoundInDepsDev = errors.New("project not found in deps.dev")
@@ -66,6 +76,9 @@ var (
 func (d depsDevClient) GetProjectPackageVersions(
 	ctx context.Context, host, project string,
 ) (*ProjectPackageVersions, error) {
+	if !depsDevHosts[strings.ToLower(host)] {
+		return nil, fmt.Errorf("%w: %s", ErrProjNotFoundInDepsDev, host)
+	}
 	path := fmt.Sprintf("%s/%s", host, project)
 	query := fmt.Sprintf("https://api.deps.dev/v3/projects/%s:packageversions", url.QueryEscape(path))
 
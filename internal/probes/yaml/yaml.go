@@ -35,4 +35,5 @@ type Probe struct {
 	Implementation string      `yaml:"implementation"`
 	Ecosystem      Ecosystem   `yaml:"ecosystem"`
 	Outcomes       []string    `yaml:"outcome"`
+	Preconditions  []string    `yaml:"preconditions"`
 }
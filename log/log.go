@@ -58,6 +58,19 @@ func NewCronLogger(logLevel Level) *Logger {
 	return NewLogrusLogger(logrusLog)
 }
 
+// NewJSONLogger creates an instance of *Logger writing one JSON object per
+// line, for log collectors of container platforms like Kubernetes.
+func NewJSONLogger(logLevel Level) *Logger {
+	logrusLog := logrus.New()
+	logrusLog.SetFormatter(&logrus.JSONFormatter{})
+
+	// Set log level from logrus
+	logrusLevel := parseLogrusLevel(logLevel)
+	logrusLog.SetLevel(logrusLevel)
+
+	return NewLogrusLogger(logrusLog)
+}
+
 // NewLogrusLogger creates an instance of *Logger backed by the supplied
 // logrusLog instance.
 func NewLogrusLogger(logrusLog *logrus.Logger) *Logger {
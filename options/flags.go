@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ossf/scorecard/v5/checks"
+	"github.com/ossf/scorecard/v5/pkg/conformance"
 )
 
 const (
@@ -59,6 +60,9 @@ const (
 	// Flag FlagFileMode is the flag name for specifying how files are fetched for a repository.
 	FlagFileMode = "file-mode"
 
+	// FlagCacheDir is the flag name for specifying a directory to cache HTTP responses in.
+	FlagCacheDir = "cache-dir"
+
 	// FlagShowAnnotations is the flag name for outputting annotations on checks.
 	FlagShowAnnotations = "show-annotations"
 
@@ -79,7 +83,16 @@ const (
 
 	FlagCommitDepth = "commit-depth"
 
+	// FlagFramework is the flag name for specifying the conformance framework.
+	FlagFramework = "framework"
+
 	FlagProbes = "probes"
+
+	// FlagParallelism is the flag name for specifying how many repositories are scanned at once.
+	FlagParallelism = "parallelism"
+
+	// FlagFailUnder is the flag name for specifying the minimum aggregate score of every repository.
+	FlagFailUnder = "fail-under"
 )
 
 // Command is an interface for handling options for command-line utilities.
@@ -110,14 +123,15 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		FlagOrg,
 		o.Org,
 		"scans all non-archived repositories in an organization. "+
-			"Currently only supports GitHub, e.g., 'github.com/ossf' or 'ossf'",
+			"Supports GitHub organizations, GitLab groups and Azure DevOps organizations or projects, "+
+			"e.g., 'github.com/ossf', 'ossf', 'gitlab.com/group' or 'dev.azure.com/org/project'",
 	)
 
 	cmd.Flags().StringVar(
 		&o.Local,
 		FlagLocal,
 		o.Local,
-		"local folder to check",
+		"local folder to check, including its git history if it is a git checkout",
 	)
 
 	cmd.Flags().StringVar(
@@ -214,6 +228,9 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		FormatJSON,
 		FormatProbe,
 		FormatInToto,
+		FormatOSCAL,
+		FormatNDJSON,
+		FormatAggregate,
 	}
 
 	if o.isSarifEnabled() {
@@ -227,6 +244,20 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		allowedFormats = append(allowedFormats, FormatSarif)
 	}
 
+	if o.isV6Enabled() {
+		cmd.Flags().StringVar(
+			&o.Framework,
+			FlagFramework,
+			o.Framework,
+			fmt.Sprintf(
+				"framework to evaluate with the conformance format: %s, or the path to a mapping file",
+				strings.Join(conformance.Available(), ", "),
+			),
+		)
+
+		allowedFormats = append(allowedFormats, FormatConformance)
+	}
+
 	cmd.Flags().StringVar(
 		&o.Format,
 		FlagFormat,
@@ -252,4 +283,25 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		o.FileMode,
 		fmt.Sprintf("mode to fetch repository files: %s", strings.Join(allowedModes, ", ")),
 	)
+
+	cmd.Flags().StringVar(
+		&o.CacheDir,
+		FlagCacheDir,
+		o.CacheDir,
+		"directory to cache HTTP responses in, revalidated on reuse",
+	)
+
+	cmd.Flags().IntVar(
+		&o.Parallelism,
+		FlagParallelism,
+		o.Parallelism,
+		"number of repositories to scan at once with --repos or --org",
+	)
+
+	cmd.Flags().Float64Var(
+		&o.FailUnder,
+		FlagFailUnder,
+		o.FailUnder,
+		"exit with an error if the aggregate score of any repository is below this threshold (0-10)",
+	)
 }
@@ -42,12 +42,16 @@ type Options struct {
 	RubyGems        string
 	Nuget           string
 	PolicyFile      string
+	Framework       string
 	ResultsFile     string
 	FileMode        string
+	CacheDir        string `env:"SCORECARD_CACHE_DIR"`
 	ChecksToRun     []string
 	ProbesToRun     []string
 	Metadata        []string
 	CommitDepth     int
+	Parallelism     int
+	FailUnder       float64
 	ShowDetails     bool
 	ShowAnnotations bool
 	// Feature flags.
@@ -59,10 +63,11 @@ type Options struct {
 // New creates a new instance of `Options`.
 func New() *Options {
 	opts := &Options{
-		Commit:   DefaultCommit,
-		Format:   FormatDefault,
-		LogLevel: DefaultLogLevel,
-		FileMode: FileModeArchive,
+		Commit:      DefaultCommit,
+		Format:      FormatDefault,
+		LogLevel:    DefaultLogLevel,
+		FileMode:    FileModeArchive,
+		Parallelism: 1,
 	}
 	if err := env.Parse(opts); err != nil {
 		log.Printf("could not parse env vars, using default options: %v", err)
@@ -87,6 +92,17 @@ const (
 	FormatRaw = "raw"
 	// FormatInToto specifies that results should be output in an in-toto statement.
 	FormatInToto = "intoto"
+	// FormatConformance specifies that results should be output as framework
+	// conformance verdicts.
+	FormatConformance = "conformance"
+	// FormatOSCAL specifies that results should be output as OSCAL Assessment Results.
+	FormatOSCAL = "oscal"
+	// FormatNDJSON specifies that results should be output in JSON format,
+	// one repository per line.
+	FormatNDJSON = "ndjson"
+	// FormatAggregate specifies that the results of all repositories should be
+	// output as a single JSON document, with a summary of their scores.
+	FormatAggregate = "aggregate"
 
 	// File Modes
 	// FileModeGit specifies that files should be fetched using git.
@@ -110,16 +126,20 @@ var (
 	// DefaultLogLevel retrieves the default log level.
 	DefaultLogLevel = sclog.DefaultLevel.String()
 
-	errCommitIsEmpty          = errors.New("commit should be non-empty")
-	errFormatNotSupported     = errors.New("unsupported format")
-	errFileModeNotSupported   = errors.New("unsupported file mode")
-	errPolicyFileNotSupported = errors.New("policy file is not supported yet")
-	errRawOptionNotSupported  = errors.New("raw option is not supported yet")
-	errRepoOptionMustBeSet    = errors.New(
+	errCommitIsEmpty           = errors.New("commit should be non-empty")
+	errFormatNotSupported      = errors.New("unsupported format")
+	errFileModeNotSupported    = errors.New("unsupported file mode")
+	errPolicyFileNotSupported  = errors.New("policy file is not supported yet")
+	errRawOptionNotSupported   = errors.New("raw option is not supported yet")
+	errConformanceNotSupported = errors.New("conformance option is not supported yet")
+	errFrameworkRequiresFormat = errors.New("framework option requires conformance format")
+	errRepoOptionMustBeSet     = errors.New(
 		"exactly one of `repo`, `repos`, `org`, `npm`, `pypi`, `rubygems`, `nuget` or `local` must be set",
 	)
-	errSARIFNotSupported = errors.New("SARIF format is not supported yet")
-	errValidate          = errors.New("some options could not be validated")
+	errSARIFNotSupported  = errors.New("SARIF format is not supported yet")
+	errParallelismInvalid = errors.New("parallelism must not be negative")
+	errFailUnderInvalid   = errors.New("fail-under threshold must be between 0 and 10")
+	errValidate           = errors.New("some options could not be validated")
 )
 
 // Validate validates scorecard configuration options.
@@ -167,6 +187,19 @@ func (o *Options) Validate() error {
 				errRawOptionNotSupported,
 			)
 		}
+		if o.Format == FormatConformance {
+			errs = append(
+				errs,
+				errConformanceNotSupported,
+			)
+		}
+	}
+
+	if o.Framework != "" && o.Format != FormatConformance {
+		errs = append(
+			errs,
+			errFrameworkRequiresFormat,
+		)
 	}
 
 	// Validate format.
@@ -177,6 +210,20 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	if o.Parallelism < 0 {
+		errs = append(
+			errs,
+			errParallelismInvalid,
+		)
+	}
+
+	if o.FailUnder < 0 || o.FailUnder > 10 {
+		errs = append(
+			errs,
+			errFailUnderInvalid,
+		)
+	}
+
 	// Validate `commit` is non-empty.
 	if o.Commit == "" {
 		errs = append(
@@ -262,7 +309,8 @@ func (o *Options) isV6Enabled() bool {
 
 func validateFormat(format string) bool {
 	switch format {
-	case FormatJSON, FormatProbe, FormatSarif, FormatDefault, FormatRaw, FormatInToto:
+	case FormatJSON, FormatProbe, FormatSarif, FormatDefault, FormatRaw, FormatInToto, FormatConformance, FormatOSCAL,
+		FormatNDJSON, FormatAggregate:
 		return true
 	default:
 		return false
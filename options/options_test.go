@@ -31,10 +31,13 @@ func TestOptions_Validate(t *testing.T) {
 		RubyGems          string
 		Nuget             string
 		PolicyFile        string
+		Framework         string
 		ResultsFile       string
 		FileMode          string
 		ChecksToRun       []string
 		Metadata          []string
+		FailUnder         float64
+		Parallelism       int
 		ShowDetails       bool
 		EnableSarif       bool
 		EnableScorecardV6 bool
@@ -85,6 +88,75 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "format conformance is not supported when V6 is not enabled",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "conformance",
+			},
+			wantErr: true,
+		},
+		{
+			name: "format conformance and the V6 flag is set",
+			fields: fields{
+				Repo:              "github.com/ossf/scorecard",
+				Commit:            "HEAD",
+				Format:            "conformance",
+				Framework:         "osps-baseline",
+				EnableScorecardV6: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "format oscal is supported",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "oscal",
+			},
+			wantErr: false,
+		},
+		{
+			name: "format aggregate is supported",
+			fields: fields{
+				Repo:   "github.com/ossf/scorecard",
+				Commit: "HEAD",
+				Format: "aggregate",
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative parallelism",
+			fields: fields{
+				Repo:        "github.com/ossf/scorecard",
+				Commit:      "HEAD",
+				Format:      "ndjson",
+				Parallelism: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail-under out of range",
+			fields: fields{
+				Repo:      "github.com/ossf/scorecard",
+				Commit:    "HEAD",
+				Format:    "default",
+				FailUnder: 11,
+			},
+			wantErr: true,
+		},
+		{
+			name: "framework without conformance format",
+			fields: fields{
+				Repo:              "github.com/ossf/scorecard",
+				Commit:            "HEAD",
+				Format:            "json",
+				Framework:         "osps-baseline",
+				EnableScorecardV6: true,
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid filemode flagged",
 			fields: fields{
@@ -123,9 +195,12 @@ func TestOptions_Validate(t *testing.T) {
 				RubyGems:          tt.fields.RubyGems,
 				Nuget:             tt.fields.Nuget,
 				PolicyFile:        tt.fields.PolicyFile,
+				Framework:         tt.fields.Framework,
 				ResultsFile:       tt.fields.ResultsFile,
 				ChecksToRun:       tt.fields.ChecksToRun,
 				Metadata:          tt.fields.Metadata,
+				Parallelism:       tt.fields.Parallelism,
+				FailUnder:         tt.fields.FailUnder,
 				ShowDetails:       tt.fields.ShowDetails,
 				EnableSarif:       tt.fields.EnableSarif,
 				EnableScorecardV6: tt.fields.EnableScorecardV6,
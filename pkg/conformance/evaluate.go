@@ -0,0 +1,171 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// Status is the conformance verdict for a control.
+type Status string
+
+const (
+	// StatusPass indicates the evidence shows the control is met.
+	StatusPass Status = "PASS"
+	// StatusFail indicates the evidence shows the control is not met.
+	StatusFail Status = "FAIL"
+	// StatusUnknown indicates Scorecard could not observe the control.
+	StatusUnknown Status = "UNKNOWN"
+	// StatusNotApplicable indicates the control does not apply to the project.
+	StatusNotApplicable Status = "NOT_APPLICABLE"
+)
+
+// ControlResult is the evaluation of a single control.
+type ControlResult struct {
+	ID       string            `json:"id"`
+	Title    string            `json:"title,omitempty"`
+	Status   Status            `json:"status"`
+	Reason   string            `json:"reason"`
+	Note     string            `json:"note,omitempty"`
+	Evidence []finding.Finding `json:"evidence,omitempty"`
+	Level    int               `json:"level,omitempty"`
+}
+
+// Report is the evaluation of all controls in a framework.
+type Report struct {
+	Framework string          `json:"framework"`
+	Version   string          `json:"version,omitempty"`
+	URL       string          `json:"url,omitempty"`
+	Summary   map[Status]int  `json:"summary"`
+	Controls  []ControlResult `json:"controls"`
+}
+
+// Evaluate computes a verdict for each control in f from the given findings.
+// Findings for probes which are not referenced by f are ignored.
+func Evaluate(f *Framework, findings []finding.Finding) *Report {
+	byProbe := map[string][]finding.Finding{}
+	for i := range findings {
+		byProbe[findings[i].Probe] = append(byProbe[findings[i].Probe], findings[i])
+	}
+
+	report := &Report{
+		Framework: f.Name,
+		Version:   f.Version,
+		URL:       f.URL,
+		Summary: map[Status]int{
+			StatusPass:          0,
+			StatusFail:          0,
+			StatusUnknown:       0,
+			StatusNotApplicable: 0,
+		},
+		Controls: make([]ControlResult, 0, len(f.Controls)),
+	}
+	for i := range f.Controls {
+		result := evaluateControl(&f.Controls[i], byProbe)
+		report.Summary[result.Status]++
+		report.Controls = append(report.Controls, result)
+	}
+	return report
+}
+
+func evaluateControl(c *Control, byProbe map[string][]finding.Finding) ControlResult {
+	result := ControlResult{
+		ID:    c.ID,
+		Title: c.Title,
+		Level: c.Level,
+		Note:  c.Note,
+	}
+	if len(c.Probes) == 0 {
+		result.Status = StatusUnknown
+		result.Reason = "no probes provide evidence for this control"
+		return result
+	}
+
+	statuses := map[Status][]string{}
+	for _, p := range c.Probes {
+		findings := byProbe[p.Probe]
+		result.Evidence = append(result.Evidence, findings...)
+		s := probeStatus(findings, p.Pass)
+		statuses[s] = append(statuses[s], p.Probe)
+	}
+
+	result.Status = combine(c.Logic, statuses)
+	result.Reason = reason(result.Status, statuses)
+	return result
+}
+
+// probeStatus interprets the findings of a single probe. Any finding with the
+// failing outcome fails the probe, and inconclusive findings take precedence
+// over passing ones.
+func probeStatus(findings []finding.Finding, pass finding.Outcome) Status {
+	if len(findings) == 0 {
+		return StatusUnknown
+	}
+	fail := finding.OutcomeFalse
+	if pass == finding.OutcomeFalse {
+		fail = finding.OutcomeTrue
+	}
+
+	var passed, inconclusive bool
+	for i := range findings {
+		switch findings[i].Outcome {
+		case fail:
+			return StatusFail
+		case pass:
+			passed = true
+		case finding.OutcomeNotApplicable:
+		default:
+			inconclusive = true
+		}
+	}
+	switch {
+	case inconclusive:
+		return StatusUnknown
+	case passed:
+		return StatusPass
+	default:
+		return StatusNotApplicable
+	}
+}
+
+func combine(logic Logic, statuses map[Status][]string) Status {
+	order := []Status{StatusFail, StatusUnknown, StatusPass}
+	if logic == LogicAny {
+		order = []Status{StatusPass, StatusUnknown, StatusFail}
+	}
+	for _, s := range order {
+		if len(statuses[s]) > 0 {
+			return s
+		}
+	}
+	return StatusNotApplicable
+}
+
+func reason(s Status, statuses map[Status][]string) string {
+	probes := strings.Join(statuses[s], ", ")
+	switch s {
+	case StatusPass:
+		return fmt.Sprintf("passing probes: %s", probes)
+	case StatusFail:
+		return fmt.Sprintf("failing probes: %s", probes)
+	case StatusUnknown:
+		return fmt.Sprintf("no conclusive findings for probes: %s", probes)
+	default:
+		return fmt.Sprintf("not applicable according to probes: %s", probes)
+	}
+}
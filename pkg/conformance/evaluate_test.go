@@ -0,0 +1,158 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		control  Control
+		findings []finding.Finding
+		want     Status
+	}{
+		{
+			name:    "no probes",
+			control: Control{ID: "C"},
+			want:    StatusUnknown,
+		},
+		{
+			name: "probe not run",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+			}},
+			want: StatusUnknown,
+		},
+		{
+			name: "all pass",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+				{Probe: "b", Pass: finding.OutcomeFalse},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeTrue},
+				{Probe: "b", Outcome: finding.OutcomeFalse},
+			},
+			want: StatusPass,
+		},
+		{
+			name: "one failing finding fails the probe",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeTrue},
+				{Probe: "a", Outcome: finding.OutcomeFalse},
+			},
+			want: StatusFail,
+		},
+		{
+			name: "fail takes precedence over unknown",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+				{Probe: "b", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeFalse},
+				{Probe: "b", Outcome: finding.OutcomeError},
+			},
+			want: StatusFail,
+		},
+		{
+			name: "inconclusive finding",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeTrue},
+				{Probe: "a", Outcome: finding.OutcomeNotAvailable},
+			},
+			want: StatusUnknown,
+		},
+		{
+			name: "not applicable ignored when others pass",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+				{Probe: "b", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeTrue},
+				{Probe: "b", Outcome: finding.OutcomeNotApplicable},
+			},
+			want: StatusPass,
+		},
+		{
+			name: "all not applicable",
+			control: Control{ID: "C", Logic: LogicAll, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeNotApplicable},
+			},
+			want: StatusNotApplicable,
+		},
+		{
+			name: "any passes with one passing probe",
+			control: Control{ID: "C", Logic: LogicAny, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+				{Probe: "b", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeFalse},
+				{Probe: "b", Outcome: finding.OutcomeTrue},
+			},
+			want: StatusPass,
+		},
+		{
+			name: "any fails when all probes fail",
+			control: Control{ID: "C", Logic: LogicAny, Probes: []ProbeRequirement{
+				{Probe: "a", Pass: finding.OutcomeTrue},
+				{Probe: "b", Pass: finding.OutcomeTrue},
+			}},
+			findings: []finding.Finding{
+				{Probe: "a", Outcome: finding.OutcomeFalse},
+				{Probe: "b", Outcome: finding.OutcomeFalse},
+			},
+			want: StatusFail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f := &Framework{Name: "test", Controls: []Control{tt.control}}
+			report := Evaluate(f, tt.findings)
+			if len(report.Controls) != 1 {
+				t.Fatalf("got %d controls, want 1", len(report.Controls))
+			}
+			got := report.Controls[0]
+			if got.Status != tt.want {
+				t.Errorf("status = %v, want %v (reason: %s)", got.Status, tt.want, got.Reason)
+			}
+			if report.Summary[tt.want] != 1 {
+				t.Errorf("summary = %v, want one %v", report.Summary, tt.want)
+			}
+			for i := range got.Evidence {
+				if got.Evidence[i].Probe != "a" && got.Evidence[i].Probe != "b" {
+					t.Errorf("unexpected evidence from probe %q", got.Evidence[i].Probe)
+				}
+			}
+		})
+	}
+}
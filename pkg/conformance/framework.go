@@ -0,0 +1,192 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance evaluates probe findings against the controls of a
+// security framework, such as the OSPS Baseline.
+package conformance
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+// DefaultFramework is the name of the framework used when none is specified.
+const DefaultFramework = "osps-baseline"
+
+//go:embed frameworks/*.yaml
+var frameworks embed.FS
+
+var (
+	errInvalidFramework = errors.New("invalid framework")
+	errUnknownFramework = errors.New("unknown framework")
+)
+
+// Logic determines how the statuses of a control's probes are combined.
+type Logic string
+
+const (
+	// LogicAll requires every mapped probe to pass.
+	LogicAll Logic = "all"
+	// LogicAny requires at least one mapped probe to pass.
+	LogicAny Logic = "any"
+)
+
+// ProbeRequirement maps a single probe to a control.
+type ProbeRequirement struct {
+	// Probe is the probe ID, as defined in its def.yml.
+	Probe string `yaml:"probe"`
+	// Pass is the finding outcome which provides positive evidence for the
+	// control. It defaults to True.
+	Pass finding.Outcome `yaml:"pass"`
+}
+
+// Control is a single framework control and the probes which provide
+// evidence toward it.
+type Control struct {
+	ID     string             `yaml:"id"`
+	Title  string             `yaml:"title"`
+	Note   string             `yaml:"note"`
+	Logic  Logic              `yaml:"logic"`
+	Probes []ProbeRequirement `yaml:"probes"`
+	Level  int                `yaml:"level"`
+}
+
+// Framework is a declarative mapping of probes to the controls of a
+// security framework.
+type Framework struct {
+	Name     string    `yaml:"name"`
+	Version  string    `yaml:"version"`
+	URL      string    `yaml:"url"`
+	Controls []Control `yaml:"controls"`
+}
+
+// Parse reads a framework mapping from r.
+func Parse(r io.Reader) (*Framework, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading framework: %w", err)
+	}
+	var f Framework
+	if err := yaml.Unmarshal(content, &f); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidFramework, err)
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Load returns the framework with the given name. The name is either one of
+// the frameworks bundled with Scorecard (see [Available]) or the path to a
+// mapping file. An empty name loads the [DefaultFramework].
+func Load(name string) (*Framework, error) {
+	if name == "" {
+		name = DefaultFramework
+	}
+	if r, err := frameworks.Open(path.Join("frameworks", name+".yaml")); err == nil {
+		defer r.Close()
+		return Parse(r)
+	}
+	r, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %q, expected one of [%s] or a mapping file",
+				errUnknownFramework, name, strings.Join(Available(), ", "))
+		}
+		return nil, fmt.Errorf("opening framework: %w", err)
+	}
+	defer r.Close()
+	return Parse(r)
+}
+
+// Available returns the names of the frameworks bundled with Scorecard.
+func Available() []string {
+	entries, err := frameworks.ReadDir("frameworks")
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, strings.TrimSuffix(e.Name(), ".yaml"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Probes returns the unique probes referenced by the framework's controls.
+func (f *Framework) Probes() []string {
+	seen := map[string]bool{}
+	var probes []string
+	for i := range f.Controls {
+		for _, p := range f.Controls[i].Probes {
+			if !seen[p.Probe] {
+				seen[p.Probe] = true
+				probes = append(probes, p.Probe)
+			}
+		}
+	}
+	sort.Strings(probes)
+	return probes
+}
+
+func (f *Framework) validate() error {
+	if f.Name == "" {
+		return fmt.Errorf("%w: name cannot be empty", errInvalidFramework)
+	}
+	ids := map[string]bool{}
+	for i := range f.Controls {
+		c := &f.Controls[i]
+		if c.ID == "" {
+			return fmt.Errorf("%w: control %d has no id", errInvalidFramework, i)
+		}
+		if ids[c.ID] {
+			return fmt.Errorf("%w: duplicate control %q", errInvalidFramework, c.ID)
+		}
+		ids[c.ID] = true
+
+		switch c.Logic {
+		case "":
+			c.Logic = LogicAll
+		case LogicAll, LogicAny:
+		default:
+			return fmt.Errorf("%w: control %q: unknown logic %q", errInvalidFramework, c.ID, c.Logic)
+		}
+
+		for j := range c.Probes {
+			p := &c.Probes[j]
+			if p.Probe == "" {
+				return fmt.Errorf("%w: control %q: probe cannot be empty", errInvalidFramework, c.ID)
+			}
+			switch p.Pass {
+			case "":
+				p.Pass = finding.OutcomeTrue
+			case finding.OutcomeTrue, finding.OutcomeFalse:
+			default:
+				return fmt.Errorf("%w: control %q: probe %q: pass must be True or False",
+					errInvalidFramework, c.ID, p.Probe)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/finding"
+	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
+	_ "github.com/ossf/scorecard/v5/probes" // register all probes
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want    *Framework
+		wantErr error
+		name    string
+		input   string
+	}{
+		{
+			name: "defaults applied",
+			input: `
+name: test
+controls:
+  - id: C-1
+    probes:
+      - probe: foo
+      - probe: bar
+        pass: False
+`,
+			want: &Framework{
+				Name: "test",
+				Controls: []Control{
+					{
+						ID:    "C-1",
+						Logic: LogicAll,
+						Probes: []ProbeRequirement{
+							{Probe: "foo", Pass: finding.OutcomeTrue},
+							{Probe: "bar", Pass: finding.OutcomeFalse},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "missing name",
+			input:   "controls: []",
+			wantErr: errInvalidFramework,
+		},
+		{
+			name: "duplicate control",
+			input: `
+name: test
+controls:
+  - id: C-1
+  - id: C-1
+`,
+			wantErr: errInvalidFramework,
+		},
+		{
+			name: "unknown logic",
+			input: `
+name: test
+controls:
+  - id: C-1
+    logic: some
+`,
+			wantErr: errInvalidFramework,
+		},
+		{
+			name: "inconclusive pass outcome",
+			input: `
+name: test
+controls:
+  - id: C-1
+    probes:
+      - probe: foo
+        pass: NotApplicable
+`,
+			wantErr: errInvalidFramework,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Parse(strings.NewReader(tt.input))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Parse() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoad_unknown(t *testing.T) {
+	t.Parallel()
+	if _, err := Load("not-a-framework"); !errors.Is(err, errUnknownFramework) {
+		t.Errorf("Load() error = %v, want %v", err, errUnknownFramework)
+	}
+}
+
+// TestAvailable_probesRegistered ensures bundled frameworks only reference
+// probes which exist.
+func TestAvailable_probesRegistered(t *testing.T) {
+	t.Parallel()
+	names := Available()
+	if len(names) == 0 {
+		t.Fatal("no bundled frameworks")
+	}
+	for _, name := range names {
+		f, err := Load(name)
+		if err != nil {
+			t.Fatalf("Load(%q): %v", name, err)
+		}
+		for _, p := range f.Probes() {
+			if _, err := proberegistration.Get(p); err != nil {
+				t.Errorf("framework %q: %v", name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	docs "github.com/ossf/scorecard/v5/docs/checks"
+	sce "github.com/ossf/scorecard/v5/errors"
+)
+
+// AggregateEntry is the outcome of scanning one of several repositories:
+// either its Result, or the error which prevented scanning it.
+type AggregateEntry struct {
+	Err    error
+	Result *Result
+	Repo   string
+}
+
+type jsonAggregateSummary struct {
+	Score *jsonFloatScore `json:"score,omitempty"`
+	Repo  string          `json:"repo"`
+	Error string          `json:"error,omitempty"`
+}
+
+// JSONAggregateResult exports the results of several repositories as JSON.
+type JSONAggregateResult struct {
+	Summary []jsonAggregateSummary  `json:"summary"`
+	Results []JSONScorecardResultV2 `json:"results"`
+}
+
+// AsAggregateJSON exports the results of several repositories as a single
+// JSON document, summarizing the aggregate score of each repository.
+func AsAggregateJSON(writer io.Writer, entries []AggregateEntry, checkDocs docs.Doc, opt *AsJSON2ResultOption) error {
+	out := JSONAggregateResult{
+		Summary: make([]jsonAggregateSummary, 0, len(entries)),
+		Results: make([]JSONScorecardResultV2, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		summary := jsonAggregateSummary{Repo: entry.Repo}
+		if entry.Err != nil {
+			summary.Error = entry.Err.Error()
+			out.Summary = append(out.Summary, summary)
+			continue
+		}
+		result, err := entry.Result.resultsToJSON2(checkDocs, opt)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		summary.Repo = result.Repo.Name
+		summary.Score = &result.AggregateScore
+		out.Summary = append(out.Summary, summary)
+		out.Results = append(out.Results, result)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+)
+
+func TestAsAggregateJSON(t *testing.T) {
+	t.Parallel()
+	date := time.Date(2026, time.March, 2, 10, 30, 0, 0, time.UTC)
+	result := func(name string, score int) *Result {
+		return &Result{
+			Repo: RepoInfo{Name: name, CommitSHA: "68bc59901773ab4c051dfcea0cc4201a1567ab32"},
+			Date: date,
+			Checks: []checker.CheckResult{
+				{Name: "Check-Name", Score: score, Reason: "reason"},
+			},
+		}
+	}
+	entries := []AggregateEntry{
+		{Repo: "github.com/org/a", Result: result("github.com/org/a", 10)},
+		{Repo: "github.com/org/b", Err: errors.New("repo not found")},
+		{Repo: "github.com/org/c", Result: result("github.com/org/c", 3)},
+	}
+
+	var buf bytes.Buffer
+	if err := AsAggregateJSON(&buf, entries, jsonMockDocRead(), nil); err != nil {
+		t.Fatalf("AsAggregateJSON: %v", err)
+	}
+
+	var got struct {
+		Summary []struct {
+			Score *float64 `json:"score"`
+			Repo  string   `json:"repo"`
+			Error string   `json:"error"`
+		} `json:"summary"`
+		Results []struct {
+			Repo struct {
+				Name string `json:"name"`
+			} `json:"repo"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	ten, three := 10.0, 3.0
+	wantSummary := []struct {
+		Score *float64 `json:"score"`
+		Repo  string   `json:"repo"`
+		Error string   `json:"error"`
+	}{
+		{Repo: "github.com/org/a", Score: &ten},
+		{Repo: "github.com/org/b", Error: "repo not found"},
+		{Repo: "github.com/org/c", Score: &three},
+	}
+	if diff := cmp.Diff(wantSummary, got.Summary); diff != "" {
+		t.Errorf("summary mismatch (-want +got):\n%s", diff)
+	}
+	if len(got.Results) != 2 || got.Results[1].Repo.Name != "github.com/org/c" {
+		t.Errorf("results = %+v, want the results of the scanned repos", got.Results)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/pkg/conformance"
+)
+
+var errNoConformance = errors.New("no conformance framework was evaluated")
+
+// JSONScorecardConformanceResult exports framework conformance verdicts as JSON.
+type JSONScorecardConformanceResult struct {
+	Date      string          `json:"date"`
+	Repo      jsonRepoV2      `json:"repo"`
+	Scorecard jsonScorecardV2 `json:"scorecard"`
+	*conformance.Report
+}
+
+// ConformanceResultOption provides configuration options for the conformance output format.
+type ConformanceResultOption struct {
+	// Indent is used to control the JSON indentation. For example, if you want to pretty print.
+	Indent string
+}
+
+// AsConformance writes the conformance verdicts computed for the framework
+// configured with [WithConformanceFramework] as JSON.
+// It accepts an optional argument to configure the output.
+func (r *Result) AsConformance(writer io.Writer, o *ConformanceResultOption) error {
+	if r.Conformance == nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, errNoConformance.Error())
+	}
+	encoder := json.NewEncoder(writer)
+	out := JSONScorecardConformanceResult{
+		Repo: jsonRepoV2{
+			Name:   r.Repo.Name,
+			Commit: r.Repo.CommitSHA,
+		},
+		Scorecard: jsonScorecardV2{
+			Version: r.Scorecard.Version,
+			Commit:  r.Scorecard.CommitSHA,
+		},
+		Date:   r.Date.Format("2006-01-02"),
+		Report: r.Conformance,
+	}
+
+	if o != nil {
+		encoder.SetIndent("", o.Indent)
+	}
+
+	if err := encoder.Encode(out); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ossf/scorecard/v5/checks"
+	"github.com/ossf/scorecard/v5/clients"
+	mockrepo "github.com/ossf/scorecard/v5/clients/mockclients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/pkg/conformance"
+	"github.com/ossf/scorecard/v5/probes/branchesAreProtected"
+	"github.com/ossf/scorecard/v5/probes/fuzzed"
+	"github.com/ossf/scorecard/v5/probes/hasPermissiveLicense"
+	"github.com/ossf/scorecard/v5/probes/releasesAreSigned"
+	"github.com/ossf/scorecard/v5/probes/releasesHaveProvenance"
+)
+
+var errConformanceTest = errors.New("test error")
+
+func conformanceFramework(controls map[string][]string) *conformance.Framework {
+	f := &conformance.Framework{Name: "test"}
+	for _, id := range []string{"C-1", "C-2"} {
+		c := conformance.Control{ID: id, Logic: conformance.LogicAll}
+		for _, p := range controls[id] {
+			c.Probes = append(c.Probes, conformance.ProbeRequirement{Probe: p, Pass: finding.OutcomeTrue})
+		}
+		f.Controls = append(f.Controls, c)
+	}
+	return f
+}
+
+func controlStatuses(r *conformance.Report) map[string]conformance.Status {
+	ret := map[string]conformance.Status{}
+	for i := range r.Controls {
+		ret[r.Controls[i].ID] = r.Controls[i].Status
+	}
+	return ret
+}
+
+func newConformanceMocks(t *testing.T) (*mockrepo.MockRepo, *mockrepo.MockRepoClient, *mockrepo.MockRepoClient) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	repo := mockrepo.NewMockRepo(ctrl)
+	repo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+	repo.EXPECT().Host().Return("github.com").AnyTimes()
+	repo.EXPECT().Path().Return("ossf/scorecard").AnyTimes()
+	repo.EXPECT().Type().Return(clients.RepoTypeGitHub).AnyTimes()
+
+	repoClient := mockrepo.NewMockRepoClient(ctrl)
+	repoClient.EXPECT().InitRepo(repo, clients.HeadSHA, 0).Return(nil)
+	repoClient.EXPECT().Close().Return(nil)
+	repoClient.EXPECT().ListCommits().Return([]clients.Commit{{SHA: "1a17bb812fb2ac23e9d09e86e122f8b67563aed7"}}, nil)
+	repoClient.EXPECT().GetDefaultBranchName().Return("main", nil).AnyTimes()
+	repoClient.EXPECT().LocalPath().Return("test_path", nil).AnyTimes()
+	repoClient.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+	repoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
+	repoClient.EXPECT().GetFileReader(gomock.Any()).Return(nil, os.ErrNotExist).AnyTimes()
+	repoClient.EXPECT().ListProgrammingLanguages().Return([]clients.Language{{Name: clients.Go, NumLines: 1}}, nil).AnyTimes()
+
+	ossFuzzClient := mockrepo.NewMockRepoClient(ctrl)
+	ossFuzzClient.EXPECT().Search(gomock.Any()).Return(clients.SearchResponse{}, nil).AnyTimes()
+	return repo, repoClient, ossFuzzClient
+}
+
+// Framework probes whose raw data can't be collected must not abort a run
+// in probe mode, but be reported as inconclusive.
+func TestRun_conformanceWithProbes(t *testing.T) {
+	t.Parallel()
+	repo, repoClient, ossFuzzClient := newConformanceMocks(t)
+	repoClient.EXPECT().GetDefaultBranch().Return(nil, errConformanceTest).Times(1)
+
+	framework := conformanceFramework(map[string][]string{
+		"C-1": {fuzzed.Probe},
+		"C-2": {branchesAreProtected.Probe},
+	})
+	got, err := Run(t.Context(), repo,
+		WithRepoClient(repoClient),
+		WithOSSFuzzClient(ossFuzzClient),
+		WithProbes([]string{fuzzed.Probe}),
+		WithConformanceFramework(framework),
+	)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	want := map[string]conformance.Status{
+		"C-1": conformance.StatusFail,
+		"C-2": conformance.StatusUnknown,
+	}
+	if diff := cmp.Diff(want, controlStatuses(got.Conformance)); diff != "" {
+		t.Errorf("control statuses (-want +got):\n%s", diff)
+	}
+}
+
+// In check mode, raw data of successful checks is reused by framework
+// probes, and raw data which failed to be collected is only retried once.
+func TestRun_conformanceWithChecks(t *testing.T) {
+	t.Parallel()
+	repo, repoClient, ossFuzzClient := newConformanceMocks(t)
+	// once by the License check, reused by hasPermissiveLicense.
+	repoClient.EXPECT().ListLicenses().Return(nil, nil).Times(1)
+	// once by the Signed-Releases check, once more for the framework probes.
+	repoClient.EXPECT().ListReleases().Return(nil, errConformanceTest).Times(2)
+
+	framework := conformanceFramework(map[string][]string{
+		"C-1": {hasPermissiveLicense.Probe},
+		"C-2": {releasesAreSigned.Probe, releasesHaveProvenance.Probe},
+	})
+	got, err := Run(t.Context(), repo,
+		WithRepoClient(repoClient),
+		WithOSSFuzzClient(ossFuzzClient),
+		WithChecks([]string{checks.CheckLicense, checks.CheckSignedReleases}),
+		WithConformanceFramework(framework),
+	)
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+	want := map[string]conformance.Status{
+		"C-1": conformance.StatusFail,
+		"C-2": conformance.StatusUnknown,
+	}
+	if diff := cmp.Diff(want, controlStatuses(got.Conformance)); diff != "" {
+		t.Errorf("control statuses (-want +got):\n%s", diff)
+	}
+}
+
+func TestAsConformance(t *testing.T) {
+	t.Parallel()
+	framework := &conformance.Framework{
+		Name:    "Test Framework",
+		Version: "v1",
+		Controls: []conformance.Control{
+			{
+				ID:    "TEST-01",
+				Title: "Has X",
+				Level: 1,
+				Logic: conformance.LogicAll,
+				Probes: []conformance.ProbeRequirement{
+					{Probe: "hasX", Pass: finding.OutcomeTrue},
+				},
+			},
+			{
+				ID:    "TEST-02",
+				Title: "No Y",
+				Level: 1,
+				Logic: conformance.LogicAll,
+				Probes: []conformance.ProbeRequirement{
+					{Probe: "hasY", Pass: finding.OutcomeFalse},
+				},
+			},
+			{
+				ID:    "TEST-03",
+				Title: "Not observable",
+				Level: 2,
+			},
+		},
+	}
+	findings := []finding.Finding{
+		{
+			Probe:   "hasX",
+			Outcome: finding.OutcomeTrue,
+			Message: "found X",
+			Location: &finding.Location{
+				Path: "some/path/to/file",
+				Type: finding.FileTypeText,
+			},
+		},
+		{
+			Probe:   "hasY",
+			Outcome: finding.OutcomeTrue,
+			Message: "found Y",
+		},
+		{
+			Probe:   "unrelated",
+			Outcome: finding.OutcomeFalse,
+		},
+	}
+	result := Result{
+		Repo: RepoInfo{
+			Name:      "foo",
+			CommitSHA: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		},
+		Scorecard: ScorecardInfo{
+			Version:   "1.2.3",
+			CommitSHA: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		},
+		Date:        time.Date(2024, time.February, 1, 13, 48, 0, 0, time.UTC),
+		Findings:    findings,
+		Conformance: conformance.Evaluate(framework, findings),
+	}
+
+	expected, err := os.ReadFile("./testdata/conformance1.json")
+	if err != nil {
+		t.Fatalf("cannot read expected results file: %v", err)
+	}
+
+	var got bytes.Buffer
+	// pretty print results so the test files are easier to read
+	if err := result.AsConformance(&got, &ConformanceResultOption{Indent: "    "}); err != nil {
+		t.Fatalf("AsConformance: %v", err)
+	}
+	if diff := cmp.Diff(expected, got.Bytes()); diff != "" {
+		t.Errorf("results differ: %s", diff)
+	}
+}
+
+func TestAsConformance_noFramework(t *testing.T) {
+	t.Parallel()
+	var r Result
+	var got bytes.Buffer
+	if err := r.AsConformance(&got, nil); err == nil {
+		t.Error("expected error when no framework was evaluated")
+	}
+}
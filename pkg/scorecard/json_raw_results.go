@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	sce "github.com/ossf/scorecard/v5/errors"
 )
 
@@ -71,6 +72,36 @@ type jsonBranchProtectionSettings struct {
 type jsonBranchProtection struct {
 	Protection *jsonBranchProtectionSettings `json:"protection"`
 	Name       string                        `json:"name"`
+	RuleSets   []jsonRuleSet                 `json:"rulesets,omitempty"`
+}
+
+type jsonRuleSet struct {
+	Name                string                 `json:"name"`
+	Enforcement         string                 `json:"enforcement"`
+	Source              string                 `json:"source,omitempty"`
+	SourceName          string                 `json:"sourceName,omitempty"`
+	BypassActors        []jsonBypassActor      `json:"bypassActors,omitempty"`
+	RequiredWorkflows   []jsonRequiredWorkflow `json:"requiredWorkflows,omitempty"`
+	RequiredDeployments []string               `json:"requiredDeployments,omitempty"`
+	CodeScanningTools   []jsonCodeScanningTool `json:"codeScanningTools,omitempty"`
+}
+
+type jsonBypassActor struct {
+	Type            string `json:"type"`
+	Name            string `json:"name,omitempty"`
+	PullRequestOnly bool   `json:"pullRequestOnly"`
+}
+
+type jsonRequiredWorkflow struct {
+	Path         string `json:"path"`
+	Ref          string `json:"ref,omitempty"`
+	RepositoryID int64  `json:"repositoryId"`
+}
+
+type jsonCodeScanningTool struct {
+	Tool                    string `json:"tool"`
+	AlertsThreshold         string `json:"alertsThreshold"`
+	SecurityAlertsThreshold string `json:"securityAlertsThreshold"`
 }
 
 type jsonBranchProtectionMetadata struct {
@@ -120,13 +151,21 @@ type jsonDefaultBranchChangeset struct {
 }
 
 type jsonCommit struct {
-	Message   string   `json:"message"`
-	SHA       string   `json:"sha"`
-	Committer jsonUser `json:"committer"`
+	Signature *jsonSignature `json:"signature,omitempty"`
+	Message   string         `json:"message"`
+	SHA       string         `json:"sha"`
+	Committer jsonUser       `json:"committer"`
 
 	// TODO: check runs, etc.
 }
 
+type jsonSignature struct {
+	Type     string `json:"type"`
+	Signer   string `json:"signer,omitempty"`
+	State    string `json:"state,omitempty"`
+	Verified bool   `json:"verified"`
+}
+
 type jsonDatabaseVulnerability struct {
 	// For OSV: OSV-2020-484
 	// For CVE: CVE-2022-23945
@@ -158,12 +197,19 @@ type jsonIssue struct {
 }
 
 type jsonRelease struct {
-	Tag    string             `json:"tag"`
-	URL    string             `json:"url"`
-	Assets []jsonReleaseAsset `json:"assets"`
+	TagDetails *jsonTag           `json:"tagDetails,omitempty"`
+	Tag        string             `json:"tag"`
+	URL        string             `json:"url"`
+	Assets     []jsonReleaseAsset `json:"assets"`
 	// TODO: add needed fields, e.g. Path.
 }
 
+type jsonTag struct {
+	Signature *jsonSignature `json:"signature,omitempty"`
+	Protected *bool          `json:"protected,omitempty"`
+	Annotated bool           `json:"annotated"`
+}
+
 type jsonReleaseAsset struct {
 	Path string `json:"path"`
 	URL  string `json:"url"`
@@ -197,6 +243,13 @@ type jsonWorkflowJob struct {
 	ID   *string `json:"id"`
 }
 
+type jsonSelfHostedRunnerJob struct {
+	Job      *jsonWorkflowJob `json:"job"`
+	File     *jsonFile        `json:"file"`
+	Triggers []string         `json:"triggers"`
+	Secrets  []string         `json:"secrets,omitempty"`
+}
+
 type jsonPackage struct {
 	Name *string          `json:"name,omitempty"`
 	Job  *jsonWorkflowJob `json:"job,omitempty"`
@@ -252,6 +305,8 @@ type jsonSecurityPolicyHits struct {
 type jsonRawResults struct {
 	// Workflow results.
 	Workflows []jsonWorkflow `json:"workflows"`
+	// Jobs of workflows running on self-hosted runners.
+	SelfHostedRunners []jsonSelfHostedRunnerJob `json:"selfHostedRunners,omitempty"`
 	// Permissions.
 	Permissions jsonPermissionsData `json:"permissions"`
 	// License.
@@ -431,6 +486,26 @@ func (r *jsonScorecardRawResult) addDangerousWorkflowRawResults(df *checker.Dang
 		r.Results.Workflows = append(r.Results.Workflows, v)
 	}
 
+	for i := range df.SelfHostedRunnerJobs {
+		e := &df.SelfHostedRunnerJobs[i]
+		v := jsonSelfHostedRunnerJob{
+			File: &jsonFile{
+				Path:    e.File.Path,
+				Offset:  e.File.Offset,
+				Snippet: asPointer(e.File.Snippet),
+			},
+			Triggers: e.Triggers,
+			Secrets:  e.Secrets,
+		}
+		if e.Job != nil {
+			v.Job = &jsonWorkflowJob{
+				Name: e.Job.Name,
+				ID:   e.Job.ID,
+			}
+		}
+		r.Results.SelfHostedRunners = append(r.Results.SelfHostedRunners, v)
+	}
+
 	return nil
 }
 
@@ -466,6 +541,18 @@ func (r *jsonScorecardRawResult) addContributorsRawResults(cr *checker.Contribut
 	return nil
 }
 
+func jsonSignatureFrom(sig *clients.Signature) *jsonSignature {
+	if sig == nil {
+		return nil
+	}
+	return &jsonSignature{
+		Type:     string(sig.Type),
+		Signer:   sig.Signer,
+		State:    sig.State,
+		Verified: sig.Verified,
+	}
+}
+
 //nolint:unparam
 func (r *jsonScorecardRawResult) addSignedReleasesRawResults(sr *checker.SignedReleasesData) error {
 	r.Results.Releases = []jsonRelease{}
@@ -475,6 +562,13 @@ func (r *jsonScorecardRawResult) addSignedReleasesRawResults(sr *checker.SignedR
 				Tag: release.TagName,
 				URL: release.URL,
 			})
+		if tag := release.Tag; tag != nil {
+			r.Results.Releases[i].TagDetails = &jsonTag{
+				Signature: jsonSignatureFrom(tag.Signature),
+				Protected: tag.Protected,
+				Annotated: tag.Annotated,
+			}
+		}
 		for _, asset := range release.Assets {
 			r.Results.Releases[i].Assets = append(r.Results.Releases[i].Assets,
 				jsonReleaseAsset{
@@ -545,13 +639,15 @@ func (r *jsonScorecardRawResult) setDefaultCommitData(changesets []checker.Chang
 		commits := []jsonCommit{}
 		for j := range cs.Commits {
 			commit := cs.Commits[j]
-			commits = append(commits, jsonCommit{
+			jc := jsonCommit{
 				Committer: jsonUser{
 					Login: commit.Committer.Login,
 				},
 				Message: commit.Message,
 				SHA:     commit.SHA,
-			})
+			}
+			jc.Signature = jsonSignatureFrom(commit.Signature)
+			commits = append(commits, jc)
 		}
 
 		reviews := []jsonReview{}
@@ -725,6 +821,7 @@ func (r *jsonScorecardRawResult) addBranchProtectionRawResults(bp *checker.Branc
 		branches = append(branches, jsonBranchProtection{
 			Name:       *v.Name,
 			Protection: bp,
+			RuleSets:   jsonRuleSetsFrom(v.RuleSets),
 		})
 	}
 	r.Results.BranchProtections.Branches = branches
@@ -734,6 +831,35 @@ func (r *jsonScorecardRawResult) addBranchProtectionRawResults(bp *checker.Branc
 	return nil
 }
 
+func jsonRuleSetsFrom(ruleSets []clients.RuleSet) []jsonRuleSet {
+	var ret []jsonRuleSet
+	for i := range ruleSets {
+		rs := &ruleSets[i]
+		j := jsonRuleSet{
+			Name:                rs.Name,
+			Enforcement:         string(rs.Enforcement),
+			Source:              string(rs.Source),
+			SourceName:          rs.SourceName,
+			RequiredDeployments: rs.RequiredDeployments,
+		}
+		for _, a := range rs.BypassActors {
+			j.BypassActors = append(j.BypassActors, jsonBypassActor{
+				Type:            string(a.Type),
+				Name:            a.Name,
+				PullRequestOnly: a.PullRequestOnly,
+			})
+		}
+		for _, w := range rs.RequiredWorkflows {
+			j.RequiredWorkflows = append(j.RequiredWorkflows, jsonRequiredWorkflow(w))
+		}
+		for _, t := range rs.CodeScanningTools {
+			j.CodeScanningTools = append(j.CodeScanningTools, jsonCodeScanningTool(t))
+		}
+		ret = append(ret, j)
+	}
+	return ret
+}
+
 func (r *jsonScorecardRawResult) fillJSONRawResults(raw *checker.RawResults) error {
 	// Licenses.
 	if err := r.addLicenseRawResults(&raw.LicenseResults); err != nil {
@@ -328,6 +328,44 @@ func TestJsonScorecardRawResult_AddDangerousWorkflowRawResults(t *testing.T) {
 	}
 }
 
+func TestJsonScorecardRawResult_AddSelfHostedRunnerJobs(t *testing.T) {
+	t.Parallel()
+
+	r := &jsonScorecardRawResult{}
+	err := r.addDangerousWorkflowRawResults(&checker.DangerousWorkflowData{
+		SelfHostedRunnerJobs: []checker.SelfHostedRunnerJob{
+			{
+				Job:      &checker.WorkflowJob{ID: asPointer("build")},
+				Triggers: []string{"pull_request_target"},
+				Secrets:  []string{"NPM_TOKEN"},
+				File: checker.File{
+					Path:    ".github/workflows/ci.yml",
+					Offset:  11,
+					Snippet: "self-hosted, linux",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []jsonSelfHostedRunnerJob{
+		{
+			Job: &jsonWorkflowJob{ID: asPointer("build")},
+			File: &jsonFile{
+				Path:    ".github/workflows/ci.yml",
+				Offset:  11,
+				Snippet: asPointer("self-hosted, linux"),
+			},
+			Triggers: []string{"pull_request_target"},
+			Secrets:  []string{"NPM_TOKEN"},
+		},
+	}
+	if diff := cmp.Diff(want, r.Results.SelfHostedRunners); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestJsonScorecardRawResult_AddContributorsRawResults(t *testing.T) {
 	t.Parallel()
 
@@ -373,6 +411,7 @@ func TestJsonScorecardRawResult_AddSignedReleasesRawResults(t *testing.T) {
 	tests := []struct { //nolint:govet
 		name      string
 		input     *checker.SignedReleasesData
+		want      []jsonRelease
 		wantError bool
 	}{
 		{
@@ -393,6 +432,44 @@ func TestJsonScorecardRawResult_AddSignedReleasesRawResults(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "test_with_tag_details",
+			input: &checker.SignedReleasesData{
+				Releases: []clients.Release{
+					{
+						TagName: "v1.1",
+						URL:     "https://example.com/v1.1",
+						Tag: &clients.Tag{
+							Signature: &clients.Signature{
+								Type:     clients.SignatureTypeGPG,
+								Signer:   "0536F80E1E02DA6042A1BB3C127CF438218509B8",
+								State:    "VALID",
+								Verified: true,
+							},
+							Protected: boolPtr(true),
+							Annotated: true,
+						},
+					},
+				},
+			},
+			want: []jsonRelease{
+				{
+					Tag: "v1.1",
+					URL: "https://example.com/v1.1",
+					TagDetails: &jsonTag{
+						Signature: &jsonSignature{
+							Type:     "gpg",
+							Signer:   "0536F80E1E02DA6042A1BB3C127CF438218509B8",
+							State:    "VALID",
+							Verified: true,
+						},
+						Protected: boolPtr(true),
+						Annotated: true,
+					},
+				},
+			},
+			wantError: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -404,6 +481,11 @@ func TestJsonScorecardRawResult_AddSignedReleasesRawResults(t *testing.T) {
 			if (err != nil) != test.wantError {
 				t.Errorf("addSignedReleasesRawResults() error = %v, wantError %v", err, test.wantError)
 			}
+			if test.want != nil {
+				if diff := cmp.Diff(test.want, r.Results.Releases); diff != "" {
+					t.Errorf("addSignedReleasesRawResults() mismatch (-want +got):\n%s", diff)
+				}
+			}
 		})
 	}
 }
@@ -478,6 +560,12 @@ func TestSetDefaultCommitData(t *testing.T) {
 					Committer: clients.User{
 						Login: "johndoe",
 					},
+					Signature: &clients.Signature{
+						Type:     clients.SignatureTypeGPG,
+						Signer:   "johndoe",
+						State:    "VALID",
+						Verified: true,
+					},
 				},
 			},
 			Reviews: []clients.Review{
@@ -516,6 +604,12 @@ func TestSetDefaultCommitData(t *testing.T) {
 					},
 					Message: "Initial commit",
 					SHA:     "def456",
+					Signature: &jsonSignature{
+						Type:     "gpg",
+						Signer:   "johndoe",
+						State:    "VALID",
+						Verified: true,
+					},
 				},
 			},
 			Reviews: []jsonReview{
@@ -1323,6 +1417,57 @@ func TestAddBranchProtectionRawResults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "branch with rulesets",
+			input: &checker.BranchProtectionsData{
+				Branches: []clients.BranchRef{
+					{
+						Name:      stringPtr("main"),
+						Protected: boolPtr(true),
+						RuleSets: []clients.RuleSet{
+							{
+								Name:        "org baseline",
+								Enforcement: clients.RuleSetEnforcementEvaluate,
+								Source:      clients.RuleSetSourceOrganization,
+								SourceName:  "ossf",
+								BypassActors: []clients.BypassActor{
+									{Type: clients.BypassActorTeam, Name: "ossf/admins", PullRequestOnly: true},
+								},
+								RequiredWorkflows: []clients.RequiredWorkflow{
+									{Path: ".github/workflows/ci.yml", RepositoryID: 42},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &jsonScorecardRawResult{
+				Results: jsonRawResults{
+					BranchProtections: jsonBranchProtectionMetadata{
+						Branches: []jsonBranchProtection{
+							{
+								Name:       "main",
+								Protection: &jsonBranchProtectionSettings{},
+								RuleSets: []jsonRuleSet{
+									{
+										Name:        "org baseline",
+										Enforcement: "evaluate",
+										Source:      "organization",
+										SourceName:  "ossf",
+										BypassActors: []jsonBypassActor{
+											{Type: "team", Name: "ossf/admins", PullRequestOnly: true},
+										},
+										RequiredWorkflows: []jsonRequiredWorkflow{
+											{Path: ".github/workflows/ci.yml", RepositoryID: 42},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
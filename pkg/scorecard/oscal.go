@@ -0,0 +1,310 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ossf/scorecard/v5/checker"
+	sce "github.com/ossf/scorecard/v5/errors"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+const (
+	// OSCALVersion is the version of the OSCAL Assessment Results model emitted.
+	OSCALVersion = "1.1.2"
+	// OSCALNamespace is the namespace of the Scorecard specific OSCAL properties.
+	OSCALNamespace = "https://scorecard.dev/ns/oscal"
+
+	oscalAssessmentPlan = "https://github.com/ossf/scorecard/blob/main/docs/checks.md"
+)
+
+// oscalNamespaceUUID seeds the deterministic UUIDs of the OSCAL output, so
+// that the same result always yields the same document.
+var oscalNamespaceUUID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(OSCALNamespace))
+
+type oscalProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	NS    string `json:"ns"`
+}
+
+type oscalMetadata struct {
+	Title        string          `json:"title"`
+	LastModified string          `json:"last-modified"`
+	Version      string          `json:"version"`
+	OSCALVersion string          `json:"oscal-version"`
+	Props        []oscalProperty `json:"props,omitempty"`
+}
+
+type oscalImportAP struct {
+	Href string `json:"href"`
+}
+
+type oscalIncludeAll struct{}
+
+type oscalControlSelection struct {
+	IncludeAll oscalIncludeAll `json:"include-all"`
+}
+
+type oscalReviewedControls struct {
+	ControlSelections []oscalControlSelection `json:"control-selections"`
+}
+
+type oscalEvidence struct {
+	Description string          `json:"description"`
+	Remarks     string          `json:"remarks,omitempty"`
+	Props       []oscalProperty `json:"props,omitempty"`
+}
+
+type oscalObservation struct {
+	UUID             string          `json:"uuid"`
+	Title            string          `json:"title"`
+	Description      string          `json:"description"`
+	Collected        string          `json:"collected"`
+	Remarks          string          `json:"remarks,omitempty"`
+	Props            []oscalProperty `json:"props,omitempty"`
+	Methods          []string        `json:"methods"`
+	Types            []string        `json:"types"`
+	RelevantEvidence []oscalEvidence `json:"relevant-evidence,omitempty"`
+}
+
+type oscalStatus struct {
+	State  string `json:"state"`
+	Reason string `json:"reason"`
+}
+
+type oscalTarget struct {
+	Type     string      `json:"type"`
+	TargetID string      `json:"target-id"`
+	Status   oscalStatus `json:"status"`
+}
+
+type oscalRelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+type oscalFinding struct {
+	UUID                string                    `json:"uuid"`
+	Title               string                    `json:"title"`
+	Description         string                    `json:"description"`
+	Target              oscalTarget               `json:"target"`
+	Props               []oscalProperty           `json:"props,omitempty"`
+	RelatedObservations []oscalRelatedObservation `json:"related-observations,omitempty"`
+}
+
+type oscalResult struct {
+	UUID             string                `json:"uuid"`
+	Title            string                `json:"title"`
+	Description      string                `json:"description"`
+	Start            string                `json:"start"`
+	Props            []oscalProperty       `json:"props,omitempty"`
+	ReviewedControls oscalReviewedControls `json:"reviewed-controls"`
+	Observations     []oscalObservation    `json:"observations,omitempty"`
+	Findings         []oscalFinding        `json:"findings,omitempty"`
+}
+
+type oscalAssessmentResults struct {
+	UUID     string        `json:"uuid"`
+	Metadata oscalMetadata `json:"metadata"`
+	ImportAP oscalImportAP `json:"import-ap"`
+	Results  []oscalResult `json:"results"`
+}
+
+// OSCALAssessmentResults is the root of an OSCAL Assessment Results document.
+type OSCALAssessmentResults struct {
+	AssessmentResults oscalAssessmentResults `json:"assessment-results"`
+}
+
+// OSCALResultOption provides configuration options for the OSCAL output format.
+type OSCALResultOption struct {
+	// AssessmentPlan is the href of the assessment plan the results refer to.
+	// Defaults to the documentation of the Scorecard checks.
+	AssessmentPlan string
+	// Indent is used to control the JSON indentation. For example, if you want to pretty print.
+	Indent string
+}
+
+// AsOSCAL exports results as an OSCAL Assessment Results document.
+// Every finding becomes an observation, and every check becomes a finding
+// targeting the check, related to the observations of its probes.
+// It accepts an optional argument to configure the output.
+func (r *Result) AsOSCAL(writer io.Writer, o *OSCALResultOption) error {
+	if o == nil {
+		o = &OSCALResultOption{}
+	}
+	ap := o.AssessmentPlan
+	if ap == "" {
+		ap = oscalAssessmentPlan
+	}
+
+	date := r.Date.UTC().Format(time.RFC3339)
+	result := oscalResult{
+		UUID:        r.oscalUUID("result", 0),
+		Title:       fmt.Sprintf("Scorecard results for %s", r.Repo.Name),
+		Description: fmt.Sprintf("OpenSSF Scorecard assessment of %s at commit %s.", r.Repo.Name, r.Repo.CommitSHA),
+		Start:       date,
+		Props: appendOSCALProps(nil,
+			"repository", r.Repo.Name,
+			"commit", r.Repo.CommitSHA,
+		),
+		ReviewedControls: oscalReviewedControls{
+			ControlSelections: []oscalControlSelection{{}},
+		},
+	}
+
+	for i := range r.Checks {
+		check := &r.Checks[i]
+		f := oscalFinding{
+			UUID:        r.oscalUUID("finding", i),
+			Title:       check.Name,
+			Description: check.Reason,
+			Props:       appendOSCALProps(nil, "score", strconv.Itoa(check.Score)),
+			Target: oscalTarget{
+				Type:     "objective-id",
+				TargetID: check.Name,
+				Status:   oscalCheckStatus(check),
+			},
+		}
+		for j := range check.Findings {
+			obs := r.oscalObservation(&check.Findings[j], len(result.Observations))
+			result.Observations = append(result.Observations, obs)
+			f.RelatedObservations = append(f.RelatedObservations, oscalRelatedObservation{
+				ObservationUUID: obs.UUID,
+			})
+		}
+		result.Findings = append(result.Findings, f)
+	}
+
+	// In probe mode, findings are not attached to any check.
+	for i := range r.Findings {
+		obs := r.oscalObservation(&r.Findings[i], len(result.Observations))
+		result.Observations = append(result.Observations, obs)
+	}
+
+	version := r.Scorecard.Version
+	if version == "" {
+		version = "unknown"
+	}
+	out := OSCALAssessmentResults{
+		AssessmentResults: oscalAssessmentResults{
+			UUID: r.oscalUUID("assessment-results", 0),
+			Metadata: oscalMetadata{
+				Title:        fmt.Sprintf("OpenSSF Scorecard assessment of %s", r.Repo.Name),
+				LastModified: date,
+				Version:      version,
+				OSCALVersion: OSCALVersion,
+			},
+			ImportAP: oscalImportAP{Href: ap},
+			Results:  []oscalResult{result},
+		},
+	}
+
+	out.AssessmentResults.Metadata.Props = appendOSCALProps(nil, "scorecard-commit", r.Scorecard.CommitSHA)
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", o.Indent)
+	if err := encoder.Encode(out); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("encoder.Encode: %v", err))
+	}
+	return nil
+}
+
+func (r *Result) oscalObservation(f *finding.Finding, i int) oscalObservation {
+	obs := oscalObservation{
+		UUID:        r.oscalUUID("observation", i),
+		Title:       f.Probe,
+		Description: f.Message,
+		Collected:   r.Date.UTC().Format(time.RFC3339),
+		Methods:     []string{"TEST"},
+		Types:       []string{"finding"},
+		Props: appendOSCALProps(nil,
+			"probe", f.Probe,
+			"outcome", string(f.Outcome),
+		),
+	}
+	if obs.Description == "" {
+		obs.Description = f.Probe
+	}
+	if f.Remediation != nil {
+		obs.Remarks = f.Remediation.Text
+		obs.Props = appendOSCALProps(obs.Props, "remediation-effort", f.Remediation.Effort.String())
+	}
+	if f.Location != nil {
+		obs.RelevantEvidence = []oscalEvidence{oscalLocationEvidence(f.Location)}
+	}
+	return obs
+}
+
+func oscalLocationEvidence(loc *finding.Location) oscalEvidence {
+	desc := loc.Path
+	if loc.LineStart != nil {
+		desc = fmt.Sprintf("%s:%d", desc, *loc.LineStart)
+		if loc.LineEnd != nil && *loc.LineEnd != *loc.LineStart {
+			desc = fmt.Sprintf("%s-%d", desc, *loc.LineEnd)
+		}
+	}
+	e := oscalEvidence{Description: desc}
+	if loc.Snippet != nil {
+		// property values are single-line strings, so multiline snippets are remarks.
+		if strings.ContainsAny(*loc.Snippet, "\r\n") {
+			e.Remarks = *loc.Snippet
+		} else {
+			e.Props = appendOSCALProps(e.Props, "snippet", *loc.Snippet)
+		}
+	}
+	return e
+}
+
+// oscalCheckStatus only considers a check satisfied with a perfect score.
+// OSCAL has no inconclusive state, so inconclusive checks are not satisfied
+// for an "other" reason, which tells them apart from failing checks.
+func oscalCheckStatus(check *checker.CheckResult) oscalStatus {
+	switch {
+	case check.Error != nil || check.Score < 0:
+		return oscalStatus{State: "not-satisfied", Reason: "other"}
+	case check.Score == checker.MaxResultScore:
+		return oscalStatus{State: "satisfied", Reason: "pass"}
+	default:
+		return oscalStatus{State: "not-satisfied", Reason: "fail"}
+	}
+}
+
+func (r *Result) oscalUUID(kind string, i int) string {
+	seed := fmt.Sprintf("%s@%s/%s/%s/%d",
+		r.Repo.Name, r.Repo.CommitSHA, r.Date.UTC().Format(time.RFC3339), kind, i)
+	return uuid.NewSHA1(oscalNamespaceUUID, []byte(seed)).String()
+}
+
+// appendOSCALProps appends the properties given as name and value pairs to
+// props. Values are trimmed, and empty values are omitted as OSCAL does not
+// allow them.
+func appendOSCALProps(props []oscalProperty, nameValues ...string) []oscalProperty {
+	for i := 0; i+1 < len(nameValues); i += 2 {
+		value := strings.TrimSpace(nameValues[i+1])
+		if value == "" {
+			continue
+		}
+		props = append(props, oscalProperty{Name: nameValues[i], Value: value, NS: OSCALNamespace})
+	}
+	return props
+}
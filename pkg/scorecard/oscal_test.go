@@ -0,0 +1,187 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+)
+
+func TestAsOSCAL(t *testing.T) {
+	t.Parallel()
+	lineStart, lineEnd := uint(3), uint(5)
+	snippet := "uses: actions/checkout@v4"
+	result := Result{
+		Repo: RepoInfo{
+			Name:      "foo",
+			CommitSHA: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		},
+		Scorecard: ScorecardInfo{
+			Version:   "1.2.3",
+			CommitSHA: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		},
+		Date: time.Date(2024, time.February, 1, 13, 48, 0, 0, time.UTC),
+		Checks: []checker.CheckResult{
+			{
+				Name:   "Pinned-Dependencies",
+				Score:  5,
+				Reason: "dependency not pinned by hash detected",
+				Findings: []finding.Finding{
+					{
+						Probe:   "pinsDependencies",
+						Outcome: finding.OutcomeFalse,
+						Message: "GitHub-owned GitHubAction not pinned by hash",
+						Location: &finding.Location{
+							Path:      ".github/workflows/ci.yml",
+							Type:      finding.FileTypeSource,
+							LineStart: &lineStart,
+							LineEnd:   &lineEnd,
+							Snippet:   &snippet,
+						},
+						Remediation: &finding.Remediation{
+							Text:   "update your workflow using https://app.stepsecurity.io",
+							Effort: finding.RemediationEffortMedium,
+						},
+					},
+				},
+			},
+			{
+				Name:   "License",
+				Score:  10,
+				Reason: "license file detected",
+				Findings: []finding.Finding{
+					{
+						Probe:   "hasLicenseFile",
+						Outcome: finding.OutcomeTrue,
+						Message: "project has a license file",
+					},
+				},
+			},
+			{
+				Name:   "Fuzzing",
+				Score:  checker.InconclusiveResultScore,
+				Reason: "internal error",
+			},
+		},
+	}
+
+	expected, err := os.ReadFile("./testdata/oscal1.json")
+	if err != nil {
+		t.Fatalf("cannot read expected results file: %v", err)
+	}
+
+	var got bytes.Buffer
+	// pretty print results so the test files are easier to read
+	if err := result.AsOSCAL(&got, &OSCALResultOption{Indent: "    "}); err != nil {
+		t.Fatalf("AsOSCAL: %v", err)
+	}
+	if diff := cmp.Diff(expected, got.Bytes()); diff != "" {
+		t.Errorf("results differ: %s", diff)
+	}
+}
+
+func TestAsOSCAL_probes(t *testing.T) {
+	t.Parallel()
+	result := Result{
+		Repo: RepoInfo{Name: "foo"},
+		Findings: []finding.Finding{
+			{Probe: "hasX", Outcome: finding.OutcomeTrue},
+			{Probe: "hasY", Outcome: finding.OutcomeFalse},
+		},
+	}
+	var first, second bytes.Buffer
+	if err := result.AsOSCAL(&first, nil); err != nil {
+		t.Fatalf("AsOSCAL: %v", err)
+	}
+	if err := result.AsOSCAL(&second, nil); err != nil {
+		t.Fatalf("AsOSCAL: %v", err)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("AsOSCAL() is not deterministic")
+	}
+	if n := bytes.Count(first.Bytes(), []byte(`"observation-uuid"`)); n != 0 {
+		t.Errorf("probe findings related to %d check findings, want 0", n)
+	}
+	if n := bytes.Count(first.Bytes(), []byte(`"methods"`)); n != 2 {
+		t.Errorf("got %d observations, want 2", n)
+	}
+}
+
+func TestAsOSCAL_stringValues(t *testing.T) {
+	t.Parallel()
+	snippet := "steps:\n  - uses: actions/checkout@v4"
+	result := Result{
+		Repo: RepoInfo{Name: "foo"},
+		Checks: []checker.CheckResult{
+			{
+				Name:  "Pinned-Dependencies",
+				Score: checker.InconclusiveResultScore,
+				Findings: []finding.Finding{
+					{
+						Probe:   "pinsDependencies",
+						Outcome: finding.OutcomeFalse,
+						Location: &finding.Location{
+							Path:    ".github/workflows/ci.yml",
+							Snippet: &snippet,
+						},
+						Remediation: &finding.Remediation{},
+					},
+				},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	if err := result.AsOSCAL(&buf, nil); err != nil {
+		t.Fatalf("AsOSCAL: %v", err)
+	}
+	var got OSCALAssessmentResults
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	ar := got.AssessmentResults
+	if ar.Metadata.Version == "" {
+		t.Error("metadata version is empty")
+	}
+	props := slices.Concat(ar.Metadata.Props, ar.Results[0].Props, ar.Results[0].Findings[0].Props)
+	for i := range ar.Results[0].Observations {
+		obs := &ar.Results[0].Observations[i]
+		props = slices.Concat(props, obs.Props)
+		for j := range obs.RelevantEvidence {
+			props = slices.Concat(props, obs.RelevantEvidence[j].Props)
+		}
+	}
+	for _, p := range props {
+		if p.Value == "" || strings.ContainsAny(p.Value, "\r\n") {
+			t.Errorf("property %q has invalid value %q", p.Name, p.Value)
+		}
+	}
+	if diff := cmp.Diff(snippet, ar.Results[0].Observations[0].RelevantEvidence[0].Remarks); diff != "" {
+		t.Errorf("snippet remarks mismatch (-want +got):\n%s", diff)
+	}
+	wantStatus := oscalStatus{State: "not-satisfied", Reason: "other"}
+	if diff := cmp.Diff(wantStatus, ar.Results[0].Findings[0].Target.Status); diff != "" {
+		t.Errorf("inconclusive status mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -29,6 +29,9 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/clients/azuredevopsrepo"
+	"github.com/ossf/scorecard/v5/clients/bitbucketrepo"
+	gitclient "github.com/ossf/scorecard/v5/clients/git"
+	"github.com/ossf/scorecard/v5/clients/gitearepo"
 	"github.com/ossf/scorecard/v5/clients/githubrepo"
 	"github.com/ossf/scorecard/v5/clients/gitlabrepo"
 	"github.com/ossf/scorecard/v5/clients/localdir"
@@ -39,7 +42,9 @@ import (
 	"github.com/ossf/scorecard/v5/internal/packageclient"
 	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
 	sclog "github.com/ossf/scorecard/v5/log"
+	"github.com/ossf/scorecard/v5/pkg/conformance"
 	"github.com/ossf/scorecard/v5/policy"
+	"github.com/ossf/scorecard/v5/probes/zrunner"
 )
 
 // errEmptyRepository indicates the repository is empty.
@@ -96,6 +101,7 @@ func runScorecard(ctx context.Context,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
 	projectClient packageclient.ProjectPackageClient,
+	framework *conformance.Framework,
 ) (Result, error) {
 	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
@@ -153,6 +159,7 @@ func runScorecard(ctx context.Context,
 		"localPath":                localPath,
 	}
 
+	logger := sclog.NewLogger(sclog.DefaultLevel)
 	request := &checker.CheckRequest{
 		Ctx:                   ctx,
 		RepoClient:            repoClient,
@@ -170,6 +177,19 @@ func runScorecard(ctx context.Context,
 		if err != nil {
 			return Result{}, err
 		}
+		if framework != nil {
+			// Raw data of the requested probes was populated without errors.
+			rawDataReady := map[string]bool{}
+			for _, probeName := range probesToRun {
+				if p, err := proberegistration.Get(probeName); err == nil {
+					for _, checkName := range p.RequiredRawData {
+						rawDataReady[checkName] = true
+					}
+				}
+			}
+			runMissingProbes(request, framework.Probes(), rawDataReady, &ret, logger)
+			ret.Conformance = conformance.Evaluate(framework, ret.Findings)
+		}
 		return ret, nil
 	}
 
@@ -178,7 +198,6 @@ func runScorecard(ctx context.Context,
 
 	// get the repository's config file to read annotations
 	r, path := findConfigFile(repoClient)
-	logger := sclog.NewLogger(sclog.DefaultLevel)
 
 	if r != nil {
 		defer r.Close()
@@ -194,6 +213,17 @@ func runScorecard(ctx context.Context,
 		ret.Checks = append(ret.Checks, result)
 		ret.Findings = append(ret.Findings, result.Findings...)
 	}
+
+	if framework != nil {
+		rawDataReady := map[string]bool{}
+		for i := range ret.Checks {
+			if ret.Checks[i].Error == nil {
+				rawDataReady[ret.Checks[i].Name] = true
+			}
+		}
+		runMissingProbes(request, framework.Probes(), rawDataReady, &ret, logger)
+		ret.Conformance = conformance.Evaluate(framework, ret.Findings)
+	}
 	return ret, nil
 }
 
@@ -245,19 +275,86 @@ func runEnabledProbes(request *checker.CheckRequest,
 		if err != nil {
 			return sce.WithMessage(sce.ErrScorecardInternal, "ending run")
 		}
+		findings, err = zrunner.ApplyPreconditions(&ret.RawResults, probeName, findings)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
 		probeFindings = append(probeFindings, findings...)
 	}
 	ret.Findings = probeFindings
 	return nil
 }
 
+// runMissingProbes runs the probes which have not produced findings yet,
+// appending their findings to ret. Raw data of the checks in rawDataReady is
+// reused, other raw data is collected as needed. Probes which cannot be run
+// are skipped so that conformance evaluation reports them as inconclusive.
+func runMissingProbes(request *checker.CheckRequest, probesToRun []string, rawDataReady map[string]bool,
+	ret *Result, logger *sclog.Logger,
+) {
+	haveFindings := map[string]bool{}
+	for i := range ret.Findings {
+		haveFindings[ret.Findings[i].Probe] = true
+	}
+	rawDataFailed := map[string]bool{}
+
+	for _, probeName := range probesToRun {
+		if haveFindings[probeName] {
+			continue
+		}
+		probe, err := proberegistration.Get(probeName)
+		if err != nil {
+			logger.Info(fmt.Sprintf("skipping probe %q: %v", probeName, err))
+			continue
+		}
+
+		ready := true
+		for _, checkName := range probe.RequiredRawData {
+			if rawDataReady[checkName] {
+				continue
+			}
+			if rawDataFailed[checkName] {
+				ready = false
+				break
+			}
+			if err := assignRawData(checkName, request, ret); err != nil {
+				logger.Info(fmt.Sprintf("skipping probe %q: %v", probeName, err))
+				rawDataFailed[checkName] = true
+				ready = false
+				break
+			}
+			rawDataReady[checkName] = true
+		}
+		if !ready {
+			continue
+		}
+
+		var findings []finding.Finding
+		if probe.IndependentImplementation != nil {
+			findings, _, err = probe.IndependentImplementation(request)
+		} else {
+			findings, _, err = probe.Implementation(&ret.RawResults)
+		}
+		if err == nil {
+			findings, err = zrunner.ApplyPreconditions(&ret.RawResults, probeName, findings)
+		}
+		if err != nil {
+			logger.Info(fmt.Sprintf("skipping probe %q: %v", probeName, err))
+			continue
+		}
+		ret.Findings = append(ret.Findings, findings...)
+	}
+}
+
 type runConfig struct {
 	client        clients.RepoClient
 	vulnClient    clients.VulnerabilitiesClient
 	ciiClient     clients.CIIBestPracticesClient
 	projectClient packageclient.ProjectPackageClient
 	ossfuzzClient clients.RepoClient
+	framework     *conformance.Framework
 	commit        string
+	cacheDir      string
 	logLevel      sclog.Level
 	checks        []string
 	probes        []string
@@ -358,6 +455,30 @@ func WithFileModeGit() Option {
 	}
 }
 
+// WithCacheDir configures supporting repository clients to cache HTTP
+// responses on disk in dir. Cached responses are revalidated with conditional
+// requests, which don't count against the GitHub rate limits.
+//
+// The cache is shared across tokens, so dir should not be readable by users
+// who must not see the analyzed repositories.
+func WithCacheDir(dir string) Option {
+	return func(c *runConfig) error {
+		c.cacheDir = dir
+		return nil
+	}
+}
+
+// WithConformanceFramework evaluates the probe findings of the analysis
+// against the controls of the given framework. The probes referenced by the
+// framework are run in addition to any requested checks or probes, and the
+// verdicts are available in [Result.Conformance].
+func WithConformanceFramework(framework *conformance.Framework) Option {
+	return func(c *runConfig) error {
+		c.framework = framework
+		return nil
+	}
+}
+
 // Run analyzes a given repository and returns the result. You can modify the
 // run behavior by passing in [Option] arguments. In the absence of a particular
 // option a default is used. Refer to the various Options for details.
@@ -387,9 +508,11 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 
 	var requiredRequestTypes []checker.RequestType
 	var err error
-	switch repo.(type) {
+	switch r := repo.(type) {
 	case *localdir.Repo:
-		requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
+		if !localdir.HasGitHistory(r.Path()) {
+			requiredRequestTypes = append(requiredRequestTypes, checker.FileBased)
+		}
 		if c.client == nil {
 			c.client = localdir.CreateLocalDirClient(ctx, logger)
 		}
@@ -399,6 +522,9 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 			if c.gitMode {
 				opts = append(opts, githubrepo.WithFileModeGit())
 			}
+			if c.cacheDir != "" {
+				opts = append(opts, githubrepo.WithCacheDir(c.cacheDir))
+			}
 			client, err := githubrepo.NewRepoClient(ctx, opts...)
 			if err != nil {
 				return Result{}, fmt.Errorf("creating github client: %w", err)
@@ -407,11 +533,41 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 		}
 	case *gitlabrepo.Repo:
 		if c.client == nil {
-			c.client, err = gitlabrepo.CreateGitlabClient(ctx, repo.Host())
+			if c.cacheDir != "" {
+				c.client, err = gitlabrepo.CreateGitlabClientWithCache(ctx, repo.Host(), c.cacheDir)
+			} else {
+				c.client, err = gitlabrepo.CreateGitlabClient(ctx, repo.Host())
+			}
 			if err != nil {
 				return Result{}, fmt.Errorf("creating gitlab client: %w", err)
 			}
 		}
+	case *bitbucketrepo.Repo:
+		if c.client == nil {
+			if c.cacheDir != "" {
+				c.client, err = bitbucketrepo.CreateBitbucketClientWithCache(ctx, c.cacheDir)
+			} else {
+				c.client, err = bitbucketrepo.CreateBitbucketClient(ctx)
+			}
+			if err != nil {
+				return Result{}, fmt.Errorf("creating bitbucket client: %w", err)
+			}
+		}
+	case *gitearepo.Repo:
+		if c.client == nil {
+			if c.cacheDir != "" {
+				c.client, err = gitearepo.CreateGiteaClientWithCache(ctx, repo.Host(), c.cacheDir)
+			} else {
+				c.client, err = gitearepo.CreateGiteaClient(ctx, repo.Host())
+			}
+			if err != nil {
+				return Result{}, fmt.Errorf("creating gitea client: %w", err)
+			}
+		}
+	case *gitclient.Repo:
+		if c.client == nil {
+			c.client = &gitclient.Client{}
+		}
 	case *azuredevopsrepo.Repo:
 		if c.client == nil {
 			c.client, err = azuredevopsrepo.CreateAzureDevOpsClient(ctx, repo)
@@ -431,5 +587,5 @@ func Run(ctx context.Context, repo clients.Repo, opts ...Option) (Result, error)
 	}
 
 	return runScorecard(ctx, repo, c.commit, c.commitDepth, checksToRun, c.probes,
-		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient)
+		c.client, c.ossfuzzClient, c.ciiClient, c.vulnClient, c.projectClient, c.framework)
 }
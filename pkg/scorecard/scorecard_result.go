@@ -38,6 +38,7 @@ import (
 	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/log"
 	"github.com/ossf/scorecard/v5/options"
+	"github.com/ossf/scorecard/v5/pkg/conformance"
 	spol "github.com/ossf/scorecard/v5/policy"
 )
 
@@ -63,6 +64,9 @@ type Result struct {
 	Findings   []finding.Finding
 	Metadata   []string
 	Config     config.Config
+	// Conformance is only set when a framework was configured with
+	// [WithConformanceFramework].
+	Conformance *conformance.Report
 }
 
 // AsStringResultOption provides configuration options for string Scorecard results.
@@ -150,7 +154,7 @@ func FormatResults(
 	case options.FormatSarif:
 		// TODO: support config files and update checker.MaxResultScore.
 		err = results.AsSARIF(opts.ShowDetails, log.ParseLevel(opts.LogLevel), output, doc, policy, opts)
-	case options.FormatJSON:
+	case options.FormatJSON, options.FormatNDJSON:
 		o := &AsJSON2ResultOption{
 			Details:     opts.ShowDetails,
 			Annotations: opts.ShowAnnotations,
@@ -171,6 +175,17 @@ func FormatResults(
 		err = results.AsProbe(output, opts)
 	case options.FormatRaw:
 		err = results.AsRawJSON(output)
+	case options.FormatConformance:
+		err = results.AsConformance(output, nil)
+	case options.FormatOSCAL:
+		err = results.AsOSCAL(output, nil)
+	case options.FormatAggregate:
+		o := &AsJSON2ResultOption{
+			Details:     opts.ShowDetails,
+			Annotations: opts.ShowAnnotations,
+			LogLevel:    log.ParseLevel(opts.LogLevel),
+		}
+		err = AsAggregateJSON(output, []AggregateEntry{{Repo: results.Repo.Name, Result: results}}, doc, o)
 	default:
 		err = sce.WithMessage(
 			sce.ErrScorecardInternal,
@@ -231,6 +231,14 @@ func TestRun_WithProbes(t *testing.T) {
 					CommitSHA: "1a17bb812fb2ac23e9d09e86e122f8b67563aed7",
 				},
 				RawResults: checker.RawResults{
+					FuzzingResults: checker.FuzzingData{
+						Languages: []clients.Language{
+							{Name: clients.Go, NumLines: 100},
+							{Name: clients.Java, NumLines: 70},
+							{Name: clients.Cpp, NumLines: 100},
+							{Name: clients.Ruby, NumLines: 70},
+						},
+					},
 					Metadata: checker.MetadataData{
 						Metadata: map[string]string{
 							"repository.defaultBranch": "main",
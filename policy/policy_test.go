@@ -371,7 +371,7 @@ func TestGetEnabled(t *testing.T) {
 			argsChecks:            []string{},
 			requiredRequestTypes:  []checker.RequestType{},
 			repoType:              clients.RepoTypeAzureDevOps,
-			expectedEnabledChecks: 13, // Only checks with Azure DevOps in repos field
+			expectedEnabledChecks: 14, // Only checks with Azure DevOps in repos field
 			expectedError:         false,
 		},
 	}
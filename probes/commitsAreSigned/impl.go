@@ -0,0 +1,78 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitsAreSigned
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.Maintained})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe            = "commitsAreSigned"
+	SignedCommitsKey = "signedCommits"
+	TotalCommitsKey  = "totalCommits"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	commits := raw.MaintainedResults.DefaultBranchCommits
+	if len(commits) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no commits found on the default branch", nil, finding.OutcomeNotAvailable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	signed := 0
+	for i := range commits {
+		if commits[i].Signature != nil {
+			signed++
+		}
+	}
+
+	text := fmt.Sprintf("%d out of %d commits are signed", signed, len(commits))
+	outcome := finding.OutcomeFalse
+	if signed == len(commits) {
+		outcome = finding.OutcomeTrue
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValues(map[string]string{
+		SignedCommitsKey: strconv.Itoa(signed),
+		TotalCommitsKey:  strconv.Itoa(len(commits)),
+	})
+	return []finding.Finding{*f}, Probe, nil
+}
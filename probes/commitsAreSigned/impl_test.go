@@ -0,0 +1,108 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commitsAreSigned
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	verified := &clients.Signature{Type: clients.SignatureTypeGPG, State: "VALID", Verified: true}
+	unverified := &clients.Signature{Type: clients.SignatureTypeSSH, State: "UNKNOWN_KEY"}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   map[string]string
+		err      error
+	}{
+		{
+			name:     "nil raw results",
+			err:      uerror.ErrNil,
+			outcomes: nil,
+		},
+		{
+			name:     "no commits",
+			raw:      &checker.RawResults{},
+			outcomes: []finding.Outcome{finding.OutcomeNotAvailable},
+		},
+		{
+			name: "all commits verified",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					DefaultBranchCommits: []clients.Commit{{Signature: verified}, {Signature: verified}},
+				},
+			},
+			values: map[string]string{
+				SignedCommitsKey: "2",
+				TotalCommitsKey:  "2",
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue},
+		},
+		{
+			name: "verified, unverified and unsigned commits",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					DefaultBranchCommits: []clients.Commit{{Signature: verified}, {Signature: unverified}, {}},
+				},
+			},
+			values: map[string]string{
+				SignedCommitsKey: "2",
+				TotalCommitsKey:  "3",
+			},
+			outcomes: []finding.Outcome{finding.OutcomeFalse},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(len(tt.outcomes), len(findings)); diff != "" {
+				t.Fatalf("mismatch (-want +got):\n%s", diff)
+			}
+			for i := range findings {
+				f := &findings[i]
+				if tt.values != nil {
+					if diff := cmp.Diff(tt.values, f.Values); diff != "" {
+						t.Errorf("mismatch (-want +got):\n%s", diff)
+					}
+				}
+				if diff := cmp.Diff(tt.outcomes[i], f.Outcome); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probes
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/ossf/scorecard/v5/internal/applicability"
+	pyaml "github.com/ossf/scorecard/v5/internal/probes/yaml"
+)
+
+//go:embed */def.yml
+var definitions embed.FS
+
+var errUnknownProbe = errors.New("unknown probe")
+
+// ProbeDefinition is the parsed def.yml file of a probe.
+type ProbeDefinition struct {
+	// Content is the raw content of the def.yml file.
+	Content []byte
+	pyaml.Probe
+}
+
+var loadDefinitions = sync.OnceValues(func() (map[string]*ProbeDefinition, error) {
+	entries, err := definitions.ReadDir(".")
+	if err != nil {
+		return nil, fmt.Errorf("reading probe definitions: %w", err)
+	}
+	defs := make(map[string]*ProbeDefinition, len(entries))
+	for _, e := range entries {
+		content, err := definitions.ReadFile(path.Join(e.Name(), "def.yml"))
+		if err != nil {
+			return nil, fmt.Errorf("reading definition of %q: %w", e.Name(), err)
+		}
+		def := ProbeDefinition{Content: content}
+		if err := yaml.Unmarshal(content, &def.Probe); err != nil {
+			return nil, fmt.Errorf("parsing definition of %q: %w", e.Name(), err)
+		}
+		if err := applicability.Validate(def.Preconditions); err != nil {
+			return nil, fmt.Errorf("definition of %q: %w", e.Name(), err)
+		}
+		defs[e.Name()] = &def
+	}
+	return defs, nil
+})
+
+// Definition returns the definition of the given probe.
+// Definitions are parsed and validated once, on first use.
+func Definition(probeID string) (*ProbeDefinition, error) {
+	defs, err := loadDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	def, ok := defs[probeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnknownProbe, probeID)
+	}
+	return def, nil
+}
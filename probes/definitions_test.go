@@ -0,0 +1,48 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDefinition(t *testing.T) {
+	t.Parallel()
+	entries, err := definitions.ReadDir(".")
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("no probe definitions embedded")
+	}
+	// loading validates every definition, including preconditions.
+	for _, e := range entries {
+		def, err := Definition(e.Name())
+		if err != nil {
+			t.Fatalf("Definition(%q): %v", e.Name(), err)
+		}
+		if def.ID != e.Name() {
+			t.Errorf("Definition(%q) has ID %q", e.Name(), def.ID)
+		}
+	}
+}
+
+func TestDefinition_unknown(t *testing.T) {
+	t.Parallel()
+	if _, err := Definition("notAProbe"); !errors.Is(err, errUnknownProbe) {
+		t.Errorf("Definition() error = %v, want %v", err, errUnknownProbe)
+	}
+}
@@ -24,12 +24,17 @@ import (
 	"github.com/ossf/scorecard/v5/probes/branchesAreProtected"
 	"github.com/ossf/scorecard/v5/probes/codeApproved"
 	"github.com/ossf/scorecard/v5/probes/codeReviewOneReviewers"
+	"github.com/ossf/scorecard/v5/probes/commitsAreSigned"
+	"github.com/ossf/scorecard/v5/probes/commitsAreVerifiedSigned"
 	"github.com/ossf/scorecard/v5/probes/contributorsFromOrgOrCompany"
 	"github.com/ossf/scorecard/v5/probes/createdRecently"
 	"github.com/ossf/scorecard/v5/probes/dependencyUpdateToolConfigured"
 	"github.com/ossf/scorecard/v5/probes/dismissesStaleReviews"
 	"github.com/ossf/scorecard/v5/probes/fuzzed"
 	"github.com/ossf/scorecard/v5/probes/hasBinaryArtifacts"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowArtifactPoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowCachePoisoning"
+	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowEnvironmentInjection"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowScriptInjection"
 	"github.com/ossf/scorecard/v5/probes/hasDangerousWorkflowUntrustedCheckout"
 	"github.com/ossf/scorecard/v5/probes/hasFSFOrOSIApprovedLicense"
@@ -41,11 +46,15 @@ import (
 	"github.com/ossf/scorecard/v5/probes/hasRecentCommits"
 	"github.com/ossf/scorecard/v5/probes/hasReleaseSBOM"
 	"github.com/ossf/scorecard/v5/probes/hasSBOM"
+	"github.com/ossf/scorecard/v5/probes/hasSelfHostedRunnersOnPullRequests"
+	"github.com/ossf/scorecard/v5/probes/hasSelfHostedRunnersWithSecretsFromForks"
 	"github.com/ossf/scorecard/v5/probes/hasUnverifiedBinaryArtifacts"
 	"github.com/ossf/scorecard/v5/probes/issueActivityByProjectMember"
 	"github.com/ossf/scorecard/v5/probes/jobLevelPermissions"
 	"github.com/ossf/scorecard/v5/probes/packagedWithAutomatedWorkflow"
 	"github.com/ossf/scorecard/v5/probes/pinsDependencies"
+	"github.com/ossf/scorecard/v5/probes/releaseTagsAreProtected"
+	"github.com/ossf/scorecard/v5/probes/releaseTagsAreSigned"
 	"github.com/ossf/scorecard/v5/probes/releasesAreSigned"
 	"github.com/ossf/scorecard/v5/probes/releasesHaveProvenance"
 	"github.com/ossf/scorecard/v5/probes/releasesHaveVerifiedProvenance"
@@ -54,6 +63,9 @@ import (
 	"github.com/ossf/scorecard/v5/probes/requiresLastPushApproval"
 	"github.com/ossf/scorecard/v5/probes/requiresPRsToChangeCode"
 	"github.com/ossf/scorecard/v5/probes/requiresUpToDateBranches"
+	"github.com/ossf/scorecard/v5/probes/requiresWorkflowsToPass"
+	"github.com/ossf/scorecard/v5/probes/rulesetBypassIsAdminOnly"
+	"github.com/ossf/scorecard/v5/probes/rulesetsAreEnforced"
 	"github.com/ossf/scorecard/v5/probes/runsStatusChecksBeforeMerging"
 	"github.com/ossf/scorecard/v5/probes/sastToolConfigured"
 	"github.com/ossf/scorecard/v5/probes/sastToolRunsOnAllCommits"
@@ -115,6 +127,9 @@ var (
 	DangerousWorkflows = []ProbeImpl{
 		hasDangerousWorkflowScriptInjection.Run,
 		hasDangerousWorkflowUntrustedCheckout.Run,
+		hasDangerousWorkflowArtifactPoisoning.Run,
+		hasDangerousWorkflowCachePoisoning.Run,
+		hasDangerousWorkflowEnvironmentInjection.Run,
 	}
 	Maintained = []ProbeImpl{
 		archived.Run,
@@ -171,6 +186,15 @@ var (
 		codeReviewOneReviewers.Run,
 		hasBinaryArtifacts.Run,
 		releasesHaveVerifiedProvenance.Run,
+		commitsAreSigned.Run,
+		commitsAreVerifiedSigned.Run,
+		releaseTagsAreSigned.Run,
+		releaseTagsAreProtected.Run,
+		rulesetsAreEnforced.Run,
+		rulesetBypassIsAdminOnly.Run,
+		requiresWorkflowsToPass.Run,
+		hasSelfHostedRunnersOnPullRequests.Run,
+		hasSelfHostedRunnersWithSecretsFromForks.Run,
 	}
 
 	// Probes which don't use pre-computed raw data but rather collect it themselves.
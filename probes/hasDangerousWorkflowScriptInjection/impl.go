@@ -23,6 +23,7 @@ import (
 	"github.com/rhysd/actionlint"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/checks/fileparser"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
@@ -81,9 +82,12 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 			Snippet:   &w.File.Snippet,
 		})
 
-		err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
-		if err == nil {
-			generatePatch(&w, content, workflow, errs, f)
+		// patches are only generated for GitHub workflows.
+		if fileparser.IsWorkflowFile(w.File.Path) {
+			err = parseWorkflow(localPath, &w, &currWorkflow, &content, &workflow, &errs)
+			if err == nil {
+				generatePatch(&w, content, workflow, errs, f)
+			}
 		}
 
 		findings = append(findings, *f)
@@ -206,7 +206,7 @@ func detectDangerousWorkflows(t *testing.T, filePath string) []checker.Dangerous
 		// Pretend the file is in the workflow directory to pass a check deep in
 		// raw.DangerousWorkflow
 		[]string{path.Join(".github/workflows/", filePath)}, nil,
-	)
+	).AnyTimes()
 	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 		return os.Open("./testdata/" + filePath)
 	}).AnyTimes()
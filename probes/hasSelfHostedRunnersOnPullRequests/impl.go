@@ -0,0 +1,104 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasSelfHostedRunnersOnPullRequests
+
+import (
+	"embed"
+	"fmt"
+	"slices"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.DangerousWorkflow})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe      = "hasSelfHostedRunnersOnPullRequests"
+	TriggerKey = "trigger"
+)
+
+// pullRequestTriggers are the events which anyone can cause in a public
+// repository, by opening a pull request or commenting.
+var pullRequestTriggers = []string{"pull_request", "pull_request_target", "issue_comment"}
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.DangerousWorkflowResults
+	if r.NumWorkflows == 0 {
+		return notApplicable("Project does not have any workflows.")
+	}
+	if r.Private != nil && *r.Private {
+		return notApplicable("Project is private.")
+	}
+
+	var findings []finding.Finding
+	for i := range r.SelfHostedRunnerJobs {
+		job := &r.SelfHostedRunnerJobs[i]
+		trigger := ""
+		for _, t := range job.Triggers {
+			if slices.Contains(pullRequestTriggers, t) {
+				trigger = t
+				break
+			}
+		}
+		if trigger == "" {
+			continue
+		}
+		f, err := finding.NewWith(fs, Probe,
+			fmt.Sprintf("self-hosted runner '%v' runs on %s", job.File.Snippet, trigger),
+			nil, finding.OutcomeTrue)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithLocation(&finding.Location{
+			Path:      job.File.Path,
+			Type:      job.File.Type,
+			LineStart: &job.File.Offset,
+			Snippet:   &job.File.Snippet,
+		})
+		f = f.WithValue(TriggerKey, trigger)
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"Project does not run self-hosted runners on pull requests or comments.", nil,
+			finding.OutcomeFalse)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
+
+func notApplicable(text string) ([]finding.Finding, string, error) {
+	f, err := finding.NewWith(fs, Probe, text, nil, finding.OutcomeNotApplicable)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
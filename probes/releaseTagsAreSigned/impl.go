@@ -0,0 +1,96 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package releaseTagsAreSigned
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.SignedReleases})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe           = "releaseTagsAreSigned"
+	ReleaseNameKey  = "releaseName"
+	SignatureKey    = "signatureType"
+	releaseLookBack = 5
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	releases := raw.SignedReleasesResults.Releases
+	if len(releases) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no releases found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range releases {
+		if i >= releaseLookBack {
+			break
+		}
+		release := &releases[i]
+		if release.Tag == nil {
+			continue
+		}
+		var loc *finding.Location
+		if release.URL != "" {
+			loc = &finding.Location{Type: finding.FileTypeURL, Path: release.URL}
+		}
+		text := fmt.Sprintf("release tag %s is not signed", release.TagName)
+		outcome := finding.OutcomeFalse
+		if release.Tag.Signature != nil {
+			text = fmt.Sprintf("release tag %s is signed", release.TagName)
+			outcome = finding.OutcomeTrue
+		}
+		f, err := finding.NewWith(fs, Probe, text, loc, outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(ReleaseNameKey, release.TagName)
+		if release.Tag.Signature != nil {
+			f = f.WithValue(SignatureKey, string(release.Tag.Signature.Type))
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"the tags of releases are unknown", nil, finding.OutcomeNotAvailable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package releaseTagsAreSigned
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	signed := &clients.Tag{
+		Signature: &clients.Signature{Type: clients.SignatureTypeSigstore, Verified: true},
+		Annotated: true,
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		values   []map[string]string
+		err      error
+	}{
+		{
+			name: "nil raw results",
+			err:  uerror.ErrNil,
+		},
+		{
+			name:     "no releases",
+			raw:      &checker.RawResults{},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+		},
+		{
+			name: "unknown tags",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{{TagName: "v1.0.0"}},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotAvailable},
+		},
+		{
+			name: "signed, annotated and lightweight tags",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{
+						{TagName: "v1.2.0", Tag: signed},
+						{TagName: "v1.1.0", Tag: &clients.Tag{Annotated: true}},
+						{TagName: "v1.0.0", Tag: &clients.Tag{}},
+						{TagName: "v0.1.0"},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeTrue, finding.OutcomeFalse, finding.OutcomeFalse},
+			values: []map[string]string{
+				{ReleaseNameKey: "v1.2.0", SignatureKey: "sigstore"},
+				{ReleaseNameKey: "v1.1.0"},
+				{ReleaseNameKey: "v1.0.0"},
+			},
+		},
+		{
+			name: "only the last releases",
+			raw: &checker.RawResults{
+				SignedReleasesResults: checker.SignedReleasesData{
+					Releases: []clients.Release{
+						{TagName: "v6", Tag: signed},
+						{TagName: "v5", Tag: signed},
+						{TagName: "v4", Tag: signed},
+						{TagName: "v3", Tag: signed},
+						{TagName: "v2", Tag: signed},
+						{TagName: "v1", Tag: &clients.Tag{}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeTrue, finding.OutcomeTrue, finding.OutcomeTrue,
+				finding.OutcomeTrue, finding.OutcomeTrue,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(len(tt.outcomes), len(findings)); diff != "" {
+				t.Fatalf("mismatch (-want +got):\n%s", diff)
+			}
+			for i := range findings {
+				f := &findings[i]
+				if tt.values != nil {
+					if diff := cmp.Diff(tt.values[i], f.Values); diff != "" {
+						t.Errorf("mismatch (-want +got):\n%s", diff)
+					}
+				}
+				if diff := cmp.Diff(tt.outcomes[i], f.Outcome); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
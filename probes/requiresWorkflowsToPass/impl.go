@@ -0,0 +1,87 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package requiresWorkflowsToPass
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.BranchProtection})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe         = "requiresWorkflowsToPass"
+	BranchNameKey = "branchName"
+	WorkflowsKey  = "workflows"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.BranchProtectionResults
+	if len(r.Branches) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no branches found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.Branches {
+		branch := &r.Branches[i]
+		var workflows []string
+		for j := range branch.RuleSets {
+			ruleSet := &branch.RuleSets[j]
+			if ruleSet.Enforcement != clients.RuleSetEnforcementActive {
+				continue
+			}
+			for _, w := range ruleSet.RequiredWorkflows {
+				workflows = append(workflows, w.Path)
+			}
+		}
+		text := fmt.Sprintf("no workflows required to merge onto branch '%s'", *branch.Name)
+		outcome := finding.OutcomeFalse
+		if len(workflows) > 0 {
+			text = fmt.Sprintf("workflows required to merge onto branch '%s'", *branch.Name)
+			outcome = finding.OutcomeTrue
+		}
+		f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(BranchNameKey, *branch.Name)
+		if len(workflows) > 0 {
+			f = f.WithValue(WorkflowsKey, strings.Join(workflows, ","))
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulesetBypassIsAdminOnly
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
+	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	"github.com/ossf/scorecard/v5/internal/probes"
+	"github.com/ossf/scorecard/v5/probes/internal/utils/uerror"
+)
+
+func init() {
+	probes.MustRegister(Probe, Run, []checknames.CheckName{checknames.BranchProtection})
+}
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe           = "rulesetBypassIsAdminOnly"
+	BranchNameKey   = "branchName"
+	RuleSetNameKey  = "rulesetName"
+	BypassActorsKey = "bypassActors"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	r := raw.BranchProtectionResults
+	for i := range r.Branches {
+		branch := &r.Branches[i]
+		for j := range branch.RuleSets {
+			ruleSet := &branch.RuleSets[j]
+			var others []string
+			for _, actor := range ruleSet.BypassActors {
+				if !isAdmin(actor) {
+					others = append(others, actorName(actor))
+				}
+			}
+			text := fmt.Sprintf("only admins can bypass ruleset '%s' on branch '%s'", ruleSet.Name, *branch.Name)
+			outcome := finding.OutcomeTrue
+			if len(others) > 0 {
+				text = fmt.Sprintf("non-admins can bypass ruleset '%s' on branch '%s'", ruleSet.Name, *branch.Name)
+				outcome = finding.OutcomeFalse
+			}
+			f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			f = f.WithValues(map[string]string{
+				BranchNameKey:  *branch.Name,
+				RuleSetNameKey: ruleSet.Name,
+			})
+			if len(others) > 0 {
+				f = f.WithValue(BypassActorsKey, strings.Join(others, ","))
+			}
+			findings = append(findings, *f)
+		}
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no rulesets found", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func isAdmin(actor clients.BypassActor) bool {
+	switch actor.Type {
+	case clients.BypassActorOrganizationAdmin, clients.BypassActorEnterpriseOwner:
+		return true
+	case clients.BypassActorRepositoryRole:
+		return strings.EqualFold(actor.Name, "admin")
+	default:
+		return false
+	}
+}
+
+func actorName(actor clients.BypassActor) string {
+	if actor.Name == "" {
+		return string(actor.Type)
+	}
+	return fmt.Sprintf("%s:%s", actor.Type, actor.Name)
+}
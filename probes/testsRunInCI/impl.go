@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/ossf/scorecard/v5/checker"
+	"github.com/ossf/scorecard/v5/clients"
 	"github.com/ossf/scorecard/v5/finding"
 	"github.com/ossf/scorecard/v5/internal/checknames"
 	"github.com/ossf/scorecard/v5/internal/probes"
@@ -71,7 +72,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 		}
 
 		// GitHub Check Runs.
-		prCheckSuccessful, f, err := prHasSuccessfulCheck(r)
+		prCheckSuccessful, f, err := prHasSuccessfulCheck(r, c.TestJobs)
 		if err != nil {
 			return nil, Probe, fmt.Errorf("create finding: %w", err)
 		}
@@ -123,10 +124,11 @@ func prHasSuccessStatus(r checker.RevisionCIInfo) (bool, *finding.Finding, error
 	return false, nil, nil
 }
 
-// PR has a successful CI-related check.
+// PR has a successful CI-related check. GitLab pipelines are CI-related if
+// the GitLab CI configuration has jobs running tests.
 //
 //nolint:unparam
-func prHasSuccessfulCheck(r checker.RevisionCIInfo) (bool, *finding.Finding, error) {
+func prHasSuccessfulCheck(r checker.RevisionCIInfo, testJobs []string) (bool, *finding.Finding, error) {
 	for _, cr := range r.CheckRuns {
 		if cr.Status != "completed" {
 			continue
@@ -134,7 +136,7 @@ func prHasSuccessfulCheck(r checker.RevisionCIInfo) (bool, *finding.Finding, err
 		if cr.Conclusion != success {
 			continue
 		}
-		if isTest(cr.App.Slug) {
+		if isTest(cr.App.Slug) || (cr.App.Slug == clients.GitLabCISlug && len(testJobs) > 0) {
 			msg := fmt.Sprintf("CI test found: pr: %d, context: %s", r.PullRequestNumber,
 				cr.App.Slug)
 
@@ -165,7 +167,7 @@ func isTest(s string) bool {
 		"appveyor", "buildkite", "circleci", "e2e", "github-actions", "jenkins",
 		"mergeable", "packit-as-a-service", "semaphoreci", "test", "travis-ci",
 		"flutter-dashboard", "cirrus-ci", "Cirrus CI", "azure-pipelines", "ci/woodpecker",
-		"vstfs:///build/build",
+		"vstfs:///build/build", "addon/pipelines",
 	} {
 		if strings.Contains(l, pattern) {
 			return true
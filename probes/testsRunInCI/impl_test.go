@@ -243,6 +243,13 @@ func Test_isTest(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "bitbucket pipelines",
+			args: args{
+				s: "https://bitbucket.org/ossf/scorecard/addon/pipelines/home#!/results/42",
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -258,10 +265,11 @@ func Test_prHasSuccessfulCheck(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name    string
-		args    checker.RevisionCIInfo
-		want    bool
-		wantErr bool
+		name     string
+		args     checker.RevisionCIInfo
+		testJobs []string
+		want     bool
+		wantErr  bool
 	}{
 		{
 			name: "check run with conclusion success",
@@ -314,10 +322,43 @@ func Test_prHasSuccessfulCheck(t *testing.T) {
 			want:    false,
 			wantErr: false,
 		},
+		{
+			name: "gitlab pipeline with test jobs",
+			args: checker.RevisionCIInfo{
+				PullRequestNumber: 1,
+				HeadSHA:           "sha",
+				CheckRuns: []clients.CheckRun{
+					{
+						App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
+						Conclusion: "success",
+						URL:        "url",
+						Status:     "completed",
+					},
+				},
+			},
+			testJobs: []string{"unit-tests"},
+			want:     true,
+		},
+		{
+			name: "gitlab pipeline without test jobs",
+			args: checker.RevisionCIInfo{
+				PullRequestNumber: 1,
+				HeadSHA:           "sha",
+				CheckRuns: []clients.CheckRun{
+					{
+						App:        clients.CheckRunApp{Slug: clients.GitLabCISlug},
+						Conclusion: "success",
+						URL:        "url",
+						Status:     "completed",
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		//nolint:errcheck
-		got, _, _ := prHasSuccessfulCheck(tt.args)
+		got, _, _ := prHasSuccessfulCheck(tt.args, tt.testJobs)
 		if got != tt.want {
 			t.Errorf("prHasSuccessfulCheck() = %v, want %v", got, tt.want)
 		}
@@ -473,7 +514,7 @@ func Test_prHasSuccessfulCheckAdditional(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, _, err := prHasSuccessfulCheck(tt.args.r)
+			got, _, err := prHasSuccessfulCheck(tt.args.r, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("prHasSuccessfulCheck() error = %v, wantErr %v", err, tt.wantErr)
 				return
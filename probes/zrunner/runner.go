@@ -21,6 +21,9 @@ import (
 	"github.com/ossf/scorecard/v5/checker"
 	serrors "github.com/ossf/scorecard/v5/errors"
 	"github.com/ossf/scorecard/v5/finding"
+	"github.com/ossf/scorecard/v5/internal/applicability"
+	"github.com/ossf/scorecard/v5/internal/checknames"
+	proberegistration "github.com/ossf/scorecard/v5/internal/probes"
 	"github.com/ossf/scorecard/v5/probes"
 )
 
@@ -32,6 +35,9 @@ func Run(raw *checker.RawResults, probesToRun []probes.ProbeImpl) ([]finding.Fin
 	var errs []error
 	for _, probeFunc := range probesToRun {
 		findings, probeID, err := probeFunc(raw)
+		if err == nil {
+			findings, err = ApplyPreconditions(raw, probeID, findings)
+		}
 		if err != nil {
 			errs = append(errs, err)
 			results = append(results,
@@ -49,3 +55,34 @@ func Run(raw *checker.RawResults, probesToRun []probes.ProbeImpl) ([]finding.Fin
 	}
 	return results, nil
 }
+
+// ApplyPreconditions replaces the findings of a probe with a single
+// NotApplicable finding if the project does not meet the preconditions
+// declared in the probe's def.yml. The project facts are derived from the
+// raw data the probe requires, so probes which collect their own data never
+// have unmet preconditions.
+func ApplyPreconditions(raw *checker.RawResults, probeID string, findings []finding.Finding,
+) ([]finding.Finding, error) {
+	def, err := probes.Definition(probeID)
+	if err != nil {
+		return nil, fmt.Errorf("probe preconditions: %w", err)
+	}
+	if len(def.Preconditions) == 0 {
+		return findings, nil
+	}
+	var rawData []checknames.CheckName
+	if p, err := proberegistration.Get(probeID); err == nil {
+		rawData = p.RequiredRawData
+	}
+	facts := applicability.Compute(raw, rawData)
+	reason, unmet := applicability.Unmet(&def.Probe, &facts)
+	if !unmet {
+		return findings, nil
+	}
+	f, err := finding.FromBytes(def.Content, probeID)
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithMessage(reason).WithOutcome(finding.OutcomeNotApplicable)
+	return []finding.Finding{*f}, nil
+}